@@ -0,0 +1,19 @@
+package v1alpha1
+
+// Types of conditions common to the statuses of Stages, Warehouses, and
+// Promotions. These follow the conventions widely understood by generic
+// tooling (e.g. `kubectl wait`, Argo CD, and Flux) for assessing the readiness
+// of arbitrary resources.
+const (
+	// ConditionTypeReady is the type of a condition indicating whether a
+	// resource is in its desired state and requires no further action.
+	ConditionTypeReady = "Ready"
+	// ConditionTypeReconciling is the type of a condition indicating that a
+	// resource is actively being worked toward its desired state -- for
+	// instance, a Stage with a Promotion in progress.
+	ConditionTypeReconciling = "Reconciling"
+	// ConditionTypeStalled is the type of a condition indicating that progress
+	// toward a resource's desired state has stopped because of an error that is
+	// unlikely to be resolved without intervention.
+	ConditionTypeStalled = "Stalled"
+)
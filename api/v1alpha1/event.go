@@ -0,0 +1,43 @@
+package v1alpha1
+
+// Reasons for Kubernetes Events emitted by Kargo's controllers. These are
+// stable and may be relied upon by external tools consuming the Events.
+const (
+	// EventReasonPromotionStarted is the reason for an Event recorded when
+	// execution of a Promotion begins.
+	EventReasonPromotionStarted = "PromotionStarted"
+	// EventReasonPromotionSucceeded is the reason for an Event recorded when a
+	// Promotion succeeds.
+	EventReasonPromotionSucceeded = "PromotionSucceeded"
+	// EventReasonPromotionErrored is the reason for an Event recorded when a
+	// Promotion fails.
+	EventReasonPromotionErrored = "PromotionErrored"
+	// EventReasonPromotionAborted is the reason for an Event recorded when a
+	// Promotion is aborted.
+	EventReasonPromotionAborted = "PromotionAborted"
+	// EventReasonFreightCreated is the reason for an Event recorded when a
+	// Warehouse produces new Freight.
+	EventReasonFreightCreated = "FreightCreated"
+	// EventReasonFreightQualified is the reason for an Event recorded when
+	// Freight is qualified for a Stage.
+	EventReasonFreightQualified = "FreightQualified"
+	// EventReasonHealthChanged is the reason for an Event recorded when a
+	// Stage's health changes.
+	EventReasonHealthChanged = "HealthChanged"
+	// EventReasonAutoPromotionSkipped is the reason for an Event recorded when
+	// a Stage is not automatically promoted to new Freight for a reason other
+	// than auto-promotion not being enabled for it.
+	EventReasonAutoPromotionSkipped = "AutoPromotionSkipped"
+)
+
+// Keys of annotations applied to Kubernetes Events emitted by Kargo's
+// controllers, identifying the resources an Event concerns.
+const (
+	AnnotationKeyEventStage     = "event.kargo.akuity.io/stage"
+	AnnotationKeyEventFreight   = "event.kargo.akuity.io/freight"
+	AnnotationKeyEventPromotion = "event.kargo.akuity.io/promotion"
+	AnnotationKeyEventWarehouse = "event.kargo.akuity.io/warehouse"
+	// AnnotationKeyEventMechanism identifies the promotion mechanism that
+	// failed, on Events with the EventReasonPromotionErrored reason.
+	AnnotationKeyEventMechanism = "event.kargo.akuity.io/mechanism"
+)
@@ -33,20 +33,23 @@ func GetFreight(
 
 // GetQualifiedFreight returns a pointer to the Freight resource specified by
 // the namespacedName argument if it is found and EITHER no Stages were
-// specified in the function call OR the Freight has qualified for ANY of the
-// specified Stages. If all other cases, nil is returned instead.
+// specified in the function call OR the Freight has qualified for enough of the
+// specified Stages to satisfy the specified QualificationRule. A nil rule is
+// satisfied by qualification for ANY of the specified Stages. In all other
+// cases, nil is returned instead.
 //
 // Note: The rationale for returning the found Freight (if any) instead of nil
 // when no Stages are specified is that the Stages provided are typically the
 // names of Stages UPSTREAM from some other Stage. i.e. The typical use for this
-// function is to answer whether a piece of Freight has qualified for any of a
-// given Stage's UPSTREAM Stages. Some Stages have no upstream Stages, so any
-// Freight that is found is implicitly qualified.
+// function is to answer whether a piece of Freight has qualified for a given
+// Stage's UPSTREAM Stages. Some Stages have no upstream Stages, so any Freight
+// that is found is implicitly qualified.
 func GetQualifiedFreight(
 	ctx context.Context,
 	c client.Client,
 	namespacedName types.NamespacedName,
 	stages []string,
+	rule *QualificationRule,
 ) (*Freight, error) {
 	freight, err := GetFreight(ctx, c, namespacedName)
 	if err != nil {
@@ -58,12 +61,15 @@ func GetQualifiedFreight(
 	if len(stages) == 0 {
 		return freight, nil
 	}
-	for qualifiedStage := range freight.Status.Qualifications {
-		for _, stage := range stages {
-			if qualifiedStage == stage {
-				return freight, nil
-			}
+	var qualifications int
+	for _, stage := range stages {
+		if _, qualified := freight.Status.Qualifications[stage]; qualified {
+			qualifications++
 		}
 	}
+	if qualifications > 0 &&
+		qualifications >= rule.MinQualifications(len(stages)) {
+		return freight, nil
+	}
 	return nil, nil
 }
@@ -62,3 +62,113 @@ func TestGetFreight(t *testing.T) {
 		})
 	}
 }
+
+func TestGetQualifiedFreight(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testFreight := &Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-freight",
+			Namespace: "fake-namespace",
+		},
+		Status: FreightStatus{
+			Qualifications: map[string]Qualification{
+				"fake-stage-1": {},
+				"fake-stage-2": {},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		stages     []string
+		rule       *QualificationRule
+		assertions func(*Freight, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+
+		{
+			name: "no Stages specified",
+			client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testFreight.DeepCopy()).Build(),
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+			},
+		},
+
+		{
+			name: "not qualified for any Stage",
+			client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testFreight.DeepCopy()).Build(),
+			stages: []string{"fake-stage-3"},
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+
+		{
+			name: "qualified for any Stage",
+			client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testFreight.DeepCopy()).Build(),
+			stages: []string{"fake-stage-1", "fake-stage-3"},
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+			},
+		},
+
+		{
+			name: "not qualified for all Stages",
+			client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testFreight.DeepCopy()).Build(),
+			stages: []string{"fake-stage-1", "fake-stage-2", "fake-stage-3"},
+			rule:   &QualificationRule{Type: QualificationRuleTypeAll},
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+
+		{
+			name: "qualified for a quorum of Stages",
+			client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testFreight.DeepCopy()).Build(),
+			stages: []string{"fake-stage-1", "fake-stage-2", "fake-stage-3"},
+			rule: &QualificationRule{
+				Type:   QualificationRuleTypeQuorum,
+				Quorum: 2,
+			},
+			assertions: func(freight *Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			freight, err := GetQualifiedFreight(
+				context.Background(),
+				testCase.client,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-freight",
+				},
+				testCase.stages,
+				testCase.rule,
+			)
+			testCase.assertions(freight, err)
+		})
+	}
+}
@@ -37,28 +37,34 @@ func (f *Freight) GetStatus() *FreightStatus {
 // UpdateID deterministically calculates a piece of Freight's ID based on its
 // contents and assigns it to the ID field.
 func (f *Freight) UpdateID() {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	f.ID = getFreightID(f.Commits, f.Images, f.Charts)
+}
+
+// getFreightID deterministically calculates the ID of a piece of Freight
+// referencing the provided artifacts.
+func getFreightID(commits []GitCommit, images []Image, charts []Chart) string {
+	size := len(commits) + len(images) + len(charts)
 	artifacts := make([]string, 0, size)
-	for _, commit := range f.Commits {
+	for _, commit := range commits {
 		artifacts = append(
 			artifacts,
 			fmt.Sprintf("%s:%s", commit.RepoURL, commit.ID),
 		)
 	}
-	for _, image := range f.Images {
+	for _, image := range images {
 		artifacts = append(
 			artifacts,
 			fmt.Sprintf("%s:%s", image.RepoURL, image.Tag),
 		)
 	}
-	for _, chart := range f.Charts {
+	for _, chart := range charts {
 		artifacts = append(
 			artifacts,
 			fmt.Sprintf("%s/%s:%s", chart.RegistryURL, chart.Name, chart.Version),
 		)
 	}
 	sort.Strings(artifacts)
-	f.ID = fmt.Sprintf(
+	return fmt.Sprintf(
 		"%x",
 		sha1.Sum([]byte(strings.Join(artifacts, "|"))),
 	)
@@ -92,11 +98,22 @@ type FreightStatus struct {
 	// Qualifications describes the Stages for which this Freight has been
 	// qualified.
 	Qualifications map[string]Qualification `json:"qualifications,omitempty"`
+	// Failures describes the Stages in which this Freight has been found to be
+	// faulty, resulting in an automatic rollback.
+	Failures map[string]Failure `json:"failures,omitempty"`
 }
 
 // Qualification describes a Freight's qualification for a Stage.
 type Qualification struct{}
 
+// Failure describes a Freight's failure in a Stage.
+type Failure struct {
+	// Time is the time at which the failure was recorded.
+	Time metav1.Time `json:"time"`
+	// Reason describes the failure.
+	Reason string `json:"reason"`
+}
+
 //+kubebuilder:object:root=true
 
 // FreightList is a list of Freight resources.
@@ -29,6 +29,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(GroupVersion,
 		&Freight{},
 		&FreightList{},
+		&NotificationPolicy{},
+		&NotificationPolicyList{},
 		&Stage{},
 		&StageList{},
 		&Promotion{},
@@ -5,5 +5,61 @@ const (
 
 	LabelTrueValue = "true"
 
+	// LabelKeyDerivedFreight is the key of a label that Kargo applies, with a
+	// value of LabelTrueValue, to Freight derived from a partial promotion. Such
+	// Freight was never produced by a Warehouse and is never a candidate for
+	// auto-promotion.
+	LabelKeyDerivedFreight = "kargo.akuity.io/derived"
+
 	AnnotationKeyRefresh = "kargo.akuity.io/refresh"
+
+	// AnnotationKeyAcknowledgeRollback is the key of an annotation that a user
+	// may apply to a Stage to acknowledge its most recent automatic rollback,
+	// thereby resuming auto-promotion.
+	AnnotationKeyAcknowledgeRollback = "kargo.akuity.io/acknowledge-rollback"
+
+	// AnnotationKeyOverrideStageLock is the key of an annotation that may be
+	// applied to a Promotion to request that it proceed even though its Stage is
+	// locked. Subjects creating such a Promotion must be permitted the
+	// override-lock verb on the Stage.
+	AnnotationKeyOverrideStageLock = "kargo.akuity.io/override-stage-lock"
+
+	// AnnotationKeyForceDelete is the key of an annotation that may be applied
+	// to a Stage, Warehouse, or Freight to permit its deletion even though other
+	// resources depend on it.
+	AnnotationKeyForceDelete = "kargo.akuity.io/force-delete"
+
+	// AnnotationKeyDerivedFrom is the key of an annotation that Kargo applies
+	// to Freight derived from a partial promotion. Its value is the ID of the
+	// Freight from which the promoted artifacts were taken.
+	AnnotationKeyDerivedFrom = "kargo.akuity.io/derived-from"
+
+	// AnnotationKeyAbort is the key of an annotation that may be applied to a
+	// Pending or Running Promotion to request that it be aborted.
+	AnnotationKeyAbort = "kargo.akuity.io/abort"
+
+	// AnnotationKeyCreateActor is the key of an annotation that Kargo applies to
+	// a Promotion to record the user who requested its creation. It cannot be
+	// set by anyone other than the Kargo API server and cannot be changed.
+	AnnotationKeyCreateActor = "kargo.akuity.io/create-actor"
+
+	// AnnotationKeyPromotion is the key of an annotation that Kargo applies to
+	// the Jobs created by a Stage's promotion hooks to record the Promotion for
+	// which they were created.
+	AnnotationKeyPromotion = "kargo.akuity.io/promotion"
+
+	// AnnotationKeyHookServiceAccounts is the key of an annotation that may be
+	// applied to a project's namespace to list, separated by commas, the
+	// ServiceAccounts that the Jobs created by promotion hooks in the project
+	// may run as. Hooks may not specify any other ServiceAccount.
+	AnnotationKeyHookServiceAccounts = "kargo.akuity.io/hook-service-accounts"
+
+	// AnnotationKeyGitAuthorName, AnnotationKeyGitAuthorEmail and
+	// AnnotationKeyGitCommitMessageTemplate are the keys of annotations that may
+	// be applied to a project's namespace to customize, for every Stage in the
+	// project, the commits made by Git-based promotion mechanisms. Each is
+	// overridden by the corresponding field of a GitRepoUpdate's commit options.
+	AnnotationKeyGitAuthorName            = "kargo.akuity.io/git-author-name"
+	AnnotationKeyGitAuthorEmail           = "kargo.akuity.io/git-author-email"
+	AnnotationKeyGitCommitMessageTemplate = "kargo.akuity.io/git-commit-message-template"
 )
@@ -0,0 +1,217 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum={PromotionPhaseChanged,StageHealthChanged,FreightCreated,FreightQualified,FreightFailed}
+type NotificationEventType string
+
+const (
+	// NotificationEventTypePromotionPhaseChanged denotes a Promotion having
+	// moved into a new phase.
+	NotificationEventTypePromotionPhaseChanged NotificationEventType = "PromotionPhaseChanged"
+	// NotificationEventTypeStageHealthChanged denotes a Stage's health having
+	// changed.
+	NotificationEventTypeStageHealthChanged NotificationEventType = "StageHealthChanged"
+	// NotificationEventTypeFreightCreated denotes new Freight having been
+	// produced by a Warehouse.
+	NotificationEventTypeFreightCreated NotificationEventType = "FreightCreated"
+	// NotificationEventTypeFreightQualified denotes Freight having been verified
+	// and qualified for a Stage.
+	NotificationEventTypeFreightQualified NotificationEventType = "FreightQualified"
+	// NotificationEventTypeFreightFailed denotes Freight having been found to be
+	// faulty in a Stage, resulting in an automatic rollback.
+	NotificationEventTypeFreightFailed NotificationEventType = "FreightFailed"
+)
+
+//+kubebuilder:resource:shortName={notifpolicy,notifpolicies}
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// NotificationPolicy specifies which events in a project should trigger
+// notifications and where those notifications should be sent.
+type NotificationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec describes which events should trigger notifications and where those
+	// notifications should be sent.
+	//
+	//+kubebuilder:validation:Required
+	Spec *NotificationPolicySpec `json:"spec"`
+	// Status describes the outcome of the most recent attempts to deliver
+	// notifications to each of the policy's sinks.
+	Status NotificationPolicyStatus `json:"status,omitempty"`
+}
+
+func (n *NotificationPolicy) GetStatus() *NotificationPolicyStatus {
+	return &n.Status
+}
+
+// NotificationPolicySpec describes which events should trigger notifications
+// and where those notifications should be sent.
+type NotificationPolicySpec struct {
+	// Events lists the types of events that should trigger notifications. This
+	// is a required field.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Events []NotificationEventType `json:"events"`
+	// Stages optionally restricts notifications to events concerning the named
+	// Stages. FreightCreated events do not concern any particular Stage and are
+	// unaffected by this field. If left unspecified, events concerning all
+	// Stages in the project trigger notifications.
+	Stages []string `json:"stages,omitempty"`
+	// PromotionPhases optionally restricts notifications of
+	// PromotionPhaseChanged events to Promotions that have moved into one of
+	// the listed phases. If left unspecified, every phase change triggers a
+	// notification.
+	PromotionPhases []PromotionPhase `json:"promotionPhases,omitempty"`
+	// Sinks describes where notifications should be sent. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Sinks []NotificationSink `json:"sinks"`
+}
+
+// NotificationSink describes a destination for notifications. Exactly one of
+// the Slack, Teams, HTTP, or SMTP fields must be specified.
+type NotificationSink struct {
+	// Name uniquely identifies the sink within the NotificationPolicy. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Slack describes a Slack-compatible incoming webhook.
+	Slack *SlackNotificationSink `json:"slack,omitempty"`
+	// Teams describes a Microsoft Teams incoming webhook.
+	Teams *TeamsNotificationSink `json:"teams,omitempty"`
+	// HTTP describes an arbitrary HTTP endpoint.
+	HTTP *HTTPNotificationSink `json:"http,omitempty"`
+	// SMTP describes an SMTP server through which notifications should be sent
+	// as email.
+	SMTP *SMTPNotificationSink `json:"smtp,omitempty"`
+}
+
+// SlackNotificationSink describes a Slack-compatible incoming webhook.
+type SlackNotificationSink struct {
+	// WebhookURL references a key of a Secret in the project namespace whose
+	// value is the URL of the webhook. This is a required field.
+	WebhookURL corev1.SecretKeySelector `json:"webhookURL"`
+	// Template is an optional Go template for the text of each message. If left
+	// unspecified, a default message describing the event is sent.
+	Template string `json:"template,omitempty"`
+}
+
+// TeamsNotificationSink describes a Microsoft Teams incoming webhook.
+type TeamsNotificationSink struct {
+	// WebhookURL references a key of a Secret in the project namespace whose
+	// value is the URL of the webhook. This is a required field.
+	WebhookURL corev1.SecretKeySelector `json:"webhookURL"`
+	// Template is an optional Go template for the text of each message. If left
+	// unspecified, a default message describing the event is sent.
+	Template string `json:"template,omitempty"`
+}
+
+// HTTPNotificationSink describes an arbitrary HTTP endpoint to which
+// notifications should be POSTed.
+type HTTPNotificationSink struct {
+	// URL is the URL of the endpoint. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+	// Headers specifies additional headers to send with each request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// HeadersSecret optionally references a Secret in the project namespace
+	// whose keys and values are sent as additional headers with each request.
+	// This is useful for headers, such as Authorization, whose values are
+	// sensitive.
+	HeadersSecret *corev1.LocalObjectReference `json:"headersSecret,omitempty"`
+	// ContentType is the value of the Content-Type header sent with each
+	// request. If left unspecified, the default is application/json.
+	ContentType string `json:"contentType,omitempty"`
+	// Template is a Go template for the body of each request. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Template string `json:"template"`
+}
+
+// SMTPNotificationSink describes an SMTP server through which notifications
+// should be sent as email.
+type SMTPNotificationSink struct {
+	// Host is the host name of the SMTP server. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+	// Port is the port of the SMTP server. If left unspecified, the default is
+	// 587.
+	//
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// CredentialsSecret optionally references a Secret in the project namespace
+	// with username and password keys to be used for authenticating to the
+	// SMTP server.
+	CredentialsSecret *corev1.LocalObjectReference `json:"credentialsSecret,omitempty"`
+	// From is the address from which email is sent. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+	// To lists the addresses to which email is sent. This is a required field.
+	//
+	//+kubebuilder:validation:MinItems=1
+	To []string `json:"to"`
+	// SubjectTemplate is an optional Go template for the subject of each email.
+	// If left unspecified, a default subject describing the event is used.
+	SubjectTemplate string `json:"subjectTemplate,omitempty"`
+	// Template is an optional Go template for the plain text body of each
+	// email. If left unspecified, a default body describing the event is used.
+	Template string `json:"template,omitempty"`
+}
+
+// NotificationPolicyStatus describes the outcome of the most recent attempts
+// to deliver notifications to each of a NotificationPolicy's sinks.
+type NotificationPolicyStatus struct {
+	// Sinks describes the most recent delivery to each sink, indexed by the
+	// sink's name.
+	Sinks map[string]NotificationSinkStatus `json:"sinks,omitempty"`
+}
+
+// NotificationSinkStatus describes deliveries of notifications to a single
+// sink.
+type NotificationSinkStatus struct {
+	// LastDelivery describes the most recent notification delivered, or that
+	// could not be delivered, to the sink.
+	LastDelivery *NotificationDelivery `json:"lastDelivery,omitempty"`
+	// LastSuccessTime is the time at which a notification was most recently
+	// delivered to the sink successfully.
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+}
+
+// NotificationDelivery describes the outcome of an attempt to deliver a
+// notification.
+type NotificationDelivery struct {
+	// Time is the time at which delivery concluded.
+	Time metav1.Time `json:"time"`
+	// Event is the type of event the notification described.
+	Event NotificationEventType `json:"event"`
+	// Message is a summary of the event the notification described.
+	Message string `json:"message,omitempty"`
+	// Attempts is the number of times delivery was attempted.
+	Attempts int32 `json:"attempts"`
+	// Error describes why delivery failed, if it did. An empty value indicates
+	// the notification was delivered successfully.
+	Error string `json:"error,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NotificationPolicyList contains a list of NotificationPolicies
+type NotificationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationPolicy `json:"items"`
+}
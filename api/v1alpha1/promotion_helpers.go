@@ -2,8 +2,10 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -30,3 +32,38 @@ func GetPromotion(
 	}
 	return &promo, nil
 }
+
+// AbortPromotion requests that the Promotion specified by the namespacedName
+// argument be aborted by setting an annotation on it. The Promotion controller
+// will move a Pending Promotion directly to the Aborted phase and will stop a
+// Running Promotion before executing any further promotion mechanisms.
+func AbortPromotion(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+) (*Promotion, error) {
+	promo := &Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespacedName.Namespace,
+			Name:      namespacedName.Name,
+		},
+	}
+	patchBytes := []byte(
+		fmt.Sprintf(
+			`{"metadata":{"annotations":{"%s":"%s"}}}`,
+			AnnotationKeyAbort,
+			LabelTrueValue,
+		),
+	)
+	patch := client.RawPatch(types.MergePatchType, patchBytes)
+	if err := c.Patch(ctx, promo, patch); err != nil {
+		return nil, errors.Wrap(err, "patch annotation")
+	}
+	return promo, nil
+}
+
+// IsAbortRequested returns true if the Promotion has been annotated to request
+// that it be aborted.
+func (p *Promotion) IsAbortRequested() bool {
+	return p.Annotations[AnnotationKeyAbort] == LabelTrueValue
+}
@@ -62,3 +62,37 @@ func TestGetPromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestAbortPromotion(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-promotion",
+				Namespace: "fake-namespace",
+			},
+		},
+	).Build()
+	key := types.NamespacedName{
+		Namespace: "fake-namespace",
+		Name:      "fake-promotion",
+	}
+	promo, err := AbortPromotion(context.Background(), c, key)
+	require.NoError(t, err)
+	require.True(t, promo.IsAbortRequested())
+
+	promo, err = GetPromotion(context.Background(), c, key)
+	require.NoError(t, err)
+	require.True(t, promo.IsAbortRequested())
+
+	_, err = AbortPromotion(
+		context.Background(),
+		c,
+		types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "nonexistent-promotion",
+		},
+	)
+	require.Error(t, err)
+}
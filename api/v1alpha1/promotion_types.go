@@ -28,11 +28,21 @@ const (
 	// room for the possibility in the near future that a Promotion might fail
 	// as a result of some user action.
 	PromotionPhaseErrored PromotionPhase = "Errored"
+	// PromotionPhaseAborted denotes a Promotion that was aborted by a user
+	// before it could complete.
+	PromotionPhaseAborted PromotionPhase = "Aborted"
+	// PromotionPhaseSuperseded denotes a supersedable Promotion that was never
+	// executed because, while it was still Pending, another Promotion of the
+	// same Stage to more recently created Freight was queued.
+	PromotionPhaseSuperseded PromotionPhase = "Superseded"
 )
 
 // IsTerminal returns true if the PromotionPhase is a terminal one.
 func (p *PromotionPhase) IsTerminal() bool {
-	return *p == PromotionPhaseSucceeded || *p == PromotionPhaseErrored
+	return *p == PromotionPhaseSucceeded ||
+		*p == PromotionPhaseErrored ||
+		*p == PromotionPhaseAborted ||
+		*p == PromotionPhaseSuperseded
 }
 
 //+kubebuilder:resource:shortName={promo,promos}
@@ -77,6 +87,41 @@ type PromotionSpec struct {
 	//
 	//+kubebuilder:validation:MinLength=1
 	Freight string `json:"freight"`
+	// Supersedable indicates whether this Promotion may be skipped if, while it
+	// is still Pending, another Promotion of the same Stage to more recently
+	// created Freight is queued. A Promotion that is skipped in this manner
+	// moves directly to the Superseded phase. Promotions created automatically
+	// by Kargo are always supersedable.
+	Supersedable bool `json:"supersedable,omitempty"`
+	// Artifacts optionally selects a subset of the artifacts referenced by the
+	// Freight to be promoted. Artifacts that are not selected retain the
+	// versions found in the Stage's current Freight. The Freight the Stage
+	// transitions into is then a new, derived piece of Freight composed of the
+	// selected artifacts and the unchanged ones. If this field is unspecified,
+	// all of the Freight's artifacts are promoted.
+	Artifacts *ArtifactSelector `json:"artifacts,omitempty"`
+}
+
+// ArtifactSelector selects a subset of the artifacts referenced by a piece of
+// Freight. An artifact is selected if it is matched by Include, or Include is
+// unspecified, and it is not matched by Exclude.
+type ArtifactSelector struct {
+	// Include, if specified, limits the selected artifacts to those it matches.
+	Include *ArtifactReferences `json:"include,omitempty"`
+	// Exclude specifies artifacts that are never selected, even if they are
+	// matched by Include.
+	Exclude *ArtifactReferences `json:"exclude,omitempty"`
+}
+
+// ArtifactReferences identifies artifacts without regard to their versions.
+type ArtifactReferences struct {
+	// Images lists the URLs of container image repositories.
+	Images []string `json:"images,omitempty"`
+	// Charts lists Helm charts, each identified either by name alone or by its
+	// registry URL and name, separated by a slash.
+	Charts []string `json:"charts,omitempty"`
+	// Commits lists the URLs of Git repositories.
+	Commits []string `json:"commits,omitempty"`
 }
 
 // PromotionStatus describes the current state of the transition represented by
@@ -86,7 +131,82 @@ type PromotionStatus struct {
 	Phase PromotionPhase `json:"phase,omitempty"`
 	// Error describes any errors that are preventing the Promotion controller
 	// from executing this Promotion. i.e. If the Phase field has a value of
-	// Failed, this field can be expected to explain why.
+	// Failed, this field can be expected to explain why. If the Phase field has
+	// a value of Superseded, this field explains which Promotion superseded
+	// this one.
+	Error string `json:"error,omitempty"`
+	// Attempts is the number of times execution of the Promotion has been
+	// attempted. While a failed attempt is waiting to be retried, the Phase
+	// field remains Running and the Error field describes the failure.
+	Attempts int32 `json:"attempts,omitempty"`
+	// AttemptStartTime is the time at which the attempt at executing the
+	// Promotion that is currently in progress began. It is cleared when the
+	// attempt concludes. A Running Promotion with an AttemptStartTime that is
+	// not being executed by the controller was interrupted -- for instance, by a
+	// restart of the controller -- and is resumed from its last completed step,
+	// or fails if its Stage's promotion timeout has already elapsed.
+	AttemptStartTime *metav1.Time `json:"attemptStartTime,omitempty"`
+	// DerivedFreight is the ID of the Freight into which the Stage transitions
+	// if the Promotion selects only a subset of the artifacts referenced by the
+	// Freight specified in its spec. This Freight is derived from the
+	// selected artifacts and the remaining artifacts of the Stage's current
+	// Freight.
+	DerivedFreight string `json:"derivedFreight,omitempty"`
+	// Steps describes the outcome of each individual step executed by the
+	// Stage's promotion mechanisms, in the order in which they were executed.
+	// When a Promotion is retried or resumed, steps that have already succeeded
+	// are not executed again.
+	Steps []PromotionStepResult `json:"steps,omitempty"`
+	// Conditions contains the last observations of the Promotion's current
+	// state.
+	//
+	//+patchMergeKey=type
+	//+patchStrategy=merge
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge"`
+}
+
+// PromotionStepOutcome describes whether an individual step of a Promotion is
+// still being executed, completed successfully, or failed.
+//
+// +kubebuilder:validation:Enum={Running,Succeeded,Errored}
+type PromotionStepOutcome string
+
+const (
+	// PromotionStepOutcomeRunning denotes a step that is still being executed.
+	PromotionStepOutcomeRunning PromotionStepOutcome = "Running"
+	// PromotionStepOutcomeSucceeded denotes a step that completed successfully.
+	PromotionStepOutcomeSucceeded PromotionStepOutcome = "Succeeded"
+	// PromotionStepOutcomeErrored denotes a step that failed.
+	PromotionStepOutcomeErrored PromotionStepOutcome = "Errored"
+)
+
+// PromotionStepResult describes the outcome of a single step of a Promotion --
+// for instance, an update to one Git repository or to one Argo CD Application.
+type PromotionStepResult struct {
+	// Mechanism is the name of the promotion mechanism that executed the step.
+	Mechanism string `json:"mechanism"`
+	// Target describes what the step updated -- for instance, a Git repository
+	// and branch, or an Argo CD Application.
+	Target string `json:"target,omitempty"`
+	// StartTime is the time at which execution of the step began.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time at which execution of the step concluded.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Outcome describes whether the step is still running, succeeded, or
+	// errored.
+	Outcome PromotionStepOutcome `json:"outcome,omitempty"`
+	// Commit is the ID of the commit produced by the step, if any. While the
+	// step is running, this may identify a commit that has been made but not
+	// yet pushed.
+	Commit string `json:"commit,omitempty"`
+	// Job is the name of the Kubernetes Job run by the step, if any.
+	Job string `json:"job,omitempty"`
+	// PullRequestURL is the URL of the pull request opened or updated by the
+	// step, if any, in lieu of a commit.
+	PullRequestURL string `json:"pullRequestURL,omitempty"`
+	// Error describes why the step failed, if it did.
 	Error string `json:"error,omitempty"`
 }
 
@@ -63,14 +63,34 @@ func ClearStageRefresh(
 	ctx context.Context,
 	c client.Client,
 	stage *Stage,
+) error {
+	return clearStageAnnotation(ctx, c, stage, AnnotationKeyRefresh)
+}
+
+// ClearStageRollbackAcknowledgement is called by the Stage controller to clear
+// the rollback acknowledgement annotation on the Stage (if present) once the
+// acknowledgement has been recorded in the Stage's status.
+func ClearStageRollbackAcknowledgement(
+	ctx context.Context,
+	c client.Client,
+	stage *Stage,
+) error {
+	return clearStageAnnotation(ctx, c, stage, AnnotationKeyAcknowledgeRollback)
+}
+
+func clearStageAnnotation(
+	ctx context.Context,
+	c client.Client,
+	stage *Stage,
+	key string,
 ) error {
 	if stage.Annotations == nil {
 		return nil
 	}
-	if _, ok := stage.Annotations[AnnotationKeyRefresh]; !ok {
+	if _, ok := stage.Annotations[key]; !ok {
 		return nil
 	}
-	patchBytes := []byte(fmt.Sprintf(`{"metadata":{"annotations":{"%s":null}}}`, AnnotationKeyRefresh))
+	patchBytes := []byte(fmt.Sprintf(`{"metadata":{"annotations":{"%s":null}}}`, key))
 	patch := client.RawPatch(types.MergePatchType, patchBytes)
 	newStage := Stage{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2,7 +2,9 @@ package v1alpha1
 
 import (
 	"os"
+	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -14,6 +16,86 @@ const (
 	ImageUpdateValueTypeTag   ImageUpdateValueType = "Tag"
 )
 
+// +kubebuilder:validation:Enum={Any,All,Quorum}
+type QualificationRuleType string
+
+const (
+	QualificationRuleTypeAny    QualificationRuleType = "Any"
+	QualificationRuleTypeAll    QualificationRuleType = "All"
+	QualificationRuleTypeQuorum QualificationRuleType = "Quorum"
+)
+
+// +kubebuilder:validation:Enum={Report,Reassert}
+type DriftPolicy string
+
+const (
+	// DriftPolicyReport indicates that drift between a Stage's current Freight
+	// and what is actually deployed should only be reported.
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyReassert indicates that drift between a Stage's current
+	// Freight and what is actually deployed should be reported and then
+	// corrected by re-applying the Freight.
+	DriftPolicyReassert DriftPolicy = "Reassert"
+)
+
+const (
+	// StageConditionTypeDrifted is the type of a Stage condition indicating
+	// whether what is actually deployed to the Stage has drifted from the
+	// Stage's current Freight.
+	StageConditionTypeDrifted = "Drifted"
+	// StageConditionTypeHealthy is the type of a Stage condition reflecting the
+	// Stage's last observed health. It is absent when health is not applicable.
+	StageConditionTypeHealthy = "Healthy"
+	// StageConditionTypeVerified is the type of a Stage condition indicating
+	// whether the Stage's current Freight is verified -- i.e. the Stage is
+	// healthy (or health is not applicable) and has been for at least the
+	// Stage's minimum soak duration. Verified Freight is qualified for the
+	// Stage.
+	StageConditionTypeVerified = "Verified"
+	// StageConditionTypeAutoPromotionBlocked is the type of a Stage condition
+	// indicating whether auto-promotion of the Stage is currently blocked. The
+	// condition's reason explains why.
+	StageConditionTypeAutoPromotionBlocked = "AutoPromotionBlocked"
+)
+
+// Reasons recorded in a Stage's most recent AutoPromotionDecision. Those that
+// block auto-promotion are also used as reasons for the Stage's
+// AutoPromotionBlocked condition.
+const (
+	// AutoPromotionReasonPromoted indicates that a Promotion of the Stage to new
+	// Freight was created.
+	AutoPromotionReasonPromoted = "Promoted"
+	// AutoPromotionReasonLocked indicates that the Stage is locked.
+	AutoPromotionReasonLocked = "Locked"
+	// AutoPromotionReasonRollbackUnacknowledged indicates that auto-promotion
+	// is paused pending acknowledgement of an automatic rollback.
+	AutoPromotionReasonRollbackUnacknowledged = "RollbackUnacknowledged"
+	// AutoPromotionReasonNoSubscriptions indicates that the Stage subscribes
+	// to neither a Warehouse nor any upstream Stages.
+	AutoPromotionReasonNoSubscriptions = "NoSubscriptions"
+	// AutoPromotionReasonAmbiguousSubscriptions indicates that the Stage's
+	// subscriptions do not unambiguously identify the Freight available to it.
+	AutoPromotionReasonAmbiguousSubscriptions = "AmbiguousSubscriptions"
+	// AutoPromotionReasonNoPromotionPolicy indicates that no PromotionPolicy is
+	// associated with the Stage.
+	AutoPromotionReasonNoPromotionPolicy = "NoPromotionPolicy"
+	// AutoPromotionReasonMultiplePromotionPolicies indicates that more than one
+	// PromotionPolicy is associated with the Stage.
+	AutoPromotionReasonMultiplePromotionPolicies = "MultiplePromotionPolicies"
+	// AutoPromotionReasonDisabled indicates that the PromotionPolicy
+	// associated with the Stage does not enable auto-promotion.
+	AutoPromotionReasonDisabled = "AutoPromotionDisabled"
+	// AutoPromotionReasonNoFreight indicates that no Freight is available to
+	// the Stage.
+	AutoPromotionReasonNoFreight = "NoFreight"
+	// AutoPromotionReasonAlreadyCurrent indicates that the latest Freight
+	// available to the Stage is already its current Freight.
+	AutoPromotionReasonAlreadyCurrent = "AlreadyCurrent"
+	// AutoPromotionReasonFreightPreviouslyFailed indicates that the latest
+	// Freight available to the Stage previously failed in it.
+	AutoPromotionReasonFreightPreviouslyFailed = "FreightPreviouslyFailed"
+)
+
 type HealthState string
 
 const (
@@ -61,6 +143,7 @@ const (
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name=Current Freight,type=string,JSONPath=`.status.currentFreight.id`
 //+kubebuilder:printcolumn:name=Health,type=string,JSONPath=`.status.health.status`
+//+kubebuilder:printcolumn:name=Ready,type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 //+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Stage is the Kargo API's main type.
@@ -95,6 +178,65 @@ type StageSpec struct {
 	// single upstream Stage where they may otherwise have subscribed to multiple
 	// upstream Stages.
 	PromotionMechanisms *PromotionMechanisms `json:"promotionMechanisms,omitempty"`
+	// HealthChecks describes Kubernetes resources whose health should factor
+	// into assessments of the Stage's health. This is an optional field. It is
+	// useful for Stages whose Freight is incorporated into the Stage by means
+	// other than Argo CD, which would otherwise never be assessed for health.
+	HealthChecks *HealthChecks `json:"healthChecks,omitempty"`
+	// MinimumSoakDuration is the minimum length of time for which the Stage
+	// must have been continuously Healthy with its current Freight before that
+	// Freight is qualified for the Stage and thereby made available to any
+	// downstream Stages. Any transition to a state other than Healthy resets
+	// the clock. This is an optional field. If left unspecified, Freight is
+	// qualified as soon as the Stage is Healthy.
+	MinimumSoakDuration *metav1.Duration `json:"minimumSoakDuration,omitempty"`
+	// AutoRollback optionally enables automatic rollback of the Stage to its
+	// previous Freight if the Stage becomes Unhealthy shortly after a promotion.
+	AutoRollback *AutoRollback `json:"autoRollback,omitempty"`
+	// DriftPolicy specifies what to do when the Argo CD Applications updated
+	// by the Stage's promotion mechanisms are found to have drifted from the
+	// Stage's current Freight. Drift is always reported. Valid values are
+	// "Report" and "Reassert". "Reassert" additionally re-applies the current
+	// Freight. If left unspecified, the default is "Report".
+	//
+	//+kubebuilder:default=Report
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+	// Lock optionally locks the Stage. While a Stage is locked, Promotions for
+	// the Stage are rejected unless they explicitly, and with sufficient
+	// privileges, override the lock, and the Stage is never automatically
+	// promoted or rolled back.
+	Lock *StageLock `json:"lock,omitempty"`
+}
+
+// AutoRollback describes when a Stage should automatically be rolled back to
+// its previous Freight.
+type AutoRollback struct {
+	// Window is the length of time following a promotion during which the Stage
+	// becoming Unhealthy will trigger an automatic rollback. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:Required
+	Window metav1.Duration `json:"window"`
+}
+
+// StageLock describes who locked a Stage, why, and until when.
+type StageLock struct {
+	// By identifies who locked the Stage.
+	By string `json:"by,omitempty"`
+	// Reason explains why the Stage was locked. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+	// Until is the time at which the lock expires. This is an optional field.
+	// If left unspecified, the Stage remains locked until it is explicitly
+	// unlocked.
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// IsActive returns a bool indicating whether the StageLock is in effect at the
+// provided time. A nil StageLock is never in effect.
+func (s *StageLock) IsActive(now time.Time) bool {
+	return s != nil && (s.Until == nil || now.Before(s.Until.Time))
 }
 
 // Subscriptions describes a Stage's sources of Freight.
@@ -105,6 +247,51 @@ type Subscriptions struct {
 	// UpstreamStages identifies other Stages as potential sources of Freight
 	// for this Stage. This field is mutually exclusive with the Repos field.
 	UpstreamStages []StageSubscription `json:"upstreamStages,omitempty"`
+	// QualificationRule describes how many of the Stages identified by the
+	// UpstreamStages field a piece of Freight must have qualified in before it
+	// is available to this Stage. If left unspecified, Freight qualified in ANY
+	// upstream Stage is available. Explicitly specifying a rule makes a Stage
+	// with multiple upstream Stages eligible for auto-promotion. This field may
+	// only be used in conjunction with the UpstreamStages field.
+	QualificationRule *QualificationRule `json:"qualificationRule,omitempty"`
+}
+
+// QualificationRule describes how many upstream Stages a piece of Freight must
+// have qualified in before it is available to a Stage.
+type QualificationRule struct {
+	// Type specifies the kind of rule. Valid values are "Any", which requires
+	// Freight to have qualified in at least one upstream Stage, "All", which
+	// requires Freight to have qualified in every upstream Stage, and "Quorum",
+	// which requires Freight to have qualified in at least the number of
+	// upstream Stages specified by the Quorum field. This is a required field.
+	Type QualificationRuleType `json:"type"`
+	// Quorum specifies the minimum number of upstream Stages a piece of Freight
+	// must have qualified in. This field is required when Type is "Quorum" and
+	// must not be used otherwise.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Minimum=1
+	Quorum int32 `json:"quorum,omitempty"`
+}
+
+// MinQualifications returns the minimum number of upstream Stages, out of the
+// provided total number of upstream Stages, that a piece of Freight must have
+// qualified in to satisfy the rule. A nil rule is treated as an "Any" rule.
+func (q *QualificationRule) MinQualifications(upstreamStages int) int {
+	if upstreamStages == 0 {
+		return 0
+	}
+	if q == nil {
+		return 1
+	}
+	switch q.Type {
+	case QualificationRuleTypeAll:
+		return upstreamStages
+	case QualificationRuleTypeQuorum:
+		return int(q.Quorum)
+	default:
+		return 1
+	}
 }
 
 // StageSubscription defines a subscription to Freight from another Stage.
@@ -128,6 +315,69 @@ type PromotionMechanisms struct {
 	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
 	// these.
 	ArgoCDAppUpdates []ArgoCDAppUpdate `json:"argoCDAppUpdates,omitempty"`
+	// Timeout is the maximum length of time for which a single attempt at
+	// executing a Promotion to the Stage may run. An attempt that runs longer
+	// than this fails and is not retried. This is an optional field. If left
+	// unspecified, the default is 30 minutes.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retry optionally specifies how Promotions to the Stage that fail due to
+	// transient errors, such as network errors, rejected pushes to Git
+	// repositories, or server errors from registries, should be retried. If left
+	// unspecified, failed Promotions are not retried.
+	Retry *PromotionRetry `json:"retry,omitempty"`
+	// PreHooks describes Kubernetes Jobs to be run, one at a time and in order,
+	// in the Stage's namespace BEFORE any updates specified by the
+	// GitRepoUpdates and ArgoCDAppUpdates fields are applied. A Promotion waits
+	// for each Job to complete and fails if any Job fails. This field is
+	// optional.
+	PreHooks []PromotionHook `json:"preHooks,omitempty"`
+	// PostHooks describes Kubernetes Jobs to be run, one at a time and in order,
+	// in the Stage's namespace AFTER all updates specified by the
+	// GitRepoUpdates and ArgoCDAppUpdates fields have been applied. A Promotion
+	// waits for each Job to complete and fails if any Job fails. This field is
+	// optional.
+	PostHooks []PromotionHook `json:"postHooks,omitempty"`
+}
+
+// PromotionHook describes a Kubernetes Job to be run as part of a Promotion.
+// Details of the Freight being promoted are exposed to every container of the
+// Job's Pods as environment variables.
+type PromotionHook struct {
+	// Name is a short name for the hook. It is used as the prefix of the names
+	// of the Jobs created from it. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:MaxLength=40
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Template is the template from which the hook's Jobs are created. This is
+	// a required field. Its schema is not included in the CustomResourceDefinition
+	// for Stages to keep the CRD's size manageable; the resulting Jobs are
+	// validated when they are created. Any ServiceAccount it specifies must be
+	// listed in the kargo.akuity.io/hook-service-accounts annotation of the
+	// project's namespace.
+	//
+	//+kubebuilder:validation:Schemaless
+	//+kubebuilder:validation:Type=object
+	//+kubebuilder:pruning:PreserveUnknownFields
+	Template batchv1.JobTemplateSpec `json:"template"`
+}
+
+// PromotionRetry describes how Promotions that fail due to transient errors
+// should be retried.
+type PromotionRetry struct {
+	// Limit is the maximum number of times a Promotion will be retried after its
+	// first attempt fails. This is a required field.
+	//
+	//+kubebuilder:validation:Minimum=0
+	Limit int32 `json:"limit"`
+	// Backoff is the length of time to wait before the first retry. The wait
+	// doubles with each subsequent retry. This is an optional field. If left
+	// unspecified, the default is 10 seconds.
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// MaxBackoff is the longest length of time to wait between retries. This is
+	// an optional field. If left unspecified, the default is 5 minutes.
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
 }
 
 // GitRepoUpdate describes updates that should be applied to a Git repository
@@ -166,6 +416,29 @@ type GitRepoUpdate struct {
 	// Helm describes how to use Helm to incorporate Freight into the Stage. This
 	// is mutually exclusive with the Render and Kustomize fields.
 	Helm *HelmPromotionMechanism `json:"helm,omitempty"`
+	// Commit optionally customizes the commits made to the repository. Settings
+	// specified here take precedence over any specified for the Stage's project
+	// using annotations on the project's namespace.
+	Commit *GitCommitOptions `json:"commit,omitempty"`
+}
+
+// GitCommitOptions customizes the commits made by a GitRepoUpdate.
+type GitCommitOptions struct {
+	// AuthorName is the name recorded as the author and committer of commits.
+	// This field is optional.
+	AuthorName string `json:"authorName,omitempty"`
+	// AuthorEmail is the email address recorded as the author and committer of
+	// commits. This field is optional.
+	AuthorEmail string `json:"authorEmail,omitempty"`
+	// MessageTemplate is a Go template from which commit messages are rendered.
+	// The template may reference .Project, .Stage, .Promotion, .Freight,
+	// .Initiator, .Changes (a list of the individual changes made) and .Summary
+	// (the message Kargo would otherwise have used). This field is optional.
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+	// DisableTrailers disables the Kargo-Project, Kargo-Stage, Kargo-Promotion,
+	// Kargo-Freight and Kargo-Initiator trailers that are otherwise appended to
+	// commit messages.
+	DisableTrailers bool `json:"disableTrailers,omitempty"`
 }
 
 // KargoRenderPromotionMechanism describes how to use Kargo Render to
@@ -305,6 +578,22 @@ type ArgoCDSourceUpdate struct {
 	//
 	//+kubebuilder:validation:Optional
 	Chart string `json:"chart,omitempty"`
+	// SourceIndex optionally identifies, by its zero-based position in the
+	// Application's spec.sources field, exactly which source of a multi-source
+	// Argo CD Application this update is intended for. When specified, the
+	// identified source must still match the RepoURL and Chart fields. This
+	// field is mutually exclusive with the Ref field.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Minimum=0
+	SourceIndex *int32 `json:"sourceIndex,omitempty"`
+	// Ref optionally identifies, by the value of its ref field, exactly which
+	// source of a multi-source Argo CD Application this update is intended for.
+	// When specified, the identified source must still match the RepoURL and
+	// Chart fields. This field is mutually exclusive with the SourceIndex field.
+	//
+	//+kubebuilder:validation:Optional
+	Ref string `json:"ref,omitempty"`
 	// UpdateTargetRevision is a bool indicating whether the source should be
 	// updated such that its TargetRevision field points at the most recently git
 	// commit (if RepoURL references a git repository) or chart version (if
@@ -357,6 +646,44 @@ type ArgoCDHelmImageUpdate struct {
 	Value ImageUpdateValueType `json:"value"`
 }
 
+// HealthChecks describes Kubernetes resources whose health should factor into
+// assessments of a Stage's health.
+type HealthChecks struct {
+	// Namespace is the namespace in which the resources described by the
+	// Resources field can be found. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Namespace string `json:"namespace"`
+	// Resources identifies the Kubernetes resources whose health should be
+	// assessed. Deployments, StatefulSets, and Argo Rollouts are assessed
+	// according to their kind-specific status fields. Any other kind of resource
+	// is assessed according to standard status conditions (Ready, Reconciling,
+	// and Stalled) and its observed generation.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Resources []ResourceHealthCheck `json:"resources"`
+}
+
+// ResourceHealthCheck identifies a single Kubernetes resource whose health
+// should factor into assessments of a Stage's health.
+type ResourceHealthCheck struct {
+	// APIVersion is the API version of the resource. e.g. apps/v1. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource. e.g. Deployment. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+	// Name is the name of the resource. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
 // StageStatus describes a Stages's current and recent Freight, health, and
 // more.
 type StageStatus struct {
@@ -376,6 +703,93 @@ type StageStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// CurrentPromotion is a reference to the currently Running promotion.
 	CurrentPromotion *PromotionInfo `json:"currentPromotion,omitempty"`
+	// Soak describes the progress of the Stage's current Freight toward
+	// satisfying the Stage's minimum soak duration. It is only populated for
+	// Stages that specify a minimum soak duration.
+	Soak *SoakStatus `json:"soak,omitempty"`
+	// LastPromotionTime is the time at which the Stage's current Freight was
+	// most recently promoted into the Stage.
+	LastPromotionTime *metav1.Time `json:"lastPromotionTime,omitempty"`
+	// Rollbacks is a record of recent automatic rollbacks of the Stage, most
+	// recent first. While the most recent rollback remains unacknowledged,
+	// auto-promotion of the Stage is paused.
+	Rollbacks []Rollback `json:"rollbacks,omitempty"`
+	// LastAutoPromotionDecision describes the outcome of the most recent
+	// evaluation of whether the Stage should be automatically promoted to new
+	// Freight.
+	LastAutoPromotionDecision *AutoPromotionDecision `json:"lastAutoPromotionDecision,omitempty"`
+	// Conditions contains the last observations of the Stage's current state.
+	//
+	//+patchMergeKey=type
+	//+patchStrategy=merge
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge"`
+}
+
+// AutoPromotionPaused returns a bool indicating whether auto-promotion of the
+// Stage is paused pending acknowledgement of its most recent automatic
+// rollback.
+func (s *StageStatus) AutoPromotionPaused() bool {
+	return len(s.Rollbacks) > 0 && !s.Rollbacks[0].Acknowledged
+}
+
+// RecordRollback prepends the provided Rollback to the StageStatus's record of
+// recent rollbacks, retaining no more than ten.
+func (s *StageStatus) RecordRollback(rollback Rollback) {
+	s.Rollbacks = append([]Rollback{rollback}, s.Rollbacks...)
+	const max = 10
+	if len(s.Rollbacks) > max {
+		s.Rollbacks = s.Rollbacks[:max]
+	}
+}
+
+// Rollback describes an automatic rollback of a Stage to its previous Freight.
+type Rollback struct {
+	// Time is the time at which the rollback was initiated.
+	Time metav1.Time `json:"time"`
+	// FromFreight is the ID of the Freight that was rolled back.
+	FromFreight string `json:"fromFreight"`
+	// ToFreight is the ID of the Freight that was restored.
+	ToFreight string `json:"toFreight"`
+	// Promotion is the name of the Promotion that was created to effect the
+	// rollback.
+	Promotion string `json:"promotion"`
+	// Reason describes why the rollback was initiated.
+	Reason string `json:"reason"`
+	// Acknowledged indicates whether a user has acknowledged the rollback.
+	// Auto-promotion of the Stage is paused until this is true.
+	Acknowledged bool `json:"acknowledged,omitempty"`
+}
+
+// AutoPromotionDecision describes the outcome of an evaluation of whether a
+// Stage should be automatically promoted to new Freight.
+type AutoPromotionDecision struct {
+	// Time is the time at which this decision was first reached. It is not
+	// updated when the same decision is reached repeatedly.
+	Time metav1.Time `json:"time"`
+	// Reason is a machine-readable explanation of the decision.
+	Reason string `json:"reason"`
+	// Message is a human-readable explanation of the decision.
+	Message string `json:"message,omitempty"`
+	// Freight is the ID of the Freight the decision concerns, if any.
+	Freight string `json:"freight,omitempty"`
+	// Promotion is the name of the Promotion that was created, if any.
+	Promotion string `json:"promotion,omitempty"`
+}
+
+// SoakStatus describes the progress of a Stage's current Freight toward
+// satisfying the Stage's minimum soak duration.
+type SoakStatus struct {
+	// Freight is the ID of the Freight that is soaking.
+	Freight string `json:"freight"`
+	// HealthySince is the time since which the Stage has been continuously
+	// Healthy with the Freight. It is empty whenever the Stage is not Healthy.
+	HealthySince *metav1.Time `json:"healthySince,omitempty"`
+	// Remaining is the length of time, as of the Stage's last reconciliation,
+	// for which the Stage must remain continuously Healthy before the Freight
+	// is qualified. It is empty whenever the Stage is not Healthy.
+	Remaining *metav1.Duration `json:"remaining,omitempty"`
 }
 
 // SimpleFreight is a simplified representation of a piece of Freight -- not a
@@ -393,6 +807,13 @@ type SimpleFreight struct {
 	Charts []Chart `json:"charts,omitempty"`
 }
 
+// UpdateID deterministically calculates a piece of SimpleFreight's ID based on
+// its contents and assigns it to the ID field. The ID is the same as that of a
+// piece of Freight referencing the same artifacts.
+func (f *SimpleFreight) UpdateID() {
+	f.ID = getFreightID(f.Commits, f.Images, f.Charts)
+}
+
 type SimpleFreightStack []SimpleFreight
 
 // Empty returns a bool indicating whether or not the SimpleFreightStack is
@@ -485,6 +906,9 @@ type Health struct {
 	Issues []string `json:"issues,omitempty"`
 	// ArgoCDApps describes the current state of any related ArgoCD Applications.
 	ArgoCDApps []ArgoCDAppStatus `json:"argoCDApps,omitempty"`
+	// Resources describes the current state of any Kubernetes resources
+	// referenced by the Stage's health checks.
+	Resources []ResourceHealthStatus `json:"resources,omitempty"`
 }
 
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
@@ -512,6 +936,23 @@ type ArgoCDAppSyncStatus struct {
 	Revisions []string           `json:"revisions,omitempty"`
 }
 
+// ResourceHealthStatus describes the current state of a single Kubernetes
+// resource referenced by a Stage's health checks.
+type ResourceHealthStatus struct {
+	// APIVersion is the API version of the resource.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// Status is the assessed health of the resource.
+	Status HealthState `json:"status,omitempty"`
+	// Message clarifies why the resource is in any state other than Healthy.
+	Message string `json:"message,omitempty"`
+}
+
 //+kubebuilder:object:root=true
 
 // StageList is a list of Stage resources.
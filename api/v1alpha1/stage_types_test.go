@@ -2,10 +2,84 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func TestQualificationRuleMinQualifications(t *testing.T) {
+	testCases := []struct {
+		name           string
+		rule           *QualificationRule
+		upstreamStages int
+		expectedResult int
+	}{
+		{
+			name:           "no upstream Stages",
+			rule:           &QualificationRule{Type: QualificationRuleTypeAll},
+			upstreamStages: 0,
+			expectedResult: 0,
+		},
+		{
+			name:           "rule is nil",
+			upstreamStages: 3,
+			expectedResult: 1,
+		},
+		{
+			name:           "Any",
+			rule:           &QualificationRule{Type: QualificationRuleTypeAny},
+			upstreamStages: 3,
+			expectedResult: 1,
+		},
+		{
+			name:           "All",
+			rule:           &QualificationRule{Type: QualificationRuleTypeAll},
+			upstreamStages: 3,
+			expectedResult: 3,
+		},
+		{
+			name: "Quorum",
+			rule: &QualificationRule{
+				Type:   QualificationRuleTypeQuorum,
+				Quorum: 2,
+			},
+			upstreamStages: 3,
+			expectedResult: 2,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expectedResult,
+				testCase.rule.MinQualifications(testCase.upstreamStages),
+			)
+		})
+	}
+}
+
+func TestSimpleFreightUpdateID(t *testing.T) {
+	freight := Freight{
+		Commits: []GitCommit{{RepoURL: "fake-git-repo", ID: "fake-commit-id"}},
+		Images:  []Image{{RepoURL: "fake-image-repo", Tag: "fake-tag"}},
+		Charts: []Chart{{
+			RegistryURL: "fake-registry",
+			Name:        "fake-chart",
+			Version:     "fake-version",
+		}},
+	}
+	freight.UpdateID()
+	simpleFreight := SimpleFreight{
+		Commits: freight.Commits,
+		Images:  freight.Images,
+		Charts:  freight.Charts,
+	}
+	simpleFreight.UpdateID()
+	require.NotEmpty(t, simpleFreight.ID)
+	require.Equal(t, freight.ID, simpleFreight.ID)
+}
+
 func TestSimpleFreightStackEmpty(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -149,3 +223,119 @@ func TestSimpleFreightStackPush(t *testing.T) {
 		})
 	}
 }
+
+func TestStageStatusAutoPromotionPaused(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   StageStatus
+		expected bool
+	}{
+		{
+			name:     "no rollbacks",
+			status:   StageStatus{},
+			expected: false,
+		},
+		{
+			name: "most recent rollback acknowledged",
+			status: StageStatus{
+				Rollbacks: []Rollback{{Acknowledged: true}, {}},
+			},
+			expected: false,
+		},
+		{
+			name: "most recent rollback not acknowledged",
+			status: StageStatus{
+				Rollbacks: []Rollback{{}, {Acknowledged: true}},
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.status.AutoPromotionPaused(),
+			)
+		})
+	}
+}
+
+func TestStageStatusRecordRollback(t *testing.T) {
+	testCases := []struct {
+		name              string
+		rollbacks         []Rollback
+		rollback          Rollback
+		expectedRollbacks []Rollback
+	}{
+		{
+			name:              "no previous rollbacks",
+			rollback:          Rollback{FromFreight: "foo"},
+			expectedRollbacks: []Rollback{{FromFreight: "foo"}},
+		},
+		{
+			name:      "previous rollbacks",
+			rollbacks: []Rollback{{FromFreight: "bar"}},
+			rollback:  Rollback{FromFreight: "foo"},
+			expectedRollbacks: []Rollback{
+				{FromFreight: "foo"},
+				{FromFreight: "bar"},
+			},
+		},
+		{
+			name: "record is full",
+			rollbacks: []Rollback{
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+			rollback: Rollback{FromFreight: "foo"},
+			expectedRollbacks: []Rollback{
+				{FromFreight: "foo"}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := StageStatus{Rollbacks: testCase.rollbacks}
+			status.RecordRollback(testCase.rollback)
+			require.Equal(t, testCase.expectedRollbacks, status.Rollbacks)
+		})
+	}
+}
+
+func TestStageLockIsActive(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name     string
+		lock     *StageLock
+		expected bool
+	}{
+		{
+			name:     "nil lock",
+			expected: false,
+		},
+		{
+			name:     "lock without expiry",
+			lock:     &StageLock{},
+			expected: true,
+		},
+		{
+			name: "lock not yet expired",
+			lock: &StageLock{
+				Until: &metav1.Time{Time: now.Add(time.Hour)},
+			},
+			expected: true,
+		},
+		{
+			name: "lock expired",
+			lock: &StageLock{
+				Until: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.lock.IsActive(now))
+		})
+	}
+}
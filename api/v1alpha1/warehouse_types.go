@@ -2,6 +2,14 @@ package v1alpha1
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+const (
+	// WarehouseConditionTypeDiscovering is the type of a Warehouse condition
+	// indicating whether the Warehouse is currently polling its subscriptions
+	// to discover new Freight. When discovery is not in progress, the
+	// condition's reason describes the outcome of the most recent attempt.
+	WarehouseConditionTypeDiscovering = "Discovering"
+)
+
 // +kubebuilder:validation:Enum={SemVer,NewestBuild,Alphabetical,Digest}
 type ImageUpdateStrategy string
 
@@ -14,6 +22,8 @@ const (
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name=Ready,type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Warehouse is a source of Freight.
 type Warehouse struct {
@@ -159,6 +169,14 @@ type WarehouseStatus struct {
 	// ObservedGeneration represents the .metadata.generation that this Warehouse
 	// was reconciled against.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions contains the last observations of the Warehouse's current
+	// state.
+	//
+	//+patchMergeKey=type
+	//+patchStrategy=merge
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge"`
 }
 
 //+kubebuilder:object:root=true
@@ -5,6 +5,8 @@
 package v1alpha1
 
 import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -140,6 +142,11 @@ func (in *ArgoCDKustomize) DeepCopy() *ArgoCDKustomize {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDSourceUpdate) DeepCopyInto(out *ArgoCDSourceUpdate) {
 	*out = *in
+	if in.SourceIndex != nil {
+		in, out := &in.SourceIndex, &out.SourceIndex
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Kustomize != nil {
 		in, out := &in.Kustomize, &out.Kustomize
 		*out = new(ArgoCDKustomize)
@@ -162,6 +169,93 @@ func (in *ArgoCDSourceUpdate) DeepCopy() *ArgoCDSourceUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactReferences) DeepCopyInto(out *ArtifactReferences) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Commits != nil {
+		in, out := &in.Commits, &out.Commits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactReferences.
+func (in *ArtifactReferences) DeepCopy() *ArtifactReferences {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactReferences)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactSelector) DeepCopyInto(out *ArtifactSelector) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = new(ArtifactReferences)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = new(ArtifactReferences)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactSelector.
+func (in *ArtifactSelector) DeepCopy() *ArtifactSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoPromotionDecision) DeepCopyInto(out *AutoPromotionDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoPromotionDecision.
+func (in *AutoPromotionDecision) DeepCopy() *AutoPromotionDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoPromotionDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollback) DeepCopyInto(out *AutoRollback) {
+	*out = *in
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollback.
+func (in *AutoRollback) DeepCopy() *AutoRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
@@ -192,6 +286,22 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Failure) DeepCopyInto(out *Failure) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Failure.
+func (in *Failure) DeepCopy() *Failure {
+	if in == nil {
+		return nil
+	}
+	out := new(Failure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -275,6 +385,13 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make(map[string]Failure, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightStatus.
@@ -302,6 +419,21 @@ func (in *GitCommit) DeepCopy() *GitCommit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitCommitOptions) DeepCopyInto(out *GitCommitOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCommitOptions.
+func (in *GitCommitOptions) DeepCopy() *GitCommitOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GitCommitOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 	*out = *in
@@ -320,6 +452,11 @@ func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 		*out = new(HelmPromotionMechanism)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Commit != nil {
+		in, out := &in.Commit, &out.Commit
+		*out = new(GitCommitOptions)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoUpdate.
@@ -347,6 +484,33 @@ func (in *GitSubscription) DeepCopy() *GitSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPNotificationSink) DeepCopyInto(out *HTTPNotificationSink) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HeadersSecret != nil {
+		in, out := &in.HeadersSecret, &out.HeadersSecret
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPNotificationSink.
+func (in *HTTPNotificationSink) DeepCopy() *HTTPNotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPNotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Health) DeepCopyInto(out *Health) {
 	*out = *in
@@ -362,6 +526,11 @@ func (in *Health) DeepCopyInto(out *Health) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceHealthStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Health.
@@ -374,6 +543,26 @@ func (in *Health) DeepCopy() *Health {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthChecks) DeepCopyInto(out *HealthChecks) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthChecks.
+func (in *HealthChecks) DeepCopy() *HealthChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmChartDependencyUpdate) DeepCopyInto(out *HelmChartDependencyUpdate) {
 	*out = *in
@@ -514,6 +703,203 @@ func (in *KustomizePromotionMechanism) DeepCopy() *KustomizePromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicy) DeepCopyInto(out *NotificationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(NotificationPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicy.
+func (in *NotificationPolicy) DeepCopy() *NotificationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicyList) DeepCopyInto(out *NotificationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicyList.
+func (in *NotificationPolicyList) DeepCopy() *NotificationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicySpec) DeepCopyInto(out *NotificationPolicySpec) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEventType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PromotionPhases != nil {
+		in, out := &in.PromotionPhases, &out.PromotionPhases
+		*out = make([]PromotionPhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicySpec.
+func (in *NotificationPolicySpec) DeepCopy() *NotificationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicyStatus) DeepCopyInto(out *NotificationPolicyStatus) {
+	*out = *in
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make(map[string]NotificationSinkStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicyStatus.
+func (in *NotificationPolicyStatus) DeepCopy() *NotificationPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSink) DeepCopyInto(out *NotificationSink) {
+	*out = *in
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackNotificationSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = new(TeamsNotificationSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPNotificationSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SMTP != nil {
+		in, out := &in.SMTP, &out.SMTP
+		*out = new(SMTPNotificationSink)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSink.
+func (in *NotificationSink) DeepCopy() *NotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkStatus) DeepCopyInto(out *NotificationSinkStatus) {
+	*out = *in
+	if in.LastDelivery != nil {
+		in, out := &in.LastDelivery, &out.LastDelivery
+		*out = new(NotificationDelivery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSuccessTime != nil {
+		in, out := &in.LastSuccessTime, &out.LastSuccessTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkStatus.
+func (in *NotificationSinkStatus) DeepCopy() *NotificationSinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Promotion) DeepCopyInto(out *Promotion) {
 	*out = *in
@@ -522,9 +908,9 @@ func (in *Promotion) DeepCopyInto(out *Promotion) {
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(PromotionSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Promotion.
@@ -545,6 +931,22 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionHook) DeepCopyInto(out *PromotionHook) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionHook.
+func (in *PromotionHook) DeepCopy() *PromotionHook {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionInfo) DeepCopyInto(out *PromotionInfo) {
 	*out = *in
@@ -610,6 +1012,30 @@ func (in *PromotionMechanisms) DeepCopyInto(out *PromotionMechanisms) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(PromotionRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreHooks != nil {
+		in, out := &in.PreHooks, &out.PreHooks
+		*out = make([]PromotionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostHooks != nil {
+		in, out := &in.PostHooks, &out.PostHooks
+		*out = make([]PromotionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionMechanisms.
@@ -679,9 +1105,39 @@ func (in *PromotionPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionRetry) DeepCopyInto(out *PromotionRetry) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRetry.
+func (in *PromotionRetry) DeepCopy() *PromotionRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 	*out = *in
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = new(ArtifactSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
@@ -697,6 +1153,24 @@ func (in *PromotionSpec) DeepCopy() *PromotionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 	*out = *in
+	if in.AttemptStartTime != nil {
+		in, out := &in.AttemptStartTime, &out.AttemptStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStepResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -709,6 +1183,29 @@ func (in *PromotionStatus) DeepCopy() *PromotionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepResult) DeepCopyInto(out *PromotionStepResult) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepResult.
+func (in *PromotionStepResult) DeepCopy() *PromotionStepResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Qualification) DeepCopyInto(out *Qualification) {
 	*out = *in
@@ -724,6 +1221,21 @@ func (in *Qualification) DeepCopy() *Qualification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QualificationRule) DeepCopyInto(out *QualificationRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QualificationRule.
+func (in *QualificationRule) DeepCopy() *QualificationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(QualificationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	*out = *in
@@ -754,6 +1266,77 @@ func (in *RepoSubscription) DeepCopy() *RepoSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealthCheck) DeepCopyInto(out *ResourceHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthCheck.
+func (in *ResourceHealthCheck) DeepCopy() *ResourceHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealthStatus) DeepCopyInto(out *ResourceHealthStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthStatus.
+func (in *ResourceHealthStatus) DeepCopy() *ResourceHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollback) DeepCopyInto(out *Rollback) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollback.
+func (in *Rollback) DeepCopy() *Rollback {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMTPNotificationSink) DeepCopyInto(out *SMTPNotificationSink) {
+	*out = *in
+	if in.CredentialsSecret != nil {
+		in, out := &in.CredentialsSecret, &out.CredentialsSecret
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPNotificationSink.
+func (in *SMTPNotificationSink) DeepCopy() *SMTPNotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(SMTPNotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SimpleFreight) DeepCopyInto(out *SimpleFreight) {
 	*out = *in
@@ -805,6 +1388,46 @@ func (in SimpleFreightStack) DeepCopy() SimpleFreightStack {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackNotificationSink) DeepCopyInto(out *SlackNotificationSink) {
+	*out = *in
+	in.WebhookURL.DeepCopyInto(&out.WebhookURL)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackNotificationSink.
+func (in *SlackNotificationSink) DeepCopy() *SlackNotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackNotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoakStatus) DeepCopyInto(out *SoakStatus) {
+	*out = *in
+	if in.HealthySince != nil {
+		in, out := &in.HealthySince, &out.HealthySince
+		*out = (*in).DeepCopy()
+	}
+	if in.Remaining != nil {
+		in, out := &in.Remaining, &out.Remaining
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoakStatus.
+func (in *SoakStatus) DeepCopy() *SoakStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SoakStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stage) DeepCopyInto(out *Stage) {
 	*out = *in
@@ -868,6 +1491,25 @@ func (in *StageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageLock) DeepCopyInto(out *StageLock) {
+	*out = *in
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageLock.
+func (in *StageLock) DeepCopy() *StageLock {
+	if in == nil {
+		return nil
+	}
+	out := new(StageLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 	*out = *in
@@ -881,6 +1523,26 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(PromotionMechanisms)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = new(HealthChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinimumSoakDuration != nil {
+		in, out := &in.MinimumSoakDuration, &out.MinimumSoakDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollback)
+		**out = **in
+	}
+	if in.Lock != nil {
+		in, out := &in.Lock, &out.Lock
+		*out = new(StageLock)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -918,6 +1580,34 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(PromotionInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Soak != nil {
+		in, out := &in.Soak, &out.Soak
+		*out = new(SoakStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastPromotionTime != nil {
+		in, out := &in.LastPromotionTime, &out.LastPromotionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Rollbacks != nil {
+		in, out := &in.Rollbacks, &out.Rollbacks
+		*out = make([]Rollback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastAutoPromotionDecision != nil {
+		in, out := &in.LastAutoPromotionDecision, &out.LastAutoPromotionDecision
+		*out = new(AutoPromotionDecision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageStatus.
@@ -953,6 +1643,11 @@ func (in *Subscriptions) DeepCopyInto(out *Subscriptions) {
 		*out = make([]StageSubscription, len(*in))
 		copy(*out, *in)
 	}
+	if in.QualificationRule != nil {
+		in, out := &in.QualificationRule, &out.QualificationRule
+		*out = new(QualificationRule)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subscriptions.
@@ -965,6 +1660,22 @@ func (in *Subscriptions) DeepCopy() *Subscriptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsNotificationSink) DeepCopyInto(out *TeamsNotificationSink) {
+	*out = *in
+	in.WebhookURL.DeepCopyInto(&out.WebhookURL)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsNotificationSink.
+func (in *TeamsNotificationSink) DeepCopy() *TeamsNotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsNotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Warehouse) DeepCopyInto(out *Warehouse) {
 	*out = *in
@@ -975,7 +1686,7 @@ func (in *Warehouse) DeepCopyInto(out *Warehouse) {
 		*out = new(WarehouseSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Warehouse.
@@ -1053,6 +1764,13 @@ func (in *WarehouseSpec) DeepCopy() *WarehouseSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
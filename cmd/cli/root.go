@@ -21,6 +21,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/get"
 	"github.com/akuity/kargo/internal/cli/login"
 	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/promotion"
 	"github.com/akuity/kargo/internal/cli/stage"
 )
 
@@ -57,6 +58,7 @@ func NewRootCommand(opt *option.Option, rs *rootState) (*cobra.Command, error) {
 						LocalMode: true,
 					},
 					client,
+					nil,
 				)
 				go srv.Serve(ctx, l) // nolint: errcheck
 				opt.LocalServerAddress = fmt.Sprintf("http://%s", l.Addr())
@@ -92,6 +94,7 @@ func NewRootCommand(opt *option.Option, rs *rootState) (*cobra.Command, error) {
 	cmd.AddCommand(delete.NewCommand(opt))
 	cmd.AddCommand(get.NewCommand(opt))
 	cmd.AddCommand(login.NewCommand(opt))
+	cmd.AddCommand(promotion.NewCommand(opt))
 	cmd.AddCommand(stage.NewCommand(opt))
 	cmd.AddCommand(newVersionCommand(opt))
 	cmd.AddCommand(
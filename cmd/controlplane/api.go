@@ -8,6 +8,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,8 +17,12 @@ import (
 	"github.com/akuity/kargo/internal/api"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/os"
+	"github.com/akuity/kargo/internal/types"
 	versionpkg "github.com/akuity/kargo/internal/version"
 )
 
@@ -60,7 +65,15 @@ func newAPICommand() *cobra.Command {
 				}).Info("SSO via OpenID Connect is enabled")
 			}
 
-			srv := api.NewServer(cfg, kubeClient)
+			var previewer promotion.Previewer
+			if types.MustParseBool(os.GetEnv("PROMOTION_PREVIEWS_ENABLED", "false")) {
+				if previewer, err = newPromotionPreviewer(restCfg); err != nil {
+					return pkgerrors.Wrap(err, "error creating promotion previewer")
+				}
+				log.Info("promotion previews are enabled")
+			}
+
+			srv := api.NewServer(cfg, kubeClient, previewer)
 			l, err := net.Listen(
 				"tcp",
 				fmt.Sprintf(
@@ -99,6 +112,10 @@ func newClientForAPI(ctx context.Context, r *rest.Config, scheme *runtime.Scheme
 	if err := kubeclient.IndexFreightByQualifiedStages(ctx, mgr); err != nil {
 		return nil, pkgerrors.Wrap(err, "index freight by qualified stages")
 	}
+	// Index Stages by upstream Stages
+	if err := kubeclient.IndexStagesByUpstreamStages(ctx, mgr); err != nil {
+		return nil, pkgerrors.Wrap(err, "index stages by upstream stages")
+	}
 	go func() {
 		if err := mgr.Start(ctx); err != nil {
 			panic(pkgerrors.Wrap(err, "start manager"))
@@ -106,3 +123,40 @@ func newClientForAPI(ctx context.Context, r *rest.Config, scheme *runtime.Scheme
 	}()
 	return mgr.GetClient(), nil
 }
+
+// newPromotionPreviewer returns a promotion.Previewer that uses the Kargo API
+// server's own credentials to read repository credentials and Argo CD
+// Applications.
+func newPromotionPreviewer(restCfg *rest.Config) (promotion.Previewer, error) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		return nil, pkgerrors.Wrap(
+			err,
+			"error adding Kubernetes core API to promotion previewer scheme",
+		)
+	}
+	if err := argocd.AddToScheme(scheme); err != nil {
+		return nil, pkgerrors.Wrap(
+			err,
+			"error adding Argo CD API to promotion previewer scheme",
+		)
+	}
+	c, err := client.New(restCfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error creating promotion previewer client")
+	}
+	var argoClientForCreds client.Client
+	if types.MustParseBool(
+		os.GetEnv("ARGOCD_ENABLE_CREDENTIAL_BORROWING", "false"),
+	) {
+		argoClientForCreds = c
+	}
+	return promotion.NewPreviewer(
+		c,
+		credentials.NewKubernetesDatabase(
+			os.GetEnv("ARGOCD_NAMESPACE", "argocd"),
+			c,
+			argoClientForCreds,
+		),
+	), nil
+}
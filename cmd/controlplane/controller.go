@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +17,7 @@ import (
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/controller/applications"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/notifications"
 	"github.com/akuity/kargo/internal/controller/promotions"
 	"github.com/akuity/kargo/internal/controller/stages"
 	"github.com/akuity/kargo/internal/controller/warehouses"
@@ -66,6 +68,13 @@ func newControllerCommand() *cobra.Command {
 							"scheme",
 					)
 				}
+				if err = batchv1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(
+						err,
+						"error adding Kubernetes batch API to Kargo controller manager "+
+							"scheme",
+					)
+				}
 				if err = kargoapi.AddToScheme(scheme); err != nil {
 					return errors.Wrap(
 						err,
@@ -173,6 +182,14 @@ func newControllerCommand() *cobra.Command {
 				return errors.Wrap(err, "error setting up Applications reconciler")
 			}
 
+			if err := notifications.SetupReconcilerWithManager(
+				ctx,
+				kargoMgr,
+				shardName,
+			); err != nil {
+				return errors.Wrap(err, "error setting up NotificationPolicies reconciler")
+			}
+
 			// No shard name == default controller. This is the only controller that
 			// should reconcile Warehouses.
 			if shardName == "" {
@@ -15,6 +15,7 @@ import (
 	"github.com/akuity/kargo/internal/os"
 	versionpkg "github.com/akuity/kargo/internal/version"
 	"github.com/akuity/kargo/internal/webhook/freight"
+	"github.com/akuity/kargo/internal/webhook/notificationpolicy"
 	"github.com/akuity/kargo/internal/webhook/promotion"
 	"github.com/akuity/kargo/internal/webhook/promotionpolicy"
 	"github.com/akuity/kargo/internal/webhook/stage"
@@ -72,7 +73,7 @@ func newWebhooksServerCommand() *cobra.Command {
 			if err = stage.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup Stage webhook")
 			}
-			if err = promotion.SetupWebhookWithManager(mgr); err != nil {
+			if err = promotion.SetupWebhookWithManager(promotion.WebhookConfigFromEnv(), mgr); err != nil {
 				return errors.Wrap(err, "setup Promotion webhook")
 			}
 			if err = promotionpolicy.SetupWebhookWithManager(mgr); err != nil {
@@ -81,6 +82,9 @@ func newWebhooksServerCommand() *cobra.Command {
 			if err = freight.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup Freight webhook")
 			}
+			if err = notificationpolicy.SetupWebhookWithManager(mgr); err != nil {
+				return errors.Wrap(err, "setup NotificationPolicy webhook")
+			}
 			if err = warehouse.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup Warehouse webhook")
 			}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// AbortPromotion requests that the specified Pending or Running Promotion be
+// aborted. The Promotion controller moves Pending Promotions directly to the
+// Aborted phase and stops Running Promotions between promotion mechanisms.
+func (s *server) AbortPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.AbortPromotionRequest],
+) (*connect.Response[svcv1alpha1.AbortPromotionResponse], error) {
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+
+	key := client.ObjectKey{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetName(),
+	}
+	promo, err := kargoapi.GetPromotion(ctx, s.client, key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf("promotion %q not found", key.String()),
+		)
+	}
+	if promo.Status.Phase.IsTerminal() {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"promotion %q cannot be aborted because it is already %s",
+				key.String(),
+				promo.Status.Phase,
+			),
+		)
+	}
+
+	if promo, err = kargoapi.AbortPromotion(ctx, s.client, key); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&svcv1alpha1.AbortPromotionResponse{
+		Promotion: typesv1alpha1.ToPromotionProto(*promo),
+	}), nil
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestAbortPromotion(t *testing.T) {
+	newPromo := func(name string, phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      name,
+			},
+			Spec: &kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: phase,
+			},
+		}
+	}
+	validProject := func(context.Context, string) error {
+		return nil
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.AbortPromotionRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.AbortPromotionResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.AbortPromotionRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AbortPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "fake-project",
+				Name:    "running-promotion",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AbortPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "Promotion not found",
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "fake-project",
+				Name:    "nonexistent-promotion",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AbortPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Promotion already terminal",
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "fake-project",
+				Name:    "succeeded-promotion",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.AbortPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "already Succeeded")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "fake-project",
+				Name:    "running-promotion",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.AbortPromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					kargoapi.LabelTrueValue,
+					res.Msg.GetPromotion().GetMetadata().GetAnnotations()[kargoapi.AnnotationKeyAbort],
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(mustNewScheme()).
+							WithObjects(
+								newPromo("running-promotion", kargoapi.PromotionPhaseRunning),
+								newPromo("succeeded-promotion", kargoapi.PromotionPhaseSucceeded),
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			testCase.server.client = kubeClient
+			testCase.assertions(
+				testCase.server.AbortPromotion(
+					ctx,
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
@@ -5,10 +5,14 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigyaml "sigs.k8s.io/yaml"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -21,21 +25,58 @@ func (s *server) CreateOrUpdateResource(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "parse manifest"))
 	}
 
+	dryRun := req.Msg.GetDryRun()
 	size := len(cluster) + len(namespaced)
 	res := make([]*svcv1alpha1.CreateOrUpdateResourceResult, 0, size)
+	// A dry run doesn't persist anything, so Projects created by the manifest
+	// are tracked to avoid rejecting the objects within them.
+	dryRunProjects := map[string]struct{}{}
 	for _, obj := range cluster {
-		res = append(res, s.createOrUpdateResource(ctx, obj))
+		result := s.createOrUpdateResource(ctx, obj, dryRun)
+		if dryRun && isProjectNamespace(obj) && result.GetCreatedResourceManifest() != nil {
+			dryRunProjects[obj.GetName()] = struct{}{}
+		}
+		res = append(res, result)
+	}
+	// Stages and Warehouses defined by the manifest are taken into account when
+	// validating the subscriptions of any Stage defined by the manifest.
+	typed, err := stagesAndWarehousesFromUnstructured(namespaced)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	pending := make([]client.Object, 0, len(typed))
+	for _, obj := range typed {
+		pending = append(pending, obj)
 	}
 	for _, obj := range namespaced {
-		if err := s.validateProject(ctx, obj.GetNamespace()); err != nil {
-			res = append(res, &svcv1alpha1.CreateOrUpdateResourceResult{
-				Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
-					Error: err.Error(),
-				},
-			})
-			continue
+		_, inDryRunProject := dryRunProjects[obj.GetNamespace()]
+		if !inDryRunProject {
+			if err := s.validateProject(ctx, obj.GetNamespace()); err != nil {
+				res = append(res, &svcv1alpha1.CreateOrUpdateResourceResult{
+					Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
+						Error: err.Error(),
+					},
+				})
+				continue
+			}
 		}
-		res = append(res, s.createOrUpdateResource(ctx, obj))
+		var warnings []string
+		if stage, ok := typed[obj].(*kargoapi.Stage); ok {
+			if warnings, err = s.validateStageSubscriptions(ctx, stage, pending...); err != nil {
+				res = append(res, newCreateOrUpdateResourceResultError(err))
+				continue
+			}
+		}
+		var result *svcv1alpha1.CreateOrUpdateResourceResult
+		if inDryRunProject {
+			// Nothing can exist in a Project that doesn't exist yet, and the API
+			// server would reject even a dry run creating something in it.
+			result = newWouldCreateResourceResult(obj)
+		} else {
+			result = s.createOrUpdateResource(ctx, obj, dryRun)
+		}
+		result.Warnings = warnings
+		res = append(res, result)
 	}
 	return &connect.Response[svcv1alpha1.CreateOrUpdateResourceResponse]{
 		Msg: &svcv1alpha1.CreateOrUpdateResourceResponse{
@@ -47,11 +88,18 @@ func (s *server) CreateOrUpdateResource(
 func (s *server) createOrUpdateResource(
 	ctx context.Context,
 	obj *unstructured.Unstructured,
+	dryRun bool,
 ) *svcv1alpha1.CreateOrUpdateResourceResult {
+	var createOpts []client.CreateOption
+	var updateOpts []client.UpdateOption
+	if dryRun {
+		createOpts = append(createOpts, client.DryRunAll)
+		updateOpts = append(updateOpts, client.DryRunAll)
+	}
 	if err := s.client.Get(ctx, client.ObjectKeyFromObject(obj), obj.DeepCopy()); err != nil {
 		if kubeerr.IsNotFound(err) {
 			// Create if resource not found
-			switch res := s.createResource(ctx, obj).Result.(type) {
+			switch res := s.createResource(ctx, obj, createOpts...).Result.(type) {
 			case *svcv1alpha1.CreateResourceResult_CreatedResourceManifest:
 				return &svcv1alpha1.CreateOrUpdateResourceResult{
 					Result: &svcv1alpha1.CreateOrUpdateResourceResult_CreatedResourceManifest{
@@ -68,7 +116,7 @@ func (s *server) createOrUpdateResource(
 	}
 
 	// Update if resource found
-	switch res := s.updateResource(ctx, obj).Result.(type) {
+	switch res := s.updateResource(ctx, obj, updateOpts...).Result.(type) {
 	case *svcv1alpha1.UpdateResourceResult_UpdatedResourceManifest:
 		return &svcv1alpha1.CreateOrUpdateResourceResult{
 			Result: &svcv1alpha1.CreateOrUpdateResourceResult_UpdatedResourceManifest{
@@ -82,6 +130,64 @@ func (s *server) createOrUpdateResource(
 	}
 }
 
+// stagesAndWarehousesFromUnstructured converts any Stages and Warehouses among
+// the provided objects to their typed equivalents, indexed by the original
+// objects. Other objects are ignored.
+func stagesAndWarehousesFromUnstructured(
+	objs []*unstructured.Unstructured,
+) (map[*unstructured.Unstructured]client.Object, error) {
+	res := map[*unstructured.Unstructured]client.Object{}
+	for _, obj := range objs {
+		var typed client.Object
+		switch obj.GroupVersionKind() {
+		case kargoapi.GroupVersion.WithKind("Stage"):
+			typed = &kargoapi.Stage{}
+		case kargoapi.GroupVersion.WithKind("Warehouse"):
+			typed = &kargoapi.Warehouse{}
+		default:
+			continue
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(
+			obj.Object,
+			typed,
+		); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"convert %s %q",
+				obj.GetKind(),
+				client.ObjectKeyFromObject(obj),
+			)
+		}
+		res[obj] = typed
+	}
+	return res, nil
+}
+
+// isProjectNamespace returns true if the provided object is a Namespace that
+// is labeled as a Project.
+func isProjectNamespace(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind() == corev1.SchemeGroupVersion.WithKind("Namespace") &&
+		obj.GetLabels()[kargoapi.LabelProjectKey] == kargoapi.LabelTrueValue
+}
+
+// newWouldCreateResourceResult reports the provided object as created by a dry
+// run without submitting it to the API server.
+func newWouldCreateResourceResult(
+	obj *unstructured.Unstructured,
+) *svcv1alpha1.CreateOrUpdateResourceResult {
+	manifest, err := sigyaml.Marshal(obj)
+	if err != nil {
+		return newCreateOrUpdateResourceResultError(
+			errors.Wrap(err, "marshal created manifest"),
+		)
+	}
+	return &svcv1alpha1.CreateOrUpdateResourceResult{
+		Result: &svcv1alpha1.CreateOrUpdateResourceResult_CreatedResourceManifest{
+			CreatedResourceManifest: manifest,
+		},
+	}
+}
+
 func newCreateOrUpdateResourceResultError(err error) *svcv1alpha1.CreateOrUpdateResourceResult {
 	return &svcv1alpha1.CreateOrUpdateResourceResult{
 		Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
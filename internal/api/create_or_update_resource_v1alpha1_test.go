@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/kubeclient/manifest"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestCreateOrUpdateResource(t *testing.T) {
+	const projectManifest = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: new-project
+  labels:
+    kargo.akuity.io/project: "true"
+`
+	const stagesManifest = `
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: fake-warehouse
+  namespace: new-project
+spec:
+  subscriptions:
+  - image:
+      repoURL: fake-image
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: fake-stage
+  namespace: new-project
+spec:
+  subscriptions:
+    warehouse: fake-warehouse
+`
+	testCases := []struct {
+		name       string
+		manifest   string
+		dryRun     bool
+		assertions func(
+			*svcv1alpha1.CreateOrUpdateResourceResponse,
+			error,
+			client.Client,
+		)
+	}{
+		{
+			name:     "dry run with Project created by the manifest",
+			manifest: projectManifest + stagesManifest,
+			dryRun:   true,
+			assertions: func(
+				res *svcv1alpha1.CreateOrUpdateResourceResponse,
+				err error,
+				c client.Client,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Results, 3)
+				for _, result := range res.Results {
+					require.Empty(t, result.GetError())
+					require.NotEmpty(t, result.GetCreatedResourceManifest())
+				}
+				require.Contains(
+					t,
+					string(res.Results[2].GetCreatedResourceManifest()),
+					"name: fake-stage",
+				)
+				var ns corev1.Namespace
+				require.Error(t, c.Get(context.Background(), client.ObjectKey{Name: "new-project"}, &ns))
+				var stages kargoapi.StageList
+				require.NoError(t, c.List(context.Background(), &stages))
+				require.Empty(t, stages.Items)
+			},
+		},
+		{
+			name:     "dry run with Project missing from the manifest",
+			manifest: stagesManifest,
+			dryRun:   true,
+			assertions: func(
+				res *svcv1alpha1.CreateOrUpdateResourceResponse,
+				err error,
+				_ client.Client,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Results, 2)
+				for _, result := range res.Results {
+					require.Contains(t, result.GetError(), "project not found")
+				}
+			},
+		},
+		{
+			name:     "Project created by the manifest",
+			manifest: projectManifest + stagesManifest,
+			assertions: func(
+				res *svcv1alpha1.CreateOrUpdateResourceResponse,
+				err error,
+				c client.Client,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Results, 3)
+				for _, result := range res.Results {
+					require.Empty(t, result.GetError())
+					require.NotEmpty(t, result.GetCreatedResourceManifest())
+				}
+				var stages kargoapi.StageList
+				require.NoError(t, c.List(context.Background(), &stages))
+				require.Len(t, stages.Items, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			internalClient := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				Build()
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			s := &server{
+				client:                    kubeClient,
+				externalValidateProjectFn: validation.ValidateProject,
+				checkStageSubscriptionsFn: kargo.CheckStageSubscriptions,
+				parseManifestFn:           manifest.NewParser(mustNewScheme()),
+			}
+			res, err := s.CreateOrUpdateResource(
+				ctx,
+				connect.NewRequest(&svcv1alpha1.CreateOrUpdateResourceRequest{
+					Manifest: []byte(testCase.manifest),
+					DryRun:   testCase.dryRun,
+				}),
+			)
+			var msg *svcv1alpha1.CreateOrUpdateResourceResponse
+			if res != nil {
+				msg = res.Msg
+			}
+			testCase.assertions(msg, err, internalClient)
+		})
+	}
+}
@@ -45,6 +45,10 @@ func (s *server) CreateStage(
 	if err := s.validateProject(ctx, stage.GetNamespace()); err != nil {
 		return nil, err
 	}
+	warnings, err := s.validateStageSubscriptions(ctx, &stage)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.client.Create(ctx, &stage); err != nil {
 		if kubeerr.IsAlreadyExists(err) {
 			return nil, connect.NewError(connect.CodeAlreadyExists, err)
@@ -52,6 +56,7 @@ func (s *server) CreateStage(
 		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "create stage"))
 	}
 	return connect.NewResponse(&svcv1alpha1.CreateStageResponse{
-		Stage: typesv1alpha1.ToStageProto(stage),
+		Stage:    typesv1alpha1.ToStageProto(stage),
+		Warnings: warnings,
 	}), nil
 }
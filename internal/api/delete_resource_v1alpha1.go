@@ -5,9 +5,10 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	sigyaml "sigs.k8s.io/yaml"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -22,6 +23,8 @@ func (s *server) DeleteResource(
 
 	size := len(namespaced) + len(cluster)
 	res := make([]*svcv1alpha1.DeleteResourceResult, 0, size)
+	// Stages already deleted by cascading from another resource in the manifest
+	cascaded := map[client.ObjectKey]struct{}{}
 	for _, obj := range namespaced {
 		if err := s.validateProject(ctx, obj.GetNamespace()); err != nil {
 			res = append(res, &svcv1alpha1.DeleteResourceResult{
@@ -31,10 +34,38 @@ func (s *server) DeleteResource(
 			})
 			continue
 		}
-		res = append(res, s.deleteResource(ctx, obj))
+		kind := obj.GroupVersionKind().GroupKind()
+		isStage := kind == kargoapi.GroupVersion.WithKind("Stage").GroupKind()
+		isWarehouse := kind == kargoapi.GroupVersion.WithKind("Warehouse").GroupKind()
+		if isStage {
+			if _, ok := cascaded[client.ObjectKeyFromObject(obj)]; ok {
+				continue
+			}
+		}
+		if req.Msg.GetCascade() && (isStage || isWarehouse) {
+			stages, err := s.listDownstreamStages(ctx, obj.GetNamespace(), kind.Kind, obj.GetName())
+			if err != nil {
+				res = append(res, &svcv1alpha1.DeleteResourceResult{
+					Result: &svcv1alpha1.DeleteResourceResult_Error{
+						Error: err.Error(),
+					},
+				})
+				continue
+			}
+			for i := range stages {
+				key := client.ObjectKeyFromObject(&stages[i])
+				if _, ok := cascaded[key]; ok {
+					continue
+				}
+				cascaded[key] = struct{}{}
+				stages[i].SetGroupVersionKind(kargoapi.GroupVersion.WithKind("Stage"))
+				res = append(res, s.deleteResource(ctx, &stages[i], req.Msg.GetForce()))
+			}
+		}
+		res = append(res, s.deleteResource(ctx, obj, req.Msg.GetForce()))
 	}
 	for _, obj := range cluster {
-		res = append(res, s.deleteResource(ctx, obj))
+		res = append(res, s.deleteResource(ctx, obj, req.Msg.GetForce()))
 	}
 	return &connect.Response[svcv1alpha1.DeleteResourceResponse]{
 		Msg: &svcv1alpha1.DeleteResourceResponse{
@@ -45,9 +76,10 @@ func (s *server) DeleteResource(
 
 func (s *server) deleteResource(
 	ctx context.Context,
-	obj *unstructured.Unstructured,
+	obj client.Object,
+	force bool,
 ) *svcv1alpha1.DeleteResourceResult {
-	if err := s.client.Delete(ctx, obj); err != nil {
+	if err := s.deleteObject(ctx, obj, force); err != nil {
 		return &svcv1alpha1.DeleteResourceResult{
 			Result: &svcv1alpha1.DeleteResourceResult_Error{
 				Error: errors.Wrap(err, "delete resource").Error(),
@@ -35,8 +35,29 @@ func (s *server) DeleteStage(
 		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if err := s.client.Delete(ctx, &stage); err != nil && !kubeerr.IsNotFound(err) {
+	var cascaded []string
+	if req.Msg.GetCascade() {
+		var err error
+		if cascaded, err = s.deleteDownstreamStages(
+			ctx,
+			req.Msg.GetProject(),
+			"Stage",
+			req.Msg.GetName(),
+			req.Msg.GetForce(),
+		); err != nil {
+			if kubeerr.IsForbidden(err) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if err := s.deleteObject(ctx, &stage, req.Msg.GetForce()); err != nil && !kubeerr.IsNotFound(err) {
+		if kubeerr.IsForbidden(err) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	return connect.NewResponse(&svcv1alpha1.DeleteStageResponse{}), nil
+	return connect.NewResponse(&svcv1alpha1.DeleteStageResponse{
+		CascadedStages: cascaded,
+	}), nil
 }
@@ -34,8 +34,29 @@ func (s *server) DeleteWarehouse(
 		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if err := s.client.Delete(ctx, &warehouse); err != nil && !kubeerr.IsNotFound(err) {
+	var cascaded []string
+	if req.Msg.GetCascade() {
+		var err error
+		if cascaded, err = s.deleteDownstreamStages(
+			ctx,
+			req.Msg.GetProject(),
+			"Warehouse",
+			req.Msg.GetName(),
+			req.Msg.GetForce(),
+		); err != nil {
+			if kubeerr.IsForbidden(err) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if err := s.deleteObject(ctx, &warehouse, req.Msg.GetForce()); err != nil && !kubeerr.IsNotFound(err) {
+		if kubeerr.IsForbidden(err) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	return connect.NewResponse(&svcv1alpha1.DeleteWarehouseResponse{}), nil
+	return connect.NewResponse(&svcv1alpha1.DeleteWarehouseResponse{
+		CascadedStages: cascaded,
+	}), nil
 }
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// listDownstreamStages returns all Stages in the specified project that
+// receive Freight, directly or indirectly, from the specified Stage or
+// Warehouse. Stages are ordered such that every Stage precedes the Stages it
+// subscribes to, which is the order in which they can be deleted without
+// leaving any of them with downstream subscribers.
+func (s *server) listDownstreamStages(
+	ctx context.Context,
+	project string,
+	kind string,
+	name string,
+) ([]kargoapi.Stage, error) {
+	var stages kargoapi.StageList
+	if err := s.client.List(ctx, &stages, client.InNamespace(project)); err != nil {
+		return nil, errors.Wrapf(err, "error listing Stages in namespace %q", project)
+	}
+	subscribers := func(kind, name string) []*kargoapi.Stage {
+		var subs []*kargoapi.Stage
+		for i := range stages.Items {
+			stage := &stages.Items[i]
+			if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+				continue
+			}
+			switch kind {
+			case "Warehouse":
+				if stage.Spec.Subscriptions.Warehouse == name {
+					subs = append(subs, stage)
+				}
+			case "Stage":
+				for _, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+					if upstream.Name == name && stage.Name != name {
+						subs = append(subs, stage)
+						break
+					}
+				}
+			}
+		}
+		return subs
+	}
+	var downstream []kargoapi.Stage
+	visited := map[string]struct{}{}
+	var visit func(kind, name string)
+	visit = func(kind, name string) {
+		for _, sub := range subscribers(kind, name) {
+			if _, ok := visited[sub.Name]; ok {
+				continue
+			}
+			visited[sub.Name] = struct{}{}
+			visit("Stage", sub.Name)
+			downstream = append(downstream, *sub)
+		}
+	}
+	if kind == "Stage" {
+		// Never include the Stage itself, even if its subscriptions are cyclic.
+		visited[name] = struct{}{}
+	}
+	visit(kind, name)
+	return downstream, nil
+}
+
+// deleteObject deletes the provided object. If force is true, the object is
+// first annotated to permit its deletion even though other resources depend on
+// it.
+func (s *server) deleteObject(
+	ctx context.Context,
+	obj client.Object,
+	force bool,
+) error {
+	if force {
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object)) // nolint: forcetypeassert
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[kargoapi.AnnotationKeyForceDelete] = kargoapi.LabelTrueValue
+		obj.SetAnnotations(annotations)
+		if err := s.client.Patch(ctx, obj, patch); err != nil {
+			return errors.Wrap(err, "error annotating object for forced deletion")
+		}
+	}
+	return s.client.Delete(ctx, obj)
+}
+
+// checkStagesDeletable returns a Forbidden error if any of the named Stages in
+// the specified project cannot be deleted without being forced, either because
+// it has a running Promotion or because a Stage other than the named ones
+// subscribes to it. This mirrors the checks made by the Stage webhook, so that
+// a cascading deletion can be refused before any Stage has been deleted
+// instead of stopping partway.
+func (s *server) checkStagesDeletable(
+	ctx context.Context,
+	project string,
+	names map[string]struct{},
+) error {
+	var stages kargoapi.StageList
+	if err := s.client.List(ctx, &stages, client.InNamespace(project)); err != nil {
+		return errors.Wrapf(err, "error listing Stages in namespace %q", project)
+	}
+	for _, stage := range stages.Items {
+		if _, ok := names[stage.Name]; !ok || stage.DeletionTimestamp != nil {
+			continue
+		}
+		var dependants []string
+		if stage.Status.CurrentPromotion != nil {
+			dependants = append(
+				dependants,
+				fmt.Sprintf("Promotion %q is running", stage.Status.CurrentPromotion.Name),
+			)
+		}
+		for _, sub := range stages.Items {
+			if _, ok := names[sub.Name]; ok || sub.DeletionTimestamp != nil ||
+				sub.Spec == nil || sub.Spec.Subscriptions == nil {
+				continue
+			}
+			for _, upstream := range sub.Spec.Subscriptions.UpstreamStages {
+				if upstream.Name == stage.Name {
+					dependants = append(
+						dependants,
+						fmt.Sprintf("Stage %q subscribes to it", sub.Name),
+					)
+					break
+				}
+			}
+		}
+		if len(dependants) > 0 {
+			return kubeerr.NewForbidden(
+				schema.GroupResource{
+					Group:    kargoapi.GroupVersion.Group,
+					Resource: "Stage",
+				},
+				stage.Name,
+				errors.Errorf(
+					"in use: %s; use force to delete it anyway",
+					strings.Join(dependants, "; "),
+				),
+			)
+		}
+	}
+	return nil
+}
+
+// deleteDownstreamStages deletes all Stages downstream of the specified Stage
+// or Warehouse and returns the names of the Stages that were deleted. Unless
+// force is true, nothing is deleted if any of those Stages, or the specified
+// Stage itself, cannot be deleted.
+func (s *server) deleteDownstreamStages(
+	ctx context.Context,
+	project string,
+	kind string,
+	name string,
+	force bool,
+) ([]string, error) {
+	stages, err := s.listDownstreamStages(ctx, project, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	if !force {
+		names := make(map[string]struct{}, len(stages)+1)
+		for _, stage := range stages {
+			names[stage.Name] = struct{}{}
+		}
+		if kind == "Stage" {
+			names[name] = struct{}{}
+		}
+		if err = s.checkStagesDeletable(ctx, project, names); err != nil {
+			return nil, err
+		}
+	}
+	deleted := make([]string, 0, len(stages))
+	for i := range stages {
+		if err := s.deleteObject(ctx, &stages[i], force); err != nil {
+			return deleted, errors.Wrapf(
+				err,
+				"error deleting downstream Stage %q",
+				stages[i].Name,
+			)
+		}
+		deleted = append(deleted, stages[i].Name)
+	}
+	return deleted, nil
+}
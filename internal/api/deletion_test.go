@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+)
+
+func newDeletionTestServer(
+	ctx context.Context,
+	t *testing.T,
+	objs ...client.Object,
+) *server {
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			NewInternalClient: func(
+				context.Context,
+				*rest.Config,
+				*runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().
+					WithScheme(mustNewScheme()).
+					WithObjects(objs...).
+					Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+	return &server{client: kubeClient}
+}
+
+func newDeletionTestStage(name, warehouse string, upstreams ...string) *kargoapi.Stage {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      name,
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				Warehouse: warehouse,
+			},
+		},
+	}
+	for _, upstream := range upstreams {
+		stage.Spec.Subscriptions.UpstreamStages = append(
+			stage.Spec.Subscriptions.UpstreamStages,
+			kargoapi.StageSubscription{Name: upstream},
+		)
+	}
+	return stage
+}
+
+func TestListDownstreamStages(t *testing.T) {
+	// fake-warehouse -> a -> b -> d
+	//                    \-> c -/
+	objs := []client.Object{
+		newDeletionTestStage("a", "fake-warehouse"),
+		newDeletionTestStage("b", "", "a"),
+		newDeletionTestStage("c", "", "a"),
+		newDeletionTestStage("d", "", "b", "c"),
+		newDeletionTestStage("unrelated", "other-warehouse"),
+	}
+	testCases := []struct {
+		name     string
+		kind     string
+		resource string
+		expected []string
+	}{
+		{
+			name:     "Warehouse",
+			kind:     "Warehouse",
+			resource: "fake-warehouse",
+			expected: []string{"d", "b", "c", "a"},
+		},
+		{
+			name:     "Stage",
+			kind:     "Stage",
+			resource: "a",
+			expected: []string{"d", "b", "c"},
+		},
+		{
+			name:     "Stage without subscribers",
+			kind:     "Stage",
+			resource: "d",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			stages, err := newDeletionTestServer(ctx, t, objs...).listDownstreamStages(
+				ctx,
+				"fake-project",
+				testCase.kind,
+				testCase.resource,
+			)
+			require.NoError(t, err)
+			var names []string
+			for _, stage := range stages {
+				names = append(names, stage.Name)
+			}
+			require.Equal(t, testCase.expected, names)
+		})
+	}
+}
+
+func TestDeleteDownstreamStages(t *testing.T) {
+	ctx := user.ContextWithInfo(
+		context.Background(),
+		user.Info{
+			IsAdmin: true,
+		},
+	)
+	s := newDeletionTestServer(
+		ctx,
+		t,
+		newDeletionTestStage("a", "fake-warehouse"),
+		newDeletionTestStage("b", "", "a"),
+	)
+	deleted, err := s.deleteDownstreamStages(ctx, "fake-project", "Stage", "a", true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, deleted)
+	var stages kargoapi.StageList
+	require.NoError(t, s.client.List(ctx, &stages, client.InNamespace("fake-project")))
+	require.Len(t, stages.Items, 1)
+	require.Equal(t, "a", stages.Items[0].Name)
+}
+
+func TestDeleteDownstreamStagesNotForced(t *testing.T) {
+	ctx := user.ContextWithInfo(
+		context.Background(),
+		user.Info{
+			IsAdmin: true,
+		},
+	)
+	withRunningPromotion := func(stage *kargoapi.Stage) *kargoapi.Stage {
+		stage.Status.CurrentPromotion = &kargoapi.PromotionInfo{
+			Name: "fake-promotion",
+		}
+		return stage
+	}
+	testCases := []struct {
+		name       string
+		objs       []client.Object
+		assertions func([]string, error, []kargoapi.Stage)
+	}{
+		{
+			name: "all Stages can be deleted",
+			objs: []client.Object{
+				newDeletionTestStage("a", "fake-warehouse"),
+				newDeletionTestStage("b", "", "a"),
+				newDeletionTestStage("c", "", "b"),
+			},
+			assertions: func(deleted []string, err error, remaining []kargoapi.Stage) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"c", "b"}, deleted)
+				require.Len(t, remaining, 1)
+			},
+		},
+		{
+			name: "downstream Stage cannot be deleted",
+			objs: []client.Object{
+				newDeletionTestStage("a", "fake-warehouse"),
+				newDeletionTestStage("b", "", "a"),
+				withRunningPromotion(newDeletionTestStage("c", "", "b")),
+			},
+			assertions: func(deleted []string, err error, remaining []kargoapi.Stage) {
+				require.True(t, kubeerr.IsForbidden(err))
+				require.Contains(t, err.Error(), `"c" is forbidden`)
+				require.Contains(t, err.Error(), `Promotion "fake-promotion" is running`)
+				require.Empty(t, deleted)
+				// Nothing was deleted
+				require.Len(t, remaining, 3)
+			},
+		},
+		{
+			name: "specified Stage cannot be deleted",
+			objs: []client.Object{
+				withRunningPromotion(newDeletionTestStage("a", "fake-warehouse")),
+				newDeletionTestStage("b", "", "a"),
+			},
+			assertions: func(deleted []string, err error, remaining []kargoapi.Stage) {
+				require.True(t, kubeerr.IsForbidden(err))
+				require.Contains(t, err.Error(), `"a" is forbidden`)
+				require.Empty(t, deleted)
+				require.Len(t, remaining, 2)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := newDeletionTestServer(ctx, t, testCase.objs...)
+			deleted, err :=
+				s.deleteDownstreamStages(ctx, "fake-project", "Stage", "a", false)
+			var stages kargoapi.StageList
+			require.NoError(
+				t,
+				s.client.List(ctx, &stages, client.InNamespace("fake-project")),
+			)
+			testCase.assertions(deleted, err, stages.Items)
+		})
+	}
+}
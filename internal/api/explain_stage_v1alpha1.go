@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/kubeclient"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// ExplainStage explains whether the specified Stage can currently be
+// auto-promoted and, for each piece of Freight available to it, whether that
+// Freight is eligible for auto-promotion and why.
+func (s *server) ExplainStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ExplainStageRequest],
+) (*connect.Response[svcv1alpha1.ExplainStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetName(),
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				req.Msg.GetName(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	blocker, err := s.getAutoPromotionBlocker(ctx, stage)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var candidates []kargoapi.Freight
+	if subs := stage.Spec.Subscriptions; subs != nil {
+		if subs.Warehouse != "" {
+			candidates, err =
+				s.getFreightFromWarehouseFn(ctx, stage.Namespace, subs.Warehouse)
+		} else if len(subs.UpstreamStages) > 0 {
+			candidates, err = s.getFreightQualifiedForUpstreamStagesFn(
+				ctx,
+				stage.Namespace,
+				subs.UpstreamStages,
+			)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	eligibilities := kargo.ExplainFreightEligibility(stage, candidates, blocker)
+	res := &svcv1alpha1.ExplainStageResponse{
+		Candidates: make([]*svcv1alpha1.FreightEligibility, len(eligibilities)),
+	}
+	if stage.Status.LastAutoPromotionDecision != nil {
+		res.LastDecision = typesv1alpha1.ToAutoPromotionDecisionProto(
+			*stage.Status.LastAutoPromotionDecision,
+		)
+	}
+	if blocker != nil {
+		res.Blocker = typesv1alpha1.ToAutoPromotionDecisionProto(*blocker)
+	}
+	for i, eligibility := range eligibilities {
+		res.Candidates[i] = &svcv1alpha1.FreightEligibility{
+			Freight:  eligibility.Freight.Name,
+			Created:  timestamppb.New(eligibility.Freight.CreationTimestamp.Time),
+			Eligible: eligibility.Eligible,
+			Reason:   eligibility.Reason,
+			Message:  eligibility.Message,
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+// getAutoPromotionBlocker returns a decision explaining why the provided
+// Stage cannot currently be auto-promoted, regardless of what Freight is
+// available to it. It returns nil if nothing blocks auto-promotion of the
+// Stage. Checks are made in the same order as the controller makes them.
+func (s *server) getAutoPromotionBlocker(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (*kargoapi.AutoPromotionDecision, error) {
+	if stage.Spec.Lock.IsActive(time.Now()) {
+		return &kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonLocked,
+			Message: "Auto-promotion is blocked while the Stage is locked",
+		}, nil
+	}
+	if stage.Status.AutoPromotionPaused() {
+		return &kargoapi.AutoPromotionDecision{
+			Reason: kargoapi.AutoPromotionReasonRollbackUnacknowledged,
+			Message: "Auto-promotion is paused pending acknowledgement of an " +
+				"automatic rollback",
+		}, nil
+	}
+	if decision :=
+		kargo.CheckAutoPromotionSubscriptions(stage.Spec.Subscriptions); decision != nil {
+		return decision, nil
+	}
+	var policies kargoapi.PromotionPolicyList
+	if err := s.listPromotionPoliciesFn(
+		ctx,
+		&policies,
+		client.InNamespace(stage.Namespace),
+		client.MatchingFields{
+			kubeclient.PromotionPoliciesByStageIndexField: stage.Name,
+		},
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing PromotionPolicies for Stage %q in namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	return kargo.CheckPromotionPolicies(policies.Items), nil
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestExplainStage(t *testing.T) {
+	now := time.Now()
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+		},
+		Status: kargoapi.StageStatus{
+			LastAutoPromotionDecision: &kargoapi.AutoPromotionDecision{
+				Reason: kargoapi.AutoPromotionReasonNoFreight,
+			},
+		},
+	}
+	testFreight := []kargoapi.Freight{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "older-freight",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "newer-freight",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ExplainStageRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.ExplainStageResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.ExplainStageRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ExplainStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.ExplainStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ExplainStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error listing PromotionPolicies",
+			req: &svcv1alpha1.ExplainStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return testStage.DeepCopy(), nil
+				},
+				listPromotionPoliciesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.ExplainStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "auto-promotion blocked",
+			req: &svcv1alpha1.ExplainStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return testStage.DeepCopy(), nil
+				},
+				listPromotionPoliciesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				getFreightFromWarehouseFn: func(
+					context.Context,
+					string,
+					string,
+				) ([]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonNoFreight,
+					res.Msg.GetLastDecision().GetReason(),
+				)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonNoPromotionPolicy,
+					res.Msg.GetBlocker().GetReason(),
+				)
+				candidates := res.Msg.GetCandidates()
+				require.Len(t, candidates, 2)
+				require.Equal(t, "newer-freight", candidates[0].GetFreight())
+				require.False(t, candidates[0].GetEligible())
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonNoPromotionPolicy,
+					candidates[0].GetReason(),
+				)
+				require.Equal(t, "older-freight", candidates[1].GetFreight())
+				require.Equal(
+					t,
+					kargo.FreightEligibilityReasonNotLatest,
+					candidates[1].GetReason(),
+				)
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.ExplainStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return testStage.DeepCopy(), nil
+				},
+				listPromotionPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{
+						{EnableAutoPromotion: true},
+					}
+					return nil
+				},
+				getFreightFromWarehouseFn: func(
+					context.Context,
+					string,
+					string,
+				) ([]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.ExplainStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Nil(t, res.Msg.GetBlocker())
+				candidates := res.Msg.GetCandidates()
+				require.Len(t, candidates, 2)
+				require.Equal(t, "newer-freight", candidates[0].GetFreight())
+				require.True(t, candidates[0].GetEligible())
+				require.Equal(
+					t,
+					kargo.FreightEligibilityReasonEligible,
+					candidates[0].GetReason(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.ExplainStage(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	projectGraphKindWarehouse = "Warehouse"
+	projectGraphKindStage     = "Stage"
+)
+
+func (s *server) GetProjectGraph(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetProjectGraphRequest],
+) (*connect.Response[svcv1alpha1.GetProjectGraphResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	var warehouses kargoapi.WarehouseList
+	if err := s.client.List(ctx, &warehouses, client.InNamespace(project)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var stages kargoapi.StageList
+	if err := s.client.List(ctx, &stages, client.InNamespace(project)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	sort.Slice(warehouses.Items, func(i, j int) bool {
+		return warehouses.Items[i].Name < warehouses.Items[j].Name
+	})
+	sort.Slice(stages.Items, func(i, j int) bool {
+		return stages.Items[i].Name < stages.Items[j].Name
+	})
+
+	res := &svcv1alpha1.GetProjectGraphResponse{
+		Nodes: make(
+			[]*svcv1alpha1.ProjectGraphNode,
+			0,
+			len(warehouses.Items)+len(stages.Items),
+		),
+	}
+	for _, warehouse := range warehouses.Items {
+		res.Nodes = append(res.Nodes, &svcv1alpha1.ProjectGraphNode{
+			Kind: projectGraphKindWarehouse,
+			Name: warehouse.Name,
+		})
+	}
+	now := time.Now()
+	for i := range stages.Items {
+		stage := &stages.Items[i]
+		node := &svcv1alpha1.ProjectGraphNode{
+			Kind: projectGraphKindStage,
+			Name: stage.Name,
+		}
+		if stage.Status.CurrentFreight != nil {
+			node.CurrentFreight = stage.Status.CurrentFreight.ID
+		}
+		if stage.Status.Health != nil {
+			node.Health = string(stage.Status.Health.Status)
+		}
+		if stage.Spec != nil && stage.Spec.Lock.IsActive(now) {
+			node.Locked = true
+			node.LockReason = stage.Spec.Lock.Reason
+		}
+		res.Nodes = append(res.Nodes, node)
+
+		if stage.Spec != nil && stage.Spec.Subscriptions != nil &&
+			stage.Spec.Subscriptions.Warehouse != "" {
+			res.Edges = append(res.Edges, &svcv1alpha1.ProjectGraphEdge{
+				FromKind: projectGraphKindWarehouse,
+				FromName: stage.Spec.Subscriptions.Warehouse,
+				ToKind:   projectGraphKindStage,
+				ToName:   stage.Name,
+			})
+		}
+
+		subscribers, err := s.findStageSubscribersFn(ctx, stage)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(subscribers, func(i, j int) bool {
+			return subscribers[i].Name < subscribers[j].Name
+		})
+		for _, subscriber := range subscribers {
+			res.Edges = append(res.Edges, &svcv1alpha1.ProjectGraphEdge{
+				FromKind: projectGraphKindStage,
+				FromName: stage.Name,
+				ToKind:   projectGraphKindStage,
+				ToName:   subscriber.Name,
+			})
+		}
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,234 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestGetProjectGraph(t *testing.T) {
+	const testProject = "fake-project"
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-warehouse",
+		},
+	}
+	testUpstreamStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-upstream-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+		},
+		Status: kargoapi.StageStatus{
+			CurrentFreight: &kargoapi.SimpleFreight{
+				ID: "fake-freight",
+			},
+			Health: &kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+		},
+	}
+	testDownstreamStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-downstream-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{Name: "fake-upstream-stage"},
+				},
+			},
+			Lock: &kargoapi.StageLock{
+				By:     "fake-user",
+				Reason: "fake-reason",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.GetProjectGraphRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.GetProjectGraphResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.GetProjectGraphRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.GetProjectGraphResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.GetProjectGraphRequest{
+				Project: testProject,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.GetProjectGraphResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error finding subscribers",
+			req: &svcv1alpha1.GetProjectGraphRequest{
+				Project: testProject,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.GetProjectGraphResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.GetProjectGraphRequest{
+				Project: testProject,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				findStageSubscribersFn: func(
+					_ context.Context,
+					stage *kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					if stage.Name == testUpstreamStage.Name {
+						return []kargoapi.Stage{*testDownstreamStage}, nil
+					}
+					return nil, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.GetProjectGraphResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]*svcv1alpha1.ProjectGraphNode{
+						{
+							Kind: "Warehouse",
+							Name: "fake-warehouse",
+						},
+						{
+							Kind:       "Stage",
+							Name:       "fake-downstream-stage",
+							Locked:     true,
+							LockReason: "fake-reason",
+						},
+						{
+							Kind:           "Stage",
+							Name:           "fake-upstream-stage",
+							CurrentFreight: "fake-freight",
+							Health:         "Healthy",
+						},
+					},
+					res.Msg.GetNodes(),
+				)
+				require.Equal(
+					t,
+					[]*svcv1alpha1.ProjectGraphEdge{
+						{
+							FromKind: "Warehouse",
+							FromName: "fake-warehouse",
+							ToKind:   "Stage",
+							ToName:   "fake-upstream-stage",
+						},
+						{
+							FromKind: "Stage",
+							FromName: "fake-upstream-stage",
+							ToKind:   "Stage",
+							ToName:   "fake-downstream-stage",
+						},
+					},
+					res.Msg.GetEdges(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(mustNewScheme()).
+							WithObjects(
+								testWarehouse,
+								testUpstreamStage,
+								testDownstreamStage,
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			testCase.server.client = kubeClient
+			testCase.assertions(
+				testCase.server.GetProjectGraph(
+					ctx,
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// LockStage locks the specified Stage, recording who locked it, why, and,
+// optionally, until when.
+func (s *server) LockStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.LockStageRequest],
+) (*connect.Response[svcv1alpha1.LockStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err
+	}
+	if req.Msg.GetReason() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("reason should not be empty"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+	lock := &kargoapi.StageLock{
+		By:     actorFromContext(ctx),
+		Reason: req.Msg.GetReason(),
+	}
+	if req.Msg.GetUntil() != nil {
+		lock.Until = &metav1.Time{Time: req.Msg.GetUntil().AsTime()}
+	}
+	stage, err := s.setStageLock(ctx, req.Msg.GetProject(), req.Msg.GetName(), lock)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&svcv1alpha1.LockStageResponse{
+		Stage: typesv1alpha1.ToStageProto(*stage),
+	}), nil
+}
+
+// UnlockStage removes any lock from the specified Stage.
+func (s *server) UnlockStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.UnlockStageRequest],
+) (*connect.Response[svcv1alpha1.UnlockStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+	stage, err := s.setStageLock(ctx, req.Msg.GetProject(), req.Msg.GetName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&svcv1alpha1.UnlockStageResponse{
+		Stage: typesv1alpha1.ToStageProto(*stage),
+	}), nil
+}
+
+// setStageLock sets the lock of the specified Stage to the provided StageLock.
+// A nil StageLock unlocks the Stage.
+func (s *server) setStageLock(
+	ctx context.Context,
+	project string,
+	name string,
+	lock *kargoapi.StageLock,
+) (*kargoapi.Stage, error) {
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      name,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf("Stage %q not found in namespace %q", name, project),
+		)
+	}
+	patch := client.MergeFrom(stage.DeepCopy())
+	stage.Spec.Lock = lock
+	if err = s.patchStageFn(ctx, stage, patch); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "patch stage"))
+	}
+	return stage, nil
+}
+
+// actorFromContext returns the name of the API user bound to the provided
+// context, for the purpose of recording who locked a Stage or requested a
+// Promotion.
+func actorFromContext(ctx context.Context) string {
+	userInfo, ok := user.InfoFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if userInfo.IsAdmin {
+		return "admin"
+	}
+	return userInfo.Username
+}
@@ -0,0 +1,272 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestLockStage(t *testing.T) {
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.LockStageRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.LockStageResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.LockStageRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.LockStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "reason not specified",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.LockStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "reason should not be empty")
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.LockStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.LockStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error patching Stage",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				patchStageFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.LockStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+				Until:   timestamppb.New(until),
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				patchStageFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.LockStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				lock := res.Msg.GetStage().GetSpec().GetLock()
+				require.NotNil(t, lock)
+				require.Equal(t, "fake-user", lock.GetBy())
+				require.Equal(t, "fake-reason", lock.GetReason())
+				require.Equal(t, until, lock.GetUntil().AsTime())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{Username: "fake-user"},
+			)
+			testCase.assertions(
+				testCase.server.LockStage(ctx, connect.NewRequest(testCase.req)),
+			)
+		})
+	}
+}
+
+func TestUnlockStage(t *testing.T) {
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.UnlockStageRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.UnlockStageResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.UnlockStageRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.UnlockStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.UnlockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+							Lock: &kargoapi.StageLock{
+								Reason: "fake-reason",
+							},
+						},
+					}, nil
+				},
+				patchStageFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.UnlockStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Nil(t, res.Msg.GetStage().GetSpec().GetLock())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.UnlockStage(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
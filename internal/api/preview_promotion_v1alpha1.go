@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// PreviewPromotion describes the changes that promoting a specified Stage to
+// the specified Freight would make, without making them or creating a
+// Promotion resource.
+func (s *server) PreviewPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.PreviewPromotionRequest],
+) (*connect.Response[svcv1alpha1.PreviewPromotionResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetStage()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if req.Msg.GetFreight() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("freight should not be empty"),
+		)
+	}
+	if s.previewPromotionFn == nil {
+		return nil, connect.NewError(
+			connect.CodeUnimplemented,
+			errors.New("promotion previews are not enabled on this server"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetStage(),
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				req.Msg.GetStage(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+	if stage.Spec.PromotionMechanisms == nil {
+		return connect.NewResponse(&svcv1alpha1.PreviewPromotionResponse{}), nil
+	}
+
+	// As when promoting, only Freight that is qualified for enough of the
+	// Stage's upstream Stages may be previewed.
+	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+		upstreamStages[i] = upstreamStage.Name
+	}
+	freight, err := s.getQualifiedFreightFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetFreight(),
+		},
+		upstreamStages,
+		stage.Spec.Subscriptions.QualificationRule,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"no qualified Freight %q found in namespace %q",
+				req.Msg.GetFreight(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	newFreight := kargoapi.SimpleFreight{
+		ID:      freight.ID,
+		Commits: freight.Commits,
+		Images:  freight.Images,
+		Charts:  freight.Charts,
+	}
+	if artifacts :=
+		typesv1alpha1.FromArtifactSelectorProto(req.Msg.GetArtifacts()); artifacts != nil {
+		if newFreight, err = kargo.ComposeFreight(
+			newFreight,
+			stage.Status.CurrentFreight,
+			*artifacts,
+		); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	preview := promotion.Preview{}
+	if err = s.previewPromotionFn(ctx, stage, newFreight, &preview); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &svcv1alpha1.PreviewPromotionResponse{
+		GitRepoDiffs: make([]*svcv1alpha1.GitRepoDiff, len(preview.GitRepoDiffs)),
+		ArgocdAppPatches: make(
+			[]*svcv1alpha1.ArgoCDAppPatch,
+			len(preview.ArgoCDAppPatches),
+		),
+	}
+	for i, diff := range preview.GitRepoDiffs {
+		res.GitRepoDiffs[i] = &svcv1alpha1.GitRepoDiff{
+			Mechanism: diff.Mechanism,
+			RepoUrl:   diff.RepoURL,
+			Branch:    diff.Branch,
+			Diff:      diff.Diff,
+		}
+	}
+	for i, patch := range preview.ArgoCDAppPatches {
+		res.ArgocdAppPatches[i] = &svcv1alpha1.ArgoCDAppPatch{
+			Namespace: patch.Namespace,
+			Name:      patch.Name,
+			Patch:     patch.Patch,
+		}
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,260 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPreviewPromotion(t *testing.T) {
+	testReq := &svcv1alpha1.PreviewPromotionRequest{
+		Project: "fake-project",
+		Stage:   "fake-stage",
+		Freight: "fake-freight",
+	}
+	testStage := &kargoapi.Stage{
+		Spec: &kargoapi.StageSpec{
+			Subscriptions:       &kargoapi.Subscriptions{},
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+		},
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.PreviewPromotionRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.PreviewPromotionResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.PreviewPromotionRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name:   "previews not enabled",
+			req:    testReq,
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeUnimplemented, connErr.Code())
+			},
+		},
+		{
+			name: "Stage not found",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.SimpleFreight,
+					*promotion.Preview,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "Stage")
+			},
+		},
+		{
+			name: "Freight not qualified",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return testStage, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.SimpleFreight,
+					*promotion.Preview,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "no qualified Freight")
+			},
+		},
+		{
+			name: "error previewing",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return testStage, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.SimpleFreight,
+					*promotion.Preview,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+				require.Equal(t, "something went wrong", connErr.Message())
+			},
+		},
+		{
+			name: "success",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return testStage, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ID: "fake-freight",
+					}, nil
+				},
+				previewPromotionFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					freight kargoapi.SimpleFreight,
+					preview *promotion.Preview,
+				) error {
+					require.Equal(t, "fake-freight", freight.ID)
+					preview.GitRepoDiffs = []promotion.GitRepoDiff{
+						{
+							Mechanism: "fake-mechanism",
+							RepoURL:   "fake-url",
+							Branch:    "fake-branch",
+							Diff:      "fake-diff",
+						},
+					}
+					preview.ArgoCDAppPatches = []promotion.ArgoCDAppPatch{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-app",
+							Patch:     "{}",
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetGitRepoDiffs(), 1)
+				require.Equal(t, "fake-mechanism", res.Msg.GetGitRepoDiffs()[0].GetMechanism())
+				require.Equal(t, "fake-url", res.Msg.GetGitRepoDiffs()[0].GetRepoUrl())
+				require.Equal(t, "fake-branch", res.Msg.GetGitRepoDiffs()[0].GetBranch())
+				require.Equal(t, "fake-diff", res.Msg.GetGitRepoDiffs()[0].GetDiff())
+				require.Len(t, res.Msg.GetArgocdAppPatches(), 1)
+				require.Equal(t, "fake-namespace", res.Msg.GetArgocdAppPatches()[0].GetNamespace())
+				require.Equal(t, "fake-app", res.Msg.GetArgocdAppPatches()[0].GetName())
+				require.Equal(t, "{}", res.Msg.GetArgocdAppPatches()[0].GetPatch())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.server.PreviewPromotion(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(res, err)
+		})
+	}
+}
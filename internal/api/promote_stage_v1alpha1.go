@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/kargo"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
@@ -47,12 +48,13 @@ func (s *server) PromoteStage(
 	}
 
 	// Get the specified Freight. Expect a nil if it is either not found or is
-	// not qualified for any of the upstream Stages. Errors are internal problems.
+	// not qualified for enough of the upstream Stages. Errors are internal
+	// problems.
 	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
 	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
 		upstreamStages[i] = upstreamStage.Name
 	}
-	if freight, err := s.getQualifiedFreightFn(
+	freight, err := s.getQualifiedFreightFn(
 		ctx,
 		s.client,
 		types.NamespacedName{
@@ -60,9 +62,12 @@ func (s *server) PromoteStage(
 			Name:      req.Msg.GetFreight(),
 		},
 		upstreamStages,
-	); err != nil {
+		stage.Spec.Subscriptions.QualificationRule,
+	)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
-	} else if freight == nil {
+	}
+	if freight == nil {
 		return nil, connect.NewError(
 			connect.CodeNotFound,
 			errors.Errorf(
@@ -73,7 +78,37 @@ func (s *server) PromoteStage(
 		)
 	}
 
+	// If only some of the Freight's artifacts are to be promoted, verify up
+	// front that they can be composed with the remaining artifacts of the
+	// Stage's current Freight. The Promotion itself composes them again when it
+	// is executed, as the Stage's current Freight may change in the meantime.
+	artifacts := typesv1alpha1.FromArtifactSelectorProto(req.Msg.GetArtifacts())
+	if artifacts != nil {
+		if _, err = kargo.ComposeFreight(
+			kargoapi.SimpleFreight{
+				ID:      freight.ID,
+				Commits: freight.Commits,
+				Images:  freight.Images,
+				Charts:  freight.Charts,
+			},
+			stage.Status.CurrentFreight,
+			*artifacts,
+		); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
 	promotion := kargo.NewPromotion(*stage, req.Msg.GetFreight())
+	promotion.Spec.Artifacts = artifacts
+	setCreateActor(ctx, &promotion)
+	if req.Msg.GetOverrideLock() {
+		// The webhook will verify that the user is permitted to do this
+		if promotion.Annotations == nil {
+			promotion.Annotations = map[string]string{}
+		}
+		promotion.Annotations[kargoapi.AnnotationKeyOverrideStageLock] =
+			kargoapi.LabelTrueValue
+	}
 	if err := s.createPromotionFn(ctx, &promotion); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -81,3 +116,19 @@ func (s *server) PromoteStage(
 		Promotion: typesv1alpha1.ToPromotionProto(promotion),
 	}), nil
 }
+
+// setCreateActor annotates the provided Promotion with the name of the API user
+// bound to the provided context. The API server creates Promotions using its
+// own credentials, so without this, the user who requested the Promotion would
+// not be known. If the user cannot be identified, the Promotion webhook records
+// the creator instead.
+func setCreateActor(ctx context.Context, promo *kargoapi.Promotion) {
+	actor := actorFromContext(ctx)
+	if actor == "" {
+		return
+	}
+	if promo.Annotations == nil {
+		promo.Annotations = map[string]string{}
+	}
+	promo.Annotations[kargoapi.AnnotationKeyCreateActor] = actor
+}
@@ -11,7 +11,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
 
 func TestPromoteStage(t *testing.T) {
@@ -149,6 +151,7 @@ func TestPromoteStage(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -197,6 +200,7 @@ func TestPromoteStage(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -246,6 +250,7 @@ func TestPromoteStage(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -301,6 +306,7 @@ func TestPromoteStage(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -321,6 +327,196 @@ func TestPromoteStage(t *testing.T) {
 				require.NotNil(t, res.Msg.GetPromotion())
 			},
 		},
+		{
+			name: "success overriding Stage lock",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project:      "fake-project",
+				Name:         "fake-stage",
+				Freight:      "fake-freight",
+				OverrideLock: true,
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								UpstreamStages: []kargoapi.StageSubscription{
+									{
+										Name: "fake-upstream-stage",
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					require.Equal(
+						t,
+						kargoapi.LabelTrueValue,
+						obj.GetAnnotations()[kargoapi.AnnotationKeyOverrideStageLock],
+					)
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.NotNil(t, res.Msg.GetPromotion())
+			},
+		},
+		{
+			name: "artifact selector cannot be satisfied",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Freight: "fake-freight",
+				Artifacts: &v1alpha1.ArtifactSelector{
+					Include: &v1alpha1.ArtifactReferences{
+						Images: []string{"fake-other-image"},
+					},
+				},
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+					}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Images: []kargoapi.Image{{RepoURL: "fake-image"}},
+					}, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "fake-other-image")
+			},
+		},
+		{
+			name: "success promoting selected artifacts",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Freight: "fake-freight",
+				Artifacts: &v1alpha1.ArtifactSelector{
+					Include: &v1alpha1.ArtifactReferences{
+						Images: []string{"fake-image"},
+					},
+				},
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouse: "fake-warehouse",
+							},
+						},
+						Status: kargoapi.StageStatus{
+							CurrentFreight: &kargoapi.SimpleFreight{
+								Charts: []kargoapi.Chart{
+									{Name: "fake-chart", Version: "1.0.0"},
+								},
+							},
+						},
+					}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Images: []kargoapi.Image{{RepoURL: "fake-image"}},
+						Charts: []kargoapi.Chart{
+							{Name: "fake-chart", Version: "2.0.0"},
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					require.True(t, ok)
+					require.Equal(
+						t,
+						&kargoapi.ArtifactSelector{
+							Include: &kargoapi.ArtifactReferences{
+								Images: []string{"fake-image"},
+							},
+						},
+						promo.Spec.Artifacts,
+					)
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(
+					t,
+					res.Msg.GetPromotion().GetSpec().GetArtifacts().GetInclude(),
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -333,3 +529,43 @@ func TestPromoteStage(t *testing.T) {
 		})
 	}
 }
+
+func TestSetCreateActor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		expected map[string]string
+	}{
+		{
+			name: "no user",
+			ctx:  context.Background(),
+		},
+		{
+			name: "admin",
+			ctx: user.ContextWithInfo(
+				context.Background(),
+				user.Info{IsAdmin: true},
+			),
+			expected: map[string]string{
+				kargoapi.AnnotationKeyCreateActor: "admin",
+			},
+		},
+		{
+			name: "named user",
+			ctx: user.ContextWithInfo(
+				context.Background(),
+				user.Info{Username: "fake-user"},
+			),
+			expected: map[string]string{
+				kargoapi.AnnotationKeyCreateActor: "fake-user",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			promo := &kargoapi.Promotion{}
+			setCreateActor(testCase.ctx, promo)
+			require.Equal(t, testCase.expected, promo.Annotations)
+		})
+	}
+}
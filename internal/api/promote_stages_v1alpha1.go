@@ -0,0 +1,311 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// promotionPollInterval is how often a Promotion is checked for completion
+// while a wave of PromoteStages is in progress.
+const promotionPollInterval = 5 * time.Second
+
+// PromoteStages creates Promotion resources to transition every Stage matching
+// the specified label selector, and into which the specified Freight is
+// eligible for promotion, into the state represented by that Freight. Stages
+// are promoted in waves of a configurable size and the outcome for each
+// matching Stage is streamed back to the client as it becomes known.
+//
+// Waves are coordinated by this handler for as long as the client remains
+// connected. Nothing about the rollout is persisted, so if the client
+// disconnects, no further waves are started and an error identifying the
+// Stages for which no Promotion was created is returned. Promotions that were
+// already created are unaffected.
+func (s *server) PromoteStages(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.PromoteStagesRequest],
+	stream *connect.ServerStream[svcv1alpha1.PromoteStagesResponse],
+) error {
+	if req.Msg.GetProject() == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if req.Msg.GetSelector() == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("selector should not be empty"))
+	}
+	if req.Msg.GetFreight() == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("freight should not be empty"))
+	}
+	selector, err := labels.Parse(req.Msg.GetSelector())
+	if err != nil {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Wrap(err, "invalid selector"),
+		)
+	}
+	var pause time.Duration
+	if req.Msg.GetPause() != "" {
+		if pause, err = time.ParseDuration(req.Msg.GetPause()); err != nil {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Wrap(err, "invalid pause"),
+			)
+		}
+		if pause < 0 {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("pause should not be negative"),
+			)
+		}
+	}
+	if err = s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return err
+	}
+
+	// Whether the Freight has qualified for each Stage's upstream Stages is
+	// checked individually below, so any Freight is acceptable here.
+	freight, err := s.getQualifiedFreightFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetFreight(),
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if freight == nil {
+		return connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Freight %q not found in namespace %q",
+				req.Msg.GetFreight(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	var stages kargoapi.StageList
+	if err = s.listStagesFn(
+		ctx,
+		&stages,
+		client.InNamespace(req.Msg.GetProject()),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if len(stages.Items) == 0 {
+		return connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"no Stages matching selector %q found in namespace %q",
+				req.Msg.GetSelector(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+	sort.Slice(stages.Items, func(i, j int) bool {
+		return stages.Items[i].Name < stages.Items[j].Name
+	})
+
+	// Report every Stage the Freight is not eligible for up front so that
+	// skipped Stages never occupy a place in a wave.
+	eligible := make([]kargoapi.Stage, 0, len(stages.Items))
+	now := time.Now()
+	for _, stage := range stages.Items {
+		reason := kargo.CheckPromotionEligibility(stage, *freight, now)
+		if reason == "" {
+			eligible = append(eligible, stage)
+			continue
+		}
+		if err = stream.Send(&svcv1alpha1.PromoteStagesResponse{
+			Stage:   stage.Name,
+			Outcome: kargo.StagePromotionOutcomeSkipped,
+			Message: reason,
+		}); err != nil {
+			return errors.Wrap(err, "send response")
+		}
+	}
+
+	batchSize := int(req.Msg.GetBatchSize())
+	if batchSize == 0 {
+		batchSize = len(eligible)
+	}
+	for start, wave := 0, uint32(1); start < len(eligible); start, wave = start+batchSize, wave+1 {
+		if start > 0 && pause > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(pause):
+			}
+		}
+		if ctx.Err() != nil {
+			return rolloutInterruptedError(ctx, eligible[start:])
+		}
+		end := start + batchSize
+		if end > len(eligible) {
+			end = len(eligible)
+		}
+		failed, err := s.promoteWave(
+			ctx,
+			stream,
+			eligible[start:end],
+			req.Msg.GetFreight(),
+			wave,
+			req.Msg.GetBatchSize() > 0,
+		)
+		if err != nil {
+			if ctx.Err() != nil && end < len(eligible) {
+				return rolloutInterruptedError(ctx, eligible[end:])
+			}
+			return err
+		}
+		if failed && req.Msg.GetHaltOnFailure() {
+			for _, stage := range eligible[end:] {
+				if err = stream.Send(&svcv1alpha1.PromoteStagesResponse{
+					Stage:   stage.Name,
+					Outcome: kargo.StagePromotionOutcomeHalted,
+					Message: fmt.Sprintf("A Promotion in wave %d failed", wave),
+				}); err != nil {
+					return errors.Wrap(err, "send response")
+				}
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// rolloutInterruptedError returns an error indicating that the stream for a
+// PromoteStages request was closed before Promotions were created for the
+// provided Stages.
+func rolloutInterruptedError(
+	ctx context.Context,
+	remaining []kargoapi.Stage,
+) error {
+	names := make([]string, len(remaining))
+	for i, stage := range remaining {
+		names[i] = stage.Name
+	}
+	code := connect.CodeCanceled
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		code = connect.CodeDeadlineExceeded
+	}
+	return connect.NewError(
+		code,
+		errors.Wrapf(
+			ctx.Err(),
+			"stream closed before Promotions were created for Stages %s",
+			strings.Join(names, ", "),
+		),
+	)
+}
+
+// promoteWave creates a Promotion for each of the provided Stages and, if wait
+// is true, waits for all of them to complete. The outcome for each Stage is
+// sent to the provided stream. It returns true if any Promotion could not be
+// created or did not succeed. Errors are returned only if the outcome cannot
+// be determined or reported.
+func (s *server) promoteWave(
+	ctx context.Context,
+	stream *connect.ServerStream[svcv1alpha1.PromoteStagesResponse],
+	stages []kargoapi.Stage,
+	freight string,
+	wave uint32,
+	wait bool,
+) (bool, error) {
+	var failed bool
+	results := make([]*svcv1alpha1.PromoteStagesResponse, len(stages))
+	promos := make([]*kargoapi.Promotion, len(stages))
+	for i, stage := range stages {
+		results[i] = &svcv1alpha1.PromoteStagesResponse{
+			Stage: stage.Name,
+			Wave:  wave,
+		}
+		promo := kargo.NewPromotion(stage, freight)
+		setCreateActor(ctx, &promo)
+		if err := s.createPromotionFn(ctx, &promo); err != nil {
+			failed = true
+			results[i].Outcome = kargo.StagePromotionOutcomeFailed
+			results[i].Message = fmt.Sprintf("error creating Promotion: %s", err)
+			continue
+		}
+		promos[i] = &promo
+		results[i].Outcome = kargo.StagePromotionOutcomeCreated
+		results[i].Promotion = typesv1alpha1.ToPromotionProto(promo)
+	}
+	for i, promo := range promos {
+		if promo != nil && wait {
+			completed, err := s.waitForPromotionFn(ctx, promo)
+			if err != nil {
+				return failed, connect.NewError(connect.CodeInternal, err)
+			}
+			results[i].Promotion = typesv1alpha1.ToPromotionProto(*completed)
+			if completed.Status.Phase == kargoapi.PromotionPhaseSucceeded {
+				results[i].Outcome = kargo.StagePromotionOutcomeSucceeded
+			} else {
+				failed = true
+				results[i].Outcome = kargo.StagePromotionOutcomeFailed
+				results[i].Message = fmt.Sprintf(
+					"Promotion %s %s",
+					completed.Name,
+					completed.Status.Phase,
+				)
+				if completed.Status.Error != "" {
+					results[i].Message += ": " + completed.Status.Error
+				}
+			}
+		}
+		if err := stream.Send(results[i]); err != nil {
+			return failed, errors.Wrap(err, "send response")
+		}
+	}
+	return failed, nil
+}
+
+// waitForPromotion polls the provided Promotion until it reaches a terminal
+// phase and returns its final state.
+func (s *server) waitForPromotion(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*kargoapi.Promotion, error) {
+	ticker := time.NewTicker(promotionPollInterval)
+	defer ticker.Stop()
+	for {
+		current := &kargoapi.Promotion{}
+		if err := s.client.Get(
+			ctx,
+			client.ObjectKeyFromObject(promo),
+			current,
+		); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error getting Promotion %q in namespace %q",
+				promo.Name,
+				promo.Namespace,
+			)
+		}
+		if current.Status.Phase.IsTerminal() {
+			return current, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
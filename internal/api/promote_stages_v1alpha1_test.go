@@ -0,0 +1,394 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+func TestPromoteStages(t *testing.T) {
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-freight",
+		},
+		Status: kargoapi.FreightStatus{
+			Qualifications: map[string]kargoapi.Qualification{
+				"upstream": {},
+			},
+		},
+	}
+	newStage := func(name string) kargoapi.Stage {
+		return kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      name,
+			},
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{
+					UpstreamStages: []kargoapi.StageSubscription{
+						{Name: "upstream"},
+					},
+				},
+			},
+		}
+	}
+	lockedStage := newStage("locked")
+	lockedStage.Spec.Lock = &kargoapi.StageLock{Reason: "change freeze"}
+	testStages := []kargoapi.Stage{
+		newStage("prod-c"),
+		newStage("prod-a"),
+		lockedStage,
+		newStage("prod-b"),
+	}
+	listStages := func(
+		_ context.Context,
+		objList client.ObjectList,
+		_ ...client.ListOption,
+	) error {
+		stages, ok := objList.(*kargoapi.StageList)
+		require.True(t, ok)
+		stages.Items = append(stages.Items, testStages...)
+		return nil
+	}
+	getFreight := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+		[]string,
+		*kargoapi.QualificationRule,
+	) (*kargoapi.Freight, error) {
+		return testFreight.DeepCopy(), nil
+	}
+	validRequest := &svcv1alpha1.PromoteStagesRequest{
+		Project:  "fake-project",
+		Selector: "tier=prod",
+		Freight:  "fake-freight",
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.PromoteStagesRequest
+		server     *server
+		assertions func([]*svcv1alpha1.PromoteStagesResponse, error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.PromoteStagesRequest{Project: "fake-project"},
+			server: &server{},
+			assertions: func(_ []*svcv1alpha1.PromoteStagesResponse, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "invalid selector",
+			req: &svcv1alpha1.PromoteStagesRequest{
+				Project:  "fake-project",
+				Selector: "tier in prod",
+				Freight:  "fake-freight",
+			},
+			server: &server{},
+			assertions: func(_ []*svcv1alpha1.PromoteStagesResponse, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "invalid selector")
+			},
+		},
+		{
+			name: "Freight not found",
+			req:  validRequest,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(_ []*svcv1alpha1.PromoteStagesResponse, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "no matching Stages",
+			req:  validRequest,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getQualifiedFreightFn: getFreight,
+				listStagesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(_ []*svcv1alpha1.PromoteStagesResponse, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Contains(t, err.Error(), `no Stages matching selector "tier=prod"`)
+			},
+		},
+		{
+			name: "all at once",
+			req:  validRequest,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getQualifiedFreightFn: getFreight,
+				listStagesFn:          listStages,
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					if obj.(*kargoapi.Promotion).Spec.Stage == "prod-b" { // nolint: forcetypeassert
+						return errors.New("something went wrong")
+					}
+					return nil
+				},
+			},
+			assertions: func(res []*svcv1alpha1.PromoteStagesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 4)
+				require.Equal(t, "locked", res[0].GetStage())
+				require.Equal(t, kargo.StagePromotionOutcomeSkipped, res[0].GetOutcome())
+				require.Equal(t, "Stage is locked: change freeze", res[0].GetMessage())
+				require.Zero(t, res[0].GetWave())
+				require.Equal(t, "prod-a", res[1].GetStage())
+				require.Equal(t, kargo.StagePromotionOutcomeCreated, res[1].GetOutcome())
+				require.Equal(t, uint32(1), res[1].GetWave())
+				require.NotNil(t, res[1].GetPromotion())
+				require.Equal(t, "prod-b", res[2].GetStage())
+				require.Equal(t, kargo.StagePromotionOutcomeFailed, res[2].GetOutcome())
+				require.Contains(t, res[2].GetMessage(), "something went wrong")
+				require.Equal(t, "prod-c", res[3].GetStage())
+				require.Equal(t, kargo.StagePromotionOutcomeCreated, res[3].GetOutcome())
+				require.Equal(t, uint32(1), res[3].GetWave())
+			},
+		},
+		{
+			name: "waves halted on failure",
+			req: &svcv1alpha1.PromoteStagesRequest{
+				Project:       "fake-project",
+				Selector:      "tier=prod",
+				Freight:       "fake-freight",
+				BatchSize:     1,
+				Pause:         "1ms",
+				HaltOnFailure: true,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getQualifiedFreightFn: getFreight,
+				listStagesFn:          listStages,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+				waitForPromotionFn: func(
+					_ context.Context,
+					promo *kargoapi.Promotion,
+				) (*kargoapi.Promotion, error) {
+					promo = promo.DeepCopy()
+					promo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+					if promo.Spec.Stage == "prod-b" {
+						promo.Status.Phase = kargoapi.PromotionPhaseErrored
+						promo.Status.Error = "sync failed"
+					}
+					return promo, nil
+				},
+			},
+			assertions: func(res []*svcv1alpha1.PromoteStagesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 4)
+				require.Equal(t, kargo.StagePromotionOutcomeSkipped, res[0].GetOutcome())
+				require.Equal(t, "prod-a", res[1].GetStage())
+				require.Equal(t, kargo.StagePromotionOutcomeSucceeded, res[1].GetOutcome())
+				require.Equal(t, uint32(1), res[1].GetWave())
+				require.Equal(t, "prod-b", res[2].GetStage())
+				require.Equal(t, kargo.StagePromotionOutcomeFailed, res[2].GetOutcome())
+				require.Equal(t, uint32(2), res[2].GetWave())
+				require.Contains(t, res[2].GetMessage(), "Errored: sync failed")
+				require.Equal(t, "prod-c", res[3].GetStage())
+				require.Equal(t, kargo.StagePromotionOutcomeHalted, res[3].GetOutcome())
+				require.Equal(t, "A Promotion in wave 2 failed", res[3].GetMessage())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, handler := svcv1alpha1connect.NewKargoServiceHandler(testCase.server)
+			srv := httptest.NewServer(handler)
+			defer srv.Close()
+			stream, err := svcv1alpha1connect.NewKargoServiceClient(
+				http.DefaultClient,
+				srv.URL,
+			).PromoteStages(context.Background(), connect.NewRequest(testCase.req))
+			require.NoError(t, err)
+			defer stream.Close()
+			var res []*svcv1alpha1.PromoteStagesResponse
+			for stream.Receive() {
+				res = append(res, stream.Msg())
+			}
+			testCase.assertions(res, stream.Err())
+		})
+	}
+}
+
+func TestPromoteStagesInterrupted(t *testing.T) {
+	testStages := []kargoapi.Stage{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "prod-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "prod-b"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "prod-c"}},
+	}
+	for i := range testStages {
+		testStages[i].Spec = &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{{Name: "upstream"}},
+			},
+		}
+	}
+	var created []string
+	s := &server{
+		validateProjectFn: func(context.Context, string) error {
+			return nil
+		},
+		getQualifiedFreightFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+			[]string,
+			*kargoapi.QualificationRule,
+		) (*kargoapi.Freight, error) {
+			return &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-freight",
+				},
+				Status: kargoapi.FreightStatus{
+					Qualifications: map[string]kargoapi.Qualification{
+						"upstream": {},
+					},
+				},
+			}, nil
+		},
+		listStagesFn: func(
+			_ context.Context,
+			objList client.ObjectList,
+			_ ...client.ListOption,
+		) error {
+			objList.(*kargoapi.StageList).Items = testStages // nolint: forcetypeassert
+			return nil
+		},
+		createPromotionFn: func(
+			_ context.Context,
+			obj client.Object,
+			_ ...client.CreateOption,
+		) error {
+			created = append(created, obj.(*kargoapi.Promotion).Spec.Stage) // nolint: forcetypeassert
+			return nil
+		},
+		waitForPromotionFn: func(
+			_ context.Context,
+			promo *kargoapi.Promotion,
+		) (*kargoapi.Promotion, error) {
+			promo = promo.DeepCopy()
+			promo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+			return promo, nil
+		},
+	}
+	handlerErrs := make(chan error, 1)
+	_, handler := svcv1alpha1connect.NewKargoServiceHandler(
+		s,
+		connect.WithInterceptors(handlerErrorInterceptor(handlerErrs)),
+	)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := svcv1alpha1connect.NewKargoServiceClient(
+		http.DefaultClient,
+		srv.URL,
+	).PromoteStages(ctx, connect.NewRequest(&svcv1alpha1.PromoteStagesRequest{
+		Project:   "fake-project",
+		Selector:  "tier=prod",
+		Freight:   "fake-freight",
+		BatchSize: 1,
+		Pause:     "1h",
+	}))
+	require.NoError(t, err)
+	defer stream.Close()
+	// Disconnect while the server pauses after the first wave
+	require.True(t, stream.Receive(), stream.Err())
+	require.Equal(t, "prod-a", stream.Msg().GetStage())
+	require.Equal(t, kargo.StagePromotionOutcomeSucceeded, stream.Msg().GetOutcome())
+	cancel()
+
+	select {
+	case err = <-handlerErrs:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "handler did not return after the client disconnected")
+	}
+	require.Error(t, err)
+	require.Equal(t, connect.CodeCanceled, connect.CodeOf(err))
+	require.Contains(
+		t,
+		err.Error(),
+		"stream closed before Promotions were created for Stages prod-b, prod-c",
+	)
+	require.Equal(t, []string{"prod-a"}, created)
+}
+
+// handlerErrorInterceptor is a connect.Interceptor that sends the error
+// returned by each streaming handler to the provided channel.
+type handlerErrorInterceptor chan<- error
+
+func (handlerErrorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return next
+}
+
+func (handlerErrorInterceptor) WrapStreamingClient(
+	next connect.StreamingClientFunc,
+) connect.StreamingClientFunc {
+	return next
+}
+
+func (h handlerErrorInterceptor) WrapStreamingHandler(
+	next connect.StreamingHandlerFunc,
+) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		err := next(ctx, conn)
+		h <- err
+		return err
+	}
+}
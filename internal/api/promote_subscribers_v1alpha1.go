@@ -4,6 +4,7 @@ import (
 	"context"
 	goerrors "errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -13,6 +14,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/kubeclient"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
@@ -65,6 +67,7 @@ func (s *server) PromoteSubscribers(
 			Name:      req.Msg.GetFreight(),
 		},
 		[]string{req.Msg.GetStage()},
+		nil,
 	); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	} else if freight == nil {
@@ -88,8 +91,15 @@ func (s *server) PromoteSubscribers(
 
 	promoteErrs := make([]error, 0, len(subscribers))
 	createdPromos := make([]*v1alpha1.Promotion, 0, len(subscribers))
+	var lockedStages []string
+	now := time.Now()
 	for _, subscriber := range subscribers {
+		if subscriber.Spec != nil && subscriber.Spec.Lock.IsActive(now) {
+			lockedStages = append(lockedStages, subscriber.Name)
+			continue
+		}
 		newPromo := kargo.NewPromotion(subscriber, req.Msg.GetFreight())
+		setCreateActor(ctx, &newPromo)
 		if err := s.createPromotionFn(ctx, &newPromo); err != nil {
 			promoteErrs = append(promoteErrs, err)
 			continue
@@ -98,7 +108,8 @@ func (s *server) PromoteSubscribers(
 	}
 
 	res := connect.NewResponse(&svcv1alpha1.PromoteSubscribersResponse{
-		Promotions: createdPromos,
+		Promotions:   createdPromos,
+		LockedStages: lockedStages,
 	})
 
 	if len(promoteErrs) > 0 {
@@ -110,24 +121,17 @@ func (s *server) PromoteSubscribers(
 }
 
 // findStageSubscribers returns a list of Stages that are subscribed to the given Stage
-// TODO: this could be powered by an index.
 func (s *server) findStageSubscribers(ctx context.Context, stage *kargoapi.Stage) ([]kargoapi.Stage, error) {
-	var allStages kargoapi.StageList
-	if err := s.client.List(ctx, &allStages, client.InNamespace(stage.Namespace)); err != nil {
+	var subscribers kargoapi.StageList
+	if err := s.client.List(
+		ctx,
+		&subscribers,
+		client.InNamespace(stage.Namespace),
+		client.MatchingFields{
+			kubeclient.StagesByUpstreamStagesIndexField: stage.Name,
+		},
+	); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	var subscribers []kargoapi.Stage
-	for _, s := range allStages.Items {
-		s := s
-		if s.Spec.Subscriptions == nil {
-			continue
-		}
-		for _, upstream := range s.Spec.Subscriptions.UpstreamStages {
-			if upstream.Name != stage.Name {
-				continue
-			}
-			subscribers = append(subscribers, s)
-		}
-	}
-	return subscribers, nil
+	return subscribers.Items, nil
 }
@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -151,6 +152,7 @@ func TestPromoteSubscribers(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -199,6 +201,7 @@ func TestPromoteSubscribers(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -248,6 +251,7 @@ func TestPromoteSubscribers(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -302,6 +306,7 @@ func TestPromoteSubscribers(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -357,6 +362,7 @@ func TestPromoteSubscribers(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -385,6 +391,86 @@ func TestPromoteSubscribers(t *testing.T) {
 				require.Contains(t, connErr.Message(), "something went wrong")
 			},
 		},
+		{
+			name: "locked subscribers are skipped",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								UpstreamStages: []kargoapi.StageSubscription{
+									{
+										Name: "fake-upstream-stage",
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+					*kargoapi.QualificationRule,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "locked-stage",
+							},
+							Spec: &kargoapi.StageSpec{
+								Lock: &kargoapi.StageLock{
+									Reason: "fake-reason",
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "unlocked-stage",
+							},
+							Spec: &kargoapi.StageSpec{},
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo := obj.(*kargoapi.Promotion) // nolint: forcetypeassert
+					require.Equal(t, "unlocked-stage", promo.Spec.Stage)
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Len(t, res.Msg.GetPromotions(), 1)
+				require.Equal(t, []string{"locked-stage"}, res.Msg.GetLockedStages())
+			},
+		},
 		{
 			name: "success",
 			req: &svcv1alpha1.PromoteSubscribersRequest{
@@ -418,6 +504,7 @@ func TestPromoteSubscribers(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 					[]string,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
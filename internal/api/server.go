@@ -21,7 +21,9 @@ import (
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/option"
 	"github.com/akuity/kargo/internal/api/validation"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	httputil "github.com/akuity/kargo/internal/http"
+	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient/manifest"
 	"github.com/akuity/kargo/internal/logging"
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
@@ -60,6 +62,7 @@ type server struct {
 		client client.Client,
 		namespacedName types.NamespacedName,
 		stages []string,
+		rule *kargoapi.QualificationRule,
 	) (*kargoapi.Freight, error)
 
 	// Common Promotions:
@@ -69,9 +72,44 @@ type server struct {
 		...client.CreateOption,
 	) error
 
+	// Stage validation:
+	checkStageSubscriptionsFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Stage,
+		...client.Object,
+	) (kargo.StageSubscriptionIssues, error)
+
+	// Stage locks:
+	patchStageFn func(
+		context.Context,
+		client.Object,
+		client.Patch,
+		...client.PatchOption,
+	) error
+
+	// Promotion previews:
+	previewPromotionFn func(
+		context.Context,
+		*kargoapi.Stage,
+		kargoapi.SimpleFreight,
+		*promotion.Preview,
+	) error
+
 	// Promote subscribers:
 	findStageSubscribersFn func(ctx context.Context, stage *kargoapi.Stage) ([]kargoapi.Stage, error)
 
+	// Promote Stages by label selector:
+	listStagesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+	waitForPromotionFn func(
+		context.Context,
+		*kargoapi.Promotion,
+	) (*kargoapi.Promotion, error)
+
 	// QueryFreight API:
 	listFreightFn func(
 		context.Context,
@@ -94,6 +132,13 @@ type server struct {
 		stageSubs []kargoapi.StageSubscription,
 	) ([]kargoapi.Freight, error)
 
+	// ExplainStage API:
+	listPromotionPoliciesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
 	// Common manifest parsing:
 	parseManifestFn manifest.ParseFunc
 }
@@ -102,9 +147,14 @@ type Server interface {
 	Serve(ctx context.Context, l net.Listener) error
 }
 
+// NewServer returns a Server that uses the provided configuration and
+// Kubernetes client. The provided promotion.Previewer is used to serve
+// PreviewPromotion requests. It may be nil, in which case such requests are
+// rejected as unimplemented.
 func NewServer(
 	cfg config.ServerConfig,
 	kubeClient kubernetes.Client,
+	previewer promotion.Previewer,
 ) Server {
 	s := &server{
 		cfg:    cfg,
@@ -116,12 +166,20 @@ func NewServer(
 	s.getStageFn = kargoapi.GetStage
 	s.getQualifiedFreightFn = kargoapi.GetQualifiedFreight
 	s.createPromotionFn = kubeClient.Create
+	s.checkStageSubscriptionsFn = kargo.CheckStageSubscriptions
+	s.patchStageFn = kubeClient.Patch
+	if previewer != nil {
+		s.previewPromotionFn = previewer.Preview
+	}
 	s.findStageSubscribersFn = s.findStageSubscribers
+	s.listStagesFn = kubeClient.List
+	s.waitForPromotionFn = s.waitForPromotion
 	s.listFreightFn = kubeClient.List
 	s.getAvailableFreightForStageFn = s.getAvailableFreightForStage
 	s.getFreightFromWarehouseFn = s.getFreightFromWarehouse
 	s.getFreightQualifiedForUpstreamStagesFn =
 		s.getFreightQualifiedForUpstreamStages
+	s.listPromotionPoliciesFn = kubeClient.List
 	s.parseManifestFn = manifest.NewParser(kubeClient.Scheme())
 	return s
 }
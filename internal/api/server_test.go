@@ -12,6 +12,8 @@ import (
 
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	"github.com/akuity/kargo/internal/credentials"
 )
 
 func TestNewServer(t *testing.T) {
@@ -30,7 +32,7 @@ func TestNewServer(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
-	s, ok := NewServer(testServerConfig, testClient).(*server)
+	s, ok := NewServer(testServerConfig, testClient, nil).(*server)
 	require.True(t, ok)
 	require.NotNil(t, s)
 	require.Same(t, testClient, s.client)
@@ -41,9 +43,25 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.getQualifiedFreightFn)
 	require.NotNil(t, s.createPromotionFn)
 	require.NotNil(t, s.findStageSubscribersFn)
+	require.NotNil(t, s.listStagesFn)
+	require.NotNil(t, s.waitForPromotionFn)
+	require.NotNil(t, s.checkStageSubscriptionsFn)
 	require.NotNil(t, s.listFreightFn)
 	require.NotNil(t, s.getAvailableFreightForStageFn)
 	require.NotNil(t, s.getFreightFromWarehouseFn)
 	require.NotNil(t, s.getFreightQualifiedForUpstreamStagesFn)
+	require.NotNil(t, s.listPromotionPoliciesFn)
 	require.NotNil(t, s.parseManifestFn)
+	require.Nil(t, s.previewPromotionFn)
+
+	s, ok = NewServer(
+		testServerConfig,
+		testClient,
+		promotion.NewPreviewer(
+			fake.NewClientBuilder().Build(),
+			credentials.NewKubernetesDatabase("", nil, nil),
+		),
+	).(*server)
+	require.True(t, ok)
+	require.NotNil(t, s.previewPromotionFn)
 }
@@ -97,6 +97,24 @@ func FromManagedFieldsEntryProto(e *metav1.ManagedFieldsEntry) *kubemetav1.Manag
 	}
 }
 
+func FromConditionProto(c *metav1.Condition) *kubemetav1.Condition {
+	if c == nil {
+		return nil
+	}
+	var lastTransitionTime kubemetav1.Time
+	if c.GetLastTransitionTime() != nil {
+		lastTransitionTime = kubemetav1.NewTime(c.GetLastTransitionTime().AsTime())
+	}
+	return &kubemetav1.Condition{
+		Type:               c.GetType(),
+		Status:             kubemetav1.ConditionStatus(c.GetStatus()),
+		ObservedGeneration: c.GetObservedGeneration(),
+		LastTransitionTime: lastTransitionTime,
+		Reason:             c.GetReason(),
+		Message:            c.GetMessage(),
+	}
+}
+
 func ToListMetaProto(m kubemetav1.ListMeta) *metav1.ListMeta {
 	return &metav1.ListMeta{
 		SelfLink:           proto.String(m.GetSelfLink()),
@@ -174,3 +192,14 @@ func ToFieldsV1Proto(f kubemetav1.FieldsV1) *metav1.FieldsV1 {
 		Raw: f.Raw,
 	}
 }
+
+func ToConditionProto(c kubemetav1.Condition) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               proto.String(c.Type),
+		Status:             proto.String(string(c.Status)),
+		ObservedGeneration: proto.Int64(c.ObservedGeneration),
+		LastTransitionTime: timestamppb.New(c.LastTransitionTime.Time),
+		Reason:             proto.String(c.Reason),
+		Message:            proto.String(c.Message),
+	}
+}
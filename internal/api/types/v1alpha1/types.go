@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -11,6 +12,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesmetav1 "github.com/akuity/kargo/internal/api/types/metav1"
 	"github.com/akuity/kargo/internal/version"
+	pkgmetav1 "github.com/akuity/kargo/pkg/api/metav1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
@@ -54,6 +56,179 @@ func FromStageSpecProto(s *v1alpha1.StageSpec) *kargoapi.StageSpec {
 	return &kargoapi.StageSpec{
 		Subscriptions:       FromSubscriptionsProto(s.GetSubscriptions()),
 		PromotionMechanisms: FromPromotionMechanismsProto(s.GetPromotionMechanisms()),
+		HealthChecks:        FromHealthChecksProto(s.GetHealthChecks()),
+		MinimumSoakDuration: fromDurationProto(s.MinimumSoakDuration),
+		AutoRollback:        FromAutoRollbackProto(s.GetAutoRollback()),
+		DriftPolicy:         kargoapi.DriftPolicy(s.GetDriftPolicy()),
+		Lock:                FromStageLockProto(s.GetLock()),
+	}
+}
+
+func FromStageLockProto(l *v1alpha1.StageLock) *kargoapi.StageLock {
+	if l == nil {
+		return nil
+	}
+	var until *kubemetav1.Time
+	if l.GetUntil() != nil {
+		until = &kubemetav1.Time{Time: l.GetUntil().AsTime()}
+	}
+	return &kargoapi.StageLock{
+		By:     l.GetBy(),
+		Reason: l.GetReason(),
+		Until:  until,
+	}
+}
+
+func ToStageLockProto(l kargoapi.StageLock) *v1alpha1.StageLock {
+	var until *timestamppb.Timestamp
+	if l.Until != nil {
+		until = timestamppb.New(l.Until.Time)
+	}
+	return &v1alpha1.StageLock{
+		By:     l.By,
+		Reason: l.Reason,
+		Until:  until,
+	}
+}
+
+func FromAutoRollbackProto(a *v1alpha1.AutoRollback) *kargoapi.AutoRollback {
+	if a == nil {
+		return nil
+	}
+	var window kubemetav1.Duration
+	if d := fromDurationProto(proto.String(a.GetWindow())); d != nil {
+		window = *d
+	}
+	return &kargoapi.AutoRollback{
+		Window: window,
+	}
+}
+
+func ToAutoRollbackProto(a kargoapi.AutoRollback) *v1alpha1.AutoRollback {
+	return &v1alpha1.AutoRollback{
+		Window: a.Window.Duration.String(),
+	}
+}
+
+func FromRollbackProto(r *v1alpha1.Rollback) kargoapi.Rollback {
+	var t kubemetav1.Time
+	if r.GetTime() != nil {
+		t = kubemetav1.Time{Time: r.GetTime().AsTime()}
+	}
+	return kargoapi.Rollback{
+		Time:         t,
+		FromFreight:  r.GetFromFreight(),
+		ToFreight:    r.GetToFreight(),
+		Promotion:    r.GetPromotion(),
+		Reason:       r.GetReason(),
+		Acknowledged: r.GetAcknowledged(),
+	}
+}
+
+func ToRollbackProto(r kargoapi.Rollback) *v1alpha1.Rollback {
+	return &v1alpha1.Rollback{
+		Time:         timestamppb.New(r.Time.Time),
+		FromFreight:  r.FromFreight,
+		ToFreight:    r.ToFreight,
+		Promotion:    r.Promotion,
+		Reason:       r.Reason,
+		Acknowledged: r.Acknowledged,
+	}
+}
+
+func FromAutoPromotionDecisionProto(
+	d *v1alpha1.AutoPromotionDecision,
+) *kargoapi.AutoPromotionDecision {
+	if d == nil {
+		return nil
+	}
+	var t kubemetav1.Time
+	if d.GetTime() != nil {
+		t = kubemetav1.Time{Time: d.GetTime().AsTime()}
+	}
+	return &kargoapi.AutoPromotionDecision{
+		Time:      t,
+		Reason:    d.GetReason(),
+		Message:   d.GetMessage(),
+		Freight:   d.GetFreight(),
+		Promotion: d.GetPromotion(),
+	}
+}
+
+func ToAutoPromotionDecisionProto(
+	d kargoapi.AutoPromotionDecision,
+) *v1alpha1.AutoPromotionDecision {
+	return &v1alpha1.AutoPromotionDecision{
+		Time:      timestamppb.New(d.Time.Time),
+		Reason:    d.Reason,
+		Message:   d.Message,
+		Freight:   d.Freight,
+		Promotion: d.Promotion,
+	}
+}
+
+// fromDurationProto parses the provided duration string, if any. Invalid
+// durations are treated the same as an absence of any duration.
+func fromDurationProto(d *string) *kubemetav1.Duration {
+	if d == nil {
+		return nil
+	}
+	duration, err := time.ParseDuration(*d)
+	if err != nil {
+		return nil
+	}
+	return &kubemetav1.Duration{Duration: duration}
+}
+
+func toDurationProto(d *kubemetav1.Duration) *string {
+	if d == nil {
+		return nil
+	}
+	return proto.String(d.Duration.String())
+}
+
+func FromSoakStatusProto(s *v1alpha1.SoakStatus) *kargoapi.SoakStatus {
+	if s == nil {
+		return nil
+	}
+	var healthySince *kubemetav1.Time
+	if s.GetHealthySince() != nil {
+		healthySince = &kubemetav1.Time{Time: s.GetHealthySince().AsTime()}
+	}
+	return &kargoapi.SoakStatus{
+		Freight:      s.GetFreight(),
+		HealthySince: healthySince,
+		Remaining:    fromDurationProto(s.Remaining),
+	}
+}
+
+func ToSoakStatusProto(s kargoapi.SoakStatus) *v1alpha1.SoakStatus {
+	var healthySince *timestamppb.Timestamp
+	if s.HealthySince != nil {
+		healthySince = timestamppb.New(s.HealthySince.Time)
+	}
+	return &v1alpha1.SoakStatus{
+		Freight:      s.Freight,
+		HealthySince: healthySince,
+		Remaining:    toDurationProto(s.Remaining),
+	}
+}
+
+func FromHealthChecksProto(h *v1alpha1.HealthChecks) *kargoapi.HealthChecks {
+	if h == nil {
+		return nil
+	}
+	resources := make([]kargoapi.ResourceHealthCheck, len(h.GetResources()))
+	for i, r := range h.GetResources() {
+		resources[i] = kargoapi.ResourceHealthCheck{
+			APIVersion: r.GetApiVersion(),
+			Kind:       r.GetKind(),
+			Name:       r.GetName(),
+		}
+	}
+	return &kargoapi.HealthChecks{
+		Namespace: h.GetNamespace(),
+		Resources: resources,
 	}
 }
 
@@ -65,12 +240,49 @@ func FromStageStatusProto(s *v1alpha1.StageStatus) *kargoapi.StageStatus {
 	for idx, freight := range s.GetHistory() {
 		history[idx] = *FromSimpleFreightProto(freight)
 	}
+	var lastPromotionTime *kubemetav1.Time
+	if s.GetLastPromotionTime() != nil {
+		lastPromotionTime = &kubemetav1.Time{Time: s.GetLastPromotionTime().AsTime()}
+	}
+	var rollbacks []kargoapi.Rollback
+	if len(s.GetRollbacks()) > 0 {
+		rollbacks = make([]kargoapi.Rollback, len(s.GetRollbacks()))
+		for idx, rollback := range s.GetRollbacks() {
+			rollbacks[idx] = FromRollbackProto(rollback)
+		}
+	}
 	return &kargoapi.StageStatus{
-		CurrentFreight: FromSimpleFreightProto(s.GetCurrentFreight()),
-		History:        history,
-		Health:         FromHealthProto(s.GetHealth()),
-		Error:          s.GetError(),
+		CurrentFreight:    FromSimpleFreightProto(s.GetCurrentFreight()),
+		History:           history,
+		Health:            FromHealthProto(s.GetHealth()),
+		Error:             s.GetError(),
+		Soak:              FromSoakStatusProto(s.GetSoak()),
+		LastPromotionTime: lastPromotionTime,
+		Rollbacks:         rollbacks,
+		LastAutoPromotionDecision: FromAutoPromotionDecisionProto(
+			s.GetLastAutoPromotionDecision(),
+		),
+		Conditions: fromConditionProtos(s.GetConditions()),
+	}
+}
+
+func fromConditionProtos(cs []*pkgmetav1.Condition) []kubemetav1.Condition {
+	if len(cs) == 0 {
+		return nil
+	}
+	conditions := make([]kubemetav1.Condition, len(cs))
+	for idx, condition := range cs {
+		conditions[idx] = *typesmetav1.FromConditionProto(condition)
+	}
+	return conditions
+}
+
+func toConditionProtos(cs []kubemetav1.Condition) []*pkgmetav1.Condition {
+	conditions := make([]*pkgmetav1.Condition, len(cs))
+	for idx := range cs {
+		conditions[idx] = typesmetav1.ToConditionProto(cs[idx])
 	}
+	return conditions
 }
 
 func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
@@ -98,6 +310,20 @@ func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
 	for stageName := range f.Status.Qualifications {
 		qualifications[stageName] = kargoapi.Qualification{}
 	}
+	var failures map[string]kargoapi.Failure
+	if len(f.GetStatus().GetFailures()) > 0 {
+		failures = make(map[string]kargoapi.Failure, len(f.GetStatus().GetFailures()))
+		for stageName, failure := range f.GetStatus().GetFailures() {
+			var t kubemetav1.Time
+			if failure.GetTime() != nil {
+				t = kubemetav1.Time{Time: failure.GetTime().AsTime()}
+			}
+			failures[stageName] = kargoapi.Failure{
+				Time:   t,
+				Reason: failure.GetReason(),
+			}
+		}
+	}
 	return &kargoapi.Freight{
 		TypeMeta: kubemetav1.TypeMeta{
 			APIVersion: kargoapi.GroupVersion.String(),
@@ -110,6 +336,7 @@ func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
 		Charts:     charts,
 		Status: kargoapi.FreightStatus{
 			Qualifications: qualifications,
+			Failures:       failures,
 		},
 	}
 }
@@ -181,7 +408,11 @@ func FromWarehouseStatusProto(s *v1alpha1.WarehouseStatus) *kargoapi.WarehouseSt
 	if s == nil {
 		return nil
 	}
-	return &kargoapi.WarehouseStatus{}
+	return &kargoapi.WarehouseStatus{
+		Error:              s.GetError(),
+		ObservedGeneration: s.GetObservedGeneration(),
+		Conditions:         fromConditionProtos(s.GetConditions()),
+	}
 }
 
 func FromGitCommitProto(g *v1alpha1.GitCommit) *kargoapi.GitCommit {
@@ -225,10 +456,28 @@ func FromHealthProto(h *v1alpha1.Health) *kargoapi.Health {
 	for i, argocdAppState := range h.GetArgocdApps() {
 		argocdAppStates[i] = FromArgoCDAppStateProto(argocdAppState)
 	}
+	resources := make([]kargoapi.ResourceHealthStatus, len(h.GetResources()))
+	for i, r := range h.GetResources() {
+		resources[i] = FromResourceHealthStatusProto(r)
+	}
 	return &kargoapi.Health{
 		Status:     kargoapi.HealthState(h.GetStatus()),
 		Issues:     h.GetIssues(),
 		ArgoCDApps: argocdAppStates,
+		Resources:  resources,
+	}
+}
+
+func FromResourceHealthStatusProto(
+	r *v1alpha1.ResourceHealthStatus,
+) kargoapi.ResourceHealthStatus {
+	return kargoapi.ResourceHealthStatus{
+		APIVersion: r.GetApiVersion(),
+		Kind:       r.GetKind(),
+		Namespace:  r.GetNamespace(),
+		Name:       r.GetName(),
+		Status:     kargoapi.HealthState(r.GetStatus()),
+		Message:    r.GetMessage(),
 	}
 }
 
@@ -271,8 +520,21 @@ func FromSubscriptionsProto(s *v1alpha1.Subscriptions) *kargoapi.Subscriptions {
 		upstreamStages[idx] = *FromStageSubscriptionProto(stage)
 	}
 	return &kargoapi.Subscriptions{
-		Warehouse:      s.GetWarehouse(),
-		UpstreamStages: upstreamStages,
+		Warehouse:         s.GetWarehouse(),
+		UpstreamStages:    upstreamStages,
+		QualificationRule: FromQualificationRuleProto(s.GetQualificationRule()),
+	}
+}
+
+func FromQualificationRuleProto(
+	r *v1alpha1.QualificationRule,
+) *kargoapi.QualificationRule {
+	if r == nil {
+		return nil
+	}
+	return &kargoapi.QualificationRule{
+		Type:   kargoapi.QualificationRuleType(r.GetType()),
+		Quorum: r.GetQuorum(),
 	}
 }
 
@@ -337,6 +599,44 @@ func FromPromotionMechanismsProto(m *v1alpha1.PromotionMechanisms) *kargoapi.Pro
 	return &kargoapi.PromotionMechanisms{
 		GitRepoUpdates:   gitUpdates,
 		ArgoCDAppUpdates: argoUpdates,
+		Timeout:          fromDurationProto(m.Timeout),
+		Retry:            FromPromotionRetryProto(m.GetRetry()),
+		PreHooks:         fromPromotionHooksProto(m.GetPreHooks()),
+		PostHooks:        fromPromotionHooksProto(m.GetPostHooks()),
+	}
+}
+
+func fromPromotionHooksProto(hooks []*v1alpha1.PromotionHook) []kargoapi.PromotionHook {
+	if len(hooks) == 0 {
+		return nil
+	}
+	res := make([]kargoapi.PromotionHook, len(hooks))
+	for idx, hook := range hooks {
+		res[idx] = *FromPromotionHookProto(hook)
+	}
+	return res
+}
+
+func FromPromotionHookProto(h *v1alpha1.PromotionHook) *kargoapi.PromotionHook {
+	if h == nil {
+		return nil
+	}
+	hook := &kargoapi.PromotionHook{
+		Name: h.GetName(),
+	}
+	// A malformed template is left empty and rejected by the API server
+	_ = json.Unmarshal(h.GetTemplate(), &hook.Template)
+	return hook
+}
+
+func FromPromotionRetryProto(r *v1alpha1.PromotionRetry) *kargoapi.PromotionRetry {
+	if r == nil {
+		return nil
+	}
+	return &kargoapi.PromotionRetry{
+		Limit:      r.GetLimit(),
+		Backoff:    fromDurationProto(r.Backoff),
+		MaxBackoff: fromDurationProto(r.MaxBackoff),
 	}
 }
 
@@ -351,6 +651,21 @@ func FromGitRepoUpdateProto(u *v1alpha1.GitRepoUpdate) *kargoapi.GitRepoUpdate {
 		Render:      FromKargoRenderPromotionMechanismProto(u.GetRender()),
 		Kustomize:   FromKustomizePromotionMechanismProto(u.GetKustomize()),
 		Helm:        FromHelmPromotionMechanismProto(u.GetHelm()),
+		Commit:      FromGitCommitOptionsProto(u.GetCommit()),
+	}
+}
+
+func FromGitCommitOptionsProto(
+	o *v1alpha1.GitCommitOptions,
+) *kargoapi.GitCommitOptions {
+	if o == nil {
+		return nil
+	}
+	return &kargoapi.GitCommitOptions{
+		AuthorName:      o.GetAuthorName(),
+		AuthorEmail:     o.GetAuthorEmail(),
+		MessageTemplate: o.GetMessageTemplate(),
+		DisableTrailers: o.GetDisableTrailers(),
 	}
 }
 
@@ -458,6 +773,8 @@ func FromArgoCDSourceUpdateProto(u *v1alpha1.ArgoCDSourceUpdate) *kargoapi.ArgoC
 		UpdateTargetRevision: u.GetUpdateTargetRevision(),
 		Kustomize:            FromArgoCDKustomizeProto(u.GetKustomize()),
 		Helm:                 FromArgoCDHelm(u.GetHelm()),
+		SourceIndex:          u.SourceIndex,
+		Ref:                  u.GetRef(),
 	}
 }
 
@@ -531,8 +848,35 @@ func FromPromotionSpecProto(s *v1alpha1.PromotionSpec) *kargoapi.PromotionSpec {
 		return nil
 	}
 	return &kargoapi.PromotionSpec{
-		Stage:   s.GetStage(),
-		Freight: s.GetFreight(),
+		Stage:        s.GetStage(),
+		Freight:      s.GetFreight(),
+		Supersedable: s.GetSupersedable(),
+		Artifacts:    FromArtifactSelectorProto(s.GetArtifacts()),
+	}
+}
+
+func FromArtifactSelectorProto(
+	s *v1alpha1.ArtifactSelector,
+) *kargoapi.ArtifactSelector {
+	if s == nil {
+		return nil
+	}
+	return &kargoapi.ArtifactSelector{
+		Include: FromArtifactReferencesProto(s.GetInclude()),
+		Exclude: FromArtifactReferencesProto(s.GetExclude()),
+	}
+}
+
+func FromArtifactReferencesProto(
+	r *v1alpha1.ArtifactReferences,
+) *kargoapi.ArtifactReferences {
+	if r == nil {
+		return nil
+	}
+	return &kargoapi.ArtifactReferences{
+		Images:  r.GetImages(),
+		Charts:  r.GetCharts(),
+		Commits: r.GetCommits(),
 	}
 }
 
@@ -540,9 +884,52 @@ func FromPromotionStatusProto(s *v1alpha1.PromotionStatus) *kargoapi.PromotionSt
 	if s == nil {
 		return nil
 	}
+	var steps []kargoapi.PromotionStepResult
+	if len(s.GetSteps()) > 0 {
+		steps = make([]kargoapi.PromotionStepResult, len(s.GetSteps()))
+		for i, step := range s.GetSteps() {
+			steps[i] = *FromPromotionStepResultProto(step)
+		}
+	}
+	var attemptStartTime *kubemetav1.Time
+	if s.GetAttemptStartTime() != nil {
+		attemptStartTime = &kubemetav1.Time{Time: s.GetAttemptStartTime().AsTime()}
+	}
 	return &kargoapi.PromotionStatus{
-		Phase: kargoapi.PromotionPhase(s.GetPhase()),
-		Error: s.GetError(),
+		Phase:            kargoapi.PromotionPhase(s.GetPhase()),
+		Error:            s.GetError(),
+		Attempts:         s.GetAttempts(),
+		AttemptStartTime: attemptStartTime,
+		DerivedFreight:   s.GetDerivedFreight(),
+		Steps:            steps,
+		Conditions:       fromConditionProtos(s.GetConditions()),
+	}
+}
+
+func FromPromotionStepResultProto(
+	s *v1alpha1.PromotionStepResult,
+) *kargoapi.PromotionStepResult {
+	if s == nil {
+		return nil
+	}
+	var startTime, endTime *kubemetav1.Time
+	if s.GetStartTime() != nil {
+		startTime = &kubemetav1.Time{Time: s.GetStartTime().AsTime()}
+	}
+	if s.GetEndTime() != nil {
+		endTime = &kubemetav1.Time{Time: s.GetEndTime().AsTime()}
+	}
+	return &kargoapi.PromotionStepResult{
+		Mechanism: s.GetMechanism(),
+		Target:    s.GetTarget(),
+		StartTime: startTime,
+		EndTime:   endTime,
+		Outcome:   kargoapi.PromotionStepOutcome(s.GetOutcome()),
+		Commit:    s.GetCommit(),
+		Job:       s.GetJob(),
+		Error:     s.GetError(),
+
+		PullRequestURL: s.GetPullRequestUrl(),
 	}
 }
 
@@ -587,6 +974,35 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 	if e.Spec.PromotionMechanisms != nil {
 		promotionMechanisms = ToPromotionMechanismsProto(*e.Spec.PromotionMechanisms)
 	}
+	var healthChecks *v1alpha1.HealthChecks
+	if e.Spec.HealthChecks != nil {
+		healthChecks = ToHealthChecksProto(*e.Spec.HealthChecks)
+	}
+	var soak *v1alpha1.SoakStatus
+	if e.Status.Soak != nil {
+		soak = ToSoakStatusProto(*e.Status.Soak)
+	}
+	var autoRollback *v1alpha1.AutoRollback
+	if e.Spec.AutoRollback != nil {
+		autoRollback = ToAutoRollbackProto(*e.Spec.AutoRollback)
+	}
+	var lock *v1alpha1.StageLock
+	if e.Spec.Lock != nil {
+		lock = ToStageLockProto(*e.Spec.Lock)
+	}
+	var lastPromotionTime *timestamppb.Timestamp
+	if e.Status.LastPromotionTime != nil {
+		lastPromotionTime = timestamppb.New(e.Status.LastPromotionTime.Time)
+	}
+	rollbacks := make([]*v1alpha1.Rollback, len(e.Status.Rollbacks))
+	for idx := range e.Status.Rollbacks {
+		rollbacks[idx] = ToRollbackProto(e.Status.Rollbacks[idx])
+	}
+	var lastAutoPromotionDecision *v1alpha1.AutoPromotionDecision
+	if e.Status.LastAutoPromotionDecision != nil {
+		lastAutoPromotionDecision =
+			ToAutoPromotionDecisionProto(*e.Status.LastAutoPromotionDecision)
+	}
 	var currentPromotion *v1alpha1.PromotionInfo
 	if e.Status.CurrentPromotion != nil {
 		sf := kargoapi.SimpleFreight{
@@ -607,13 +1023,23 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 		Spec: &v1alpha1.StageSpec{
 			Subscriptions:       ToSubscriptionsProto(*e.Spec.Subscriptions),
 			PromotionMechanisms: promotionMechanisms,
+			HealthChecks:        healthChecks,
+			MinimumSoakDuration: toDurationProto(e.Spec.MinimumSoakDuration),
+			AutoRollback:        autoRollback,
+			DriftPolicy:         proto.String(string(e.Spec.DriftPolicy)),
+			Lock:                lock,
 		},
 		Status: &v1alpha1.StageStatus{
-			CurrentFreight:   currentFreight,
-			CurrentPromotion: currentPromotion,
-			History:          history,
-			Health:           health,
-			Error:            e.Status.Error,
+			CurrentFreight:            currentFreight,
+			CurrentPromotion:          currentPromotion,
+			History:                   history,
+			Health:                    health,
+			Error:                     e.Status.Error,
+			Soak:                      soak,
+			LastPromotionTime:         lastPromotionTime,
+			Rollbacks:                 rollbacks,
+			LastAutoPromotionDecision: lastAutoPromotionDecision,
+			Conditions:                toConditionProtos(e.Status.Conditions),
 		},
 	}
 }
@@ -643,9 +1069,23 @@ func ToSubscriptionsProto(s kargoapi.Subscriptions) *v1alpha1.Subscriptions {
 	for idx := range s.UpstreamStages {
 		upstreamStages[idx] = ToStageSubscriptionProto(s.UpstreamStages[idx])
 	}
+	var qualificationRule *v1alpha1.QualificationRule
+	if s.QualificationRule != nil {
+		qualificationRule = ToQualificationRuleProto(*s.QualificationRule)
+	}
 	return &v1alpha1.Subscriptions{
-		Warehouse:      s.Warehouse,
-		UpstreamStages: upstreamStages,
+		Warehouse:         s.Warehouse,
+		UpstreamStages:    upstreamStages,
+		QualificationRule: qualificationRule,
+	}
+}
+
+func ToQualificationRuleProto(
+	r kargoapi.QualificationRule,
+) *v1alpha1.QualificationRule {
+	return &v1alpha1.QualificationRule{
+		Type:   string(r.Type),
+		Quorum: proto.Int32(r.Quorum),
 	}
 }
 
@@ -690,9 +1130,45 @@ func ToPromotionMechanismsProto(p kargoapi.PromotionMechanisms) *v1alpha1.Promot
 	for idx := range p.ArgoCDAppUpdates {
 		argoCDAppUpdates[idx] = ToArgoCDAppUpdateProto(p.ArgoCDAppUpdates[idx])
 	}
+	var retry *v1alpha1.PromotionRetry
+	if p.Retry != nil {
+		retry = ToPromotionRetryProto(*p.Retry)
+	}
 	return &v1alpha1.PromotionMechanisms{
 		GitRepoUpdates:   gitRepoUpdates,
 		ArgocdAppUpdates: argoCDAppUpdates,
+		Timeout:          toDurationProto(p.Timeout),
+		Retry:            retry,
+		PreHooks:         toPromotionHooksProto(p.PreHooks),
+		PostHooks:        toPromotionHooksProto(p.PostHooks),
+	}
+}
+
+func toPromotionHooksProto(hooks []kargoapi.PromotionHook) []*v1alpha1.PromotionHook {
+	if len(hooks) == 0 {
+		return nil
+	}
+	res := make([]*v1alpha1.PromotionHook, len(hooks))
+	for idx := range hooks {
+		res[idx] = ToPromotionHookProto(hooks[idx])
+	}
+	return res
+}
+
+func ToPromotionHookProto(h kargoapi.PromotionHook) *v1alpha1.PromotionHook {
+	// Marshaling a JobTemplateSpec cannot fail
+	template, _ := json.Marshal(h.Template)
+	return &v1alpha1.PromotionHook{
+		Name:     h.Name,
+		Template: template,
+	}
+}
+
+func ToPromotionRetryProto(r kargoapi.PromotionRetry) *v1alpha1.PromotionRetry {
+	return &v1alpha1.PromotionRetry{
+		Limit:      r.Limit,
+		Backoff:    toDurationProto(r.Backoff),
+		MaxBackoff: toDurationProto(r.MaxBackoff),
 	}
 }
 
@@ -709,6 +1185,10 @@ func ToGitRepoUpdateProto(g kargoapi.GitRepoUpdate) *v1alpha1.GitRepoUpdate {
 	if g.Helm != nil {
 		helm = ToHelmPromotionMechanismProto(*g.Helm)
 	}
+	var commit *v1alpha1.GitCommitOptions
+	if g.Commit != nil {
+		commit = ToGitCommitOptionsProto(*g.Commit)
+	}
 	return &v1alpha1.GitRepoUpdate{
 		RepoUrl:     g.RepoURL,
 		ReadBranch:  proto.String(g.ReadBranch),
@@ -716,6 +1196,18 @@ func ToGitRepoUpdateProto(g kargoapi.GitRepoUpdate) *v1alpha1.GitRepoUpdate {
 		Render:      render,
 		Kustomize:   kustomize,
 		Helm:        helm,
+		Commit:      commit,
+	}
+}
+
+func ToGitCommitOptionsProto(
+	o kargoapi.GitCommitOptions,
+) *v1alpha1.GitCommitOptions {
+	return &v1alpha1.GitCommitOptions{
+		AuthorName:      proto.String(o.AuthorName),
+		AuthorEmail:     proto.String(o.AuthorEmail),
+		MessageTemplate: proto.String(o.MessageTemplate),
+		DisableTrailers: proto.Bool(o.DisableTrailers),
 	}
 }
 
@@ -803,6 +1295,8 @@ func ToArgoCDSourceUpdateProto(a kargoapi.ArgoCDSourceUpdate) *v1alpha1.ArgoCDSo
 		UpdateTargetRevision: proto.Bool(a.UpdateTargetRevision),
 		Kustomize:            kustomize,
 		Helm:                 helm,
+		SourceIndex:          a.SourceIndex,
+		Ref:                  proto.String(a.Ref),
 	}
 }
 
@@ -850,6 +1344,16 @@ func ToFreightProto(f kargoapi.Freight) *v1alpha1.Freight {
 	for stageName := range f.Status.Qualifications {
 		qualifications[stageName] = &v1alpha1.Qualification{}
 	}
+	var failures map[string]*v1alpha1.Failure
+	if len(f.Status.Failures) > 0 {
+		failures = make(map[string]*v1alpha1.Failure, len(f.Status.Failures))
+		for stageName, failure := range f.Status.Failures {
+			failures[stageName] = &v1alpha1.Failure{
+				Time:   timestamppb.New(failure.Time.Time),
+				Reason: failure.Reason,
+			}
+		}
+	}
 	return &v1alpha1.Freight{
 		ApiVersion: f.APIVersion,
 		Kind:       f.Kind,
@@ -860,6 +1364,7 @@ func ToFreightProto(f kargoapi.Freight) *v1alpha1.Freight {
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Status: &v1alpha1.FreightStatus{
 			Qualifications: qualifications,
+			Failures:       failures,
 		},
 	}
 }
@@ -900,6 +1405,7 @@ func ToWarehouseProto(w kargoapi.Warehouse) *v1alpha1.Warehouse {
 		status = &v1alpha1.WarehouseStatus{
 			Error:              w.GetStatus().Error,
 			ObservedGeneration: w.GetStatus().ObservedGeneration,
+			Conditions:         toConditionProtos(w.GetStatus().Conditions),
 		}
 	}
 	return &v1alpha1.Warehouse{
@@ -944,10 +1450,43 @@ func ToHealthProto(h kargoapi.Health) *v1alpha1.Health {
 	for i, argocdAppState := range h.ArgoCDApps {
 		argocdAppStates[i] = ToArgoCDAppStateProto(argocdAppState)
 	}
+	resources := make([]*v1alpha1.ResourceHealthStatus, len(h.Resources))
+	for i, r := range h.Resources {
+		resources[i] = ToResourceHealthStatusProto(r)
+	}
 	return &v1alpha1.Health{
 		Status:     string(h.Status),
 		Issues:     h.Issues,
 		ArgocdApps: argocdAppStates,
+		Resources:  resources,
+	}
+}
+
+func ToResourceHealthStatusProto(
+	r kargoapi.ResourceHealthStatus,
+) *v1alpha1.ResourceHealthStatus {
+	return &v1alpha1.ResourceHealthStatus{
+		ApiVersion: r.APIVersion,
+		Kind:       r.Kind,
+		Namespace:  r.Namespace,
+		Name:       r.Name,
+		Status:     string(r.Status),
+		Message:    r.Message,
+	}
+}
+
+func ToHealthChecksProto(h kargoapi.HealthChecks) *v1alpha1.HealthChecks {
+	resources := make([]*v1alpha1.ResourceHealthCheck, len(h.Resources))
+	for i, r := range h.Resources {
+		resources[i] = &v1alpha1.ResourceHealthCheck{
+			ApiVersion: r.APIVersion,
+			Kind:       r.Kind,
+			Name:       r.Name,
+		}
+	}
+	return &v1alpha1.HealthChecks{
+		Namespace: h.Namespace,
+		Resources: resources,
 	}
 }
 
@@ -985,21 +1524,94 @@ func ToPromotionProto(p kargoapi.Promotion) *v1alpha1.Promotion {
 	metadata := p.ObjectMeta.DeepCopy()
 	metadata.SetManagedFields(nil)
 
+	var steps []*v1alpha1.PromotionStepResult
+	if len(p.Status.Steps) > 0 {
+		steps = make([]*v1alpha1.PromotionStepResult, len(p.Status.Steps))
+		for i, step := range p.Status.Steps {
+			steps[i] = ToPromotionStepResultProto(step)
+		}
+	}
+	var attemptStartTime *timestamppb.Timestamp
+	if p.Status.AttemptStartTime != nil {
+		attemptStartTime = timestamppb.New(p.Status.AttemptStartTime.Time)
+	}
+	var artifacts *v1alpha1.ArtifactSelector
+	if p.Spec.Artifacts != nil {
+		artifacts = ToArtifactSelectorProto(*p.Spec.Artifacts)
+	}
+
 	return &v1alpha1.Promotion{
 		ApiVersion: p.APIVersion,
 		Kind:       p.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Spec: &v1alpha1.PromotionSpec{
-			Stage:   p.Spec.Stage,
-			Freight: p.Spec.Freight,
+			Stage:        p.Spec.Stage,
+			Freight:      p.Spec.Freight,
+			Supersedable: p.Spec.Supersedable,
+			Artifacts:    artifacts,
 		},
 		Status: &v1alpha1.PromotionStatus{
-			Phase: string(p.Status.Phase),
-			Error: p.Status.Error,
+			Phase:            string(p.Status.Phase),
+			Error:            p.Status.Error,
+			Attempts:         p.Status.Attempts,
+			AttemptStartTime: attemptStartTime,
+			DerivedFreight:   p.Status.DerivedFreight,
+			Steps:            steps,
+			Conditions:       toConditionProtos(p.Status.Conditions),
 		},
 	}
 }
 
+func ToArtifactSelectorProto(
+	s kargoapi.ArtifactSelector,
+) *v1alpha1.ArtifactSelector {
+	var include, exclude *v1alpha1.ArtifactReferences
+	if s.Include != nil {
+		include = ToArtifactReferencesProto(*s.Include)
+	}
+	if s.Exclude != nil {
+		exclude = ToArtifactReferencesProto(*s.Exclude)
+	}
+	return &v1alpha1.ArtifactSelector{
+		Include: include,
+		Exclude: exclude,
+	}
+}
+
+func ToArtifactReferencesProto(
+	r kargoapi.ArtifactReferences,
+) *v1alpha1.ArtifactReferences {
+	return &v1alpha1.ArtifactReferences{
+		Images:  r.Images,
+		Charts:  r.Charts,
+		Commits: r.Commits,
+	}
+}
+
+func ToPromotionStepResultProto(
+	s kargoapi.PromotionStepResult,
+) *v1alpha1.PromotionStepResult {
+	var startTime, endTime *timestamppb.Timestamp
+	if s.StartTime != nil {
+		startTime = timestamppb.New(s.StartTime.Time)
+	}
+	if s.EndTime != nil {
+		endTime = timestamppb.New(s.EndTime.Time)
+	}
+	return &v1alpha1.PromotionStepResult{
+		Mechanism: s.Mechanism,
+		Target:    s.Target,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Outcome:   string(s.Outcome),
+		Commit:    s.Commit,
+		Job:       s.Job,
+		Error:     s.Error,
+
+		PullRequestUrl: s.PullRequestURL,
+	}
+}
+
 func ToPromotionPolicyProto(p kargoapi.PromotionPolicy) *v1alpha1.PromotionPolicy {
 	metadata := p.ObjectMeta.DeepCopy()
 	metadata.SetManagedFields(nil)
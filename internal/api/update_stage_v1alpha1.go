@@ -46,6 +46,10 @@ func (s *server) UpdateStage(
 	if err := s.validateProject(ctx, stage.GetNamespace()); err != nil {
 		return nil, err
 	}
+	warnings, err := s.validateStageSubscriptions(ctx, &stage)
+	if err != nil {
+		return nil, err
+	}
 	var existingStage kargoapi.Stage
 	if err := s.client.Get(ctx, client.ObjectKeyFromObject(&stage), &existingStage); err != nil {
 		if kubeerr.IsNotFound(err) {
@@ -57,6 +61,7 @@ func (s *server) UpdateStage(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return connect.NewResponse(&svcv1alpha1.UpdateStageResponse{
-		Stage: typesv1alpha1.ToStageProto(stage),
+		Stage:    typesv1alpha1.ToStageProto(stage),
+		Warnings: warnings,
 	}), nil
 }
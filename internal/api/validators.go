@@ -6,7 +6,9 @@ import (
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/api/validation"
 )
 
@@ -76,3 +78,23 @@ func validateGroupByOrderBy(group string, groupBy string, orderBy string) error
 
 	return nil
 }
+
+// validateStageSubscriptions returns an error if the subscriptions of the
+// provided Stage would introduce a cycle into its project's pipeline graph.
+// Otherwise, it returns warnings about any subscriptions to Warehouses or
+// upstream Stages that do not exist. Any pending Stages or Warehouses are
+// treated as if they already existed.
+func (s *server) validateStageSubscriptions(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	pending ...client.Object,
+) ([]string, error) {
+	issues, err := s.checkStageSubscriptionsFn(ctx, s.client, stage, pending...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err = issues.CycleError(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return issues.Warnings, nil
+}
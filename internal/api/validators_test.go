@@ -10,7 +10,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/api/validation"
+	"github.com/akuity/kargo/internal/kargo"
 )
 
 func TestValidateProject(t *testing.T) {
@@ -230,3 +232,80 @@ func TestValidateGroupByOrderBy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStageSubscriptions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		server     *server
+		assertions func([]string, error)
+	}{
+		{
+			name: "error checking subscriptions",
+			server: &server{
+				checkStageSubscriptionsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					...client.Object,
+				) (kargo.StageSubscriptionIssues, error) {
+					return kargo.StageSubscriptionIssues{},
+						errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "cycle",
+			server: &server{
+				checkStageSubscriptionsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					...client.Object,
+				) (kargo.StageSubscriptionIssues, error) {
+					return kargo.StageSubscriptionIssues{
+						Cycle:    []string{"a", "b", "a"},
+						Warnings: []string{"something is missing"},
+					}, nil
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "a -> b -> a")
+			},
+		},
+		{
+			name: "warnings",
+			server: &server{
+				checkStageSubscriptionsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					...client.Object,
+				) (kargo.StageSubscriptionIssues, error) {
+					return kargo.StageSubscriptionIssues{
+						Warnings: []string{"something is missing"},
+					}, nil
+				},
+			},
+			assertions: func(warnings []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"something is missing"}, warnings)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.validateStageSubscriptions(
+					context.Background(),
+					&kargoapi.Stage{},
+				),
+			)
+		})
+	}
+}
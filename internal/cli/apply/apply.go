@@ -20,6 +20,7 @@ import (
 
 type Flags struct {
 	Filenames []string
+	DryRun    bool
 }
 
 func NewCommand(opt *option.Option) *cobra.Command {
@@ -30,6 +31,9 @@ func NewCommand(opt *option.Option) *cobra.Command {
 		Example: `
 # Apply a stage using the data in stage.yaml
 kargo apply -f stage.yaml
+
+# Validate the resources in stage.yaml without applying them
+kargo apply -f stage.yaml --dry-run
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -61,6 +65,7 @@ kargo apply -f stage.yaml
 			resp, err := kargoSvcCli.CreateOrUpdateResource(ctx,
 				connect.NewRequest(&kargosvcapi.CreateOrUpdateResourceRequest{
 					Manifest: rawManifest,
+					DryRun:   flag.DryRun,
 				}))
 			if err != nil {
 				return errors.Wrap(err, "apply resource")
@@ -70,7 +75,14 @@ kargo apply -f stage.yaml
 			createdRes := make([]*kargosvcapi.CreateOrUpdateResourceResult_CreatedResourceManifest, 0, resCap)
 			updatedRes := make([]*kargosvcapi.CreateOrUpdateResourceResult_UpdatedResourceManifest, 0, resCap)
 			errs := make([]error, 0, resCap)
+			var dryRunSuffix string
+			if flag.DryRun {
+				dryRunSuffix = " (dry run)"
+			}
 			for _, r := range resp.Msg.GetResults() {
+				for _, w := range r.GetWarnings() {
+					fmt.Fprintf(opt.IOStreams.ErrOut, "Warning: %s\n", w)
+				}
 				switch typedRes := r.GetResult().(type) {
 				case *kargosvcapi.CreateOrUpdateResourceResult_CreatedResourceManifest:
 					createdRes = append(createdRes, typedRes)
@@ -92,7 +104,7 @@ kargo apply -f stage.yaml
 						Namespace: obj.GetNamespace(),
 						Name:      obj.GetName(),
 					}.String()
-					fmt.Fprintf(opt.IOStreams.Out, "%s Created: %q\n", obj.GetKind(), name)
+					fmt.Fprintf(opt.IOStreams.Out, "%s Created: %q%s\n", obj.GetKind(), name, dryRunSuffix)
 					continue
 				}
 				_ = printer.PrintObj(&obj, opt.IOStreams.Out)
@@ -109,7 +121,7 @@ kargo apply -f stage.yaml
 						Namespace: obj.GetNamespace(),
 						Name:      obj.GetName(),
 					}.String()
-					fmt.Fprintf(opt.IOStreams.Out, "%s Updated: %q\n", obj.GetKind(), name)
+					fmt.Fprintf(opt.IOStreams.Out, "%s Updated: %q%s\n", obj.GetKind(), name, dryRunSuffix)
 					continue
 				}
 				_ = printer.PrintObj(&obj, opt.IOStreams.Out)
@@ -119,5 +131,7 @@ kargo apply -f stage.yaml
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Filenames("apply", &flag.Filenames)(cmd.Flags())
+	cmd.Flags().BoolVar(&flag.DryRun, "dry-run", false,
+		"Validate the resources without persisting them")
 	return cmd
 }
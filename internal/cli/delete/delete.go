@@ -19,6 +19,8 @@ import (
 
 type Flags struct {
 	Filenames []string
+	Cascade   bool
+	Force     bool
 }
 
 func NewCommand(opt *option.Option) *cobra.Command {
@@ -35,6 +37,9 @@ kargo delete stage --project=my-project my-stage
 
 # Delete a stage using the data in stage.yaml
 kargo delete -f stage.yaml
+
+# Delete a warehouse along with all stages downstream of it
+kargo delete -f warehouse.yaml --cascade
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -54,6 +59,8 @@ kargo delete -f stage.yaml
 
 			resp, err := kargoSvcCli.DeleteResource(ctx, connect.NewRequest(&kargosvcapi.DeleteResourceRequest{
 				Manifest: manifest,
+				Cascade:  flag.Cascade,
+				Force:    flag.Force,
 			}))
 			if err != nil {
 				return errors.Wrap(err, "delete resource")
@@ -87,9 +94,19 @@ kargo delete -f stage.yaml
 		},
 	}
 	option.Filenames("delete", &flag.Filenames)(cmd.Flags())
+	addDeletionFlags(cmd, &flag)
 
 	// Subcommands
 	cmd.AddCommand(newProjectCommand(opt))
 	cmd.AddCommand(newStageCommand(opt))
 	return cmd
 }
+
+// addDeletionFlags adds flags controlling how resources that other resources
+// depend on are deleted.
+func addDeletionFlags(cmd *cobra.Command, flag *Flags) {
+	cmd.Flags().BoolVar(&flag.Cascade, "cascade", false,
+		"Also delete all stages downstream of deleted stages and warehouses")
+	cmd.Flags().BoolVar(&flag.Force, "force", false,
+		"Delete resources even if other resources depend on them")
+}
@@ -15,6 +15,7 @@ import (
 )
 
 func newStageCommand(opt *option.Option) *cobra.Command {
+	var flag Flags
 	cmd := &cobra.Command{
 		Use:   "stage [NAME]...",
 		Short: "Delete stage by name",
@@ -22,6 +23,9 @@ func newStageCommand(opt *option.Option) *cobra.Command {
 		Example: `
 # Delete stage
 kargo delete stage --project=my-project my-stage
+
+# Delete stage along with all stages downstream of it
+kargo delete stage --project=my-project my-stage --cascade
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -37,19 +41,26 @@ kargo delete stage --project=my-project my-stage
 
 			var resErr error
 			for _, name := range slices.Compact(args) {
-				if _, err := kargoSvcCli.DeleteStage(ctx, connect.NewRequest(&v1alpha1.DeleteStageRequest{
+				res, err := kargoSvcCli.DeleteStage(ctx, connect.NewRequest(&v1alpha1.DeleteStageRequest{
 					Project: project,
 					Name:    name,
-				})); err != nil {
+					Cascade: flag.Cascade,
+					Force:   flag.Force,
+				}))
+				if err != nil {
 					resErr = goerrors.Join(resErr, errors.Wrap(err, "Error"))
 					continue
 				}
+				for _, cascaded := range res.Msg.GetCascadedStages() {
+					_, _ = fmt.Fprintf(opt.IOStreams.Out, "Stage Deleted: %q\n", cascaded)
+				}
 				_, _ = fmt.Fprintf(opt.IOStreams.Out, "Stage Deleted: %q\n", name)
 			}
 			return resErr
 		},
 	}
 	opt.PrintFlags.AddFlags(cmd)
+	addDeletionFlags(cmd, &flag)
 	option.OptionalProject(opt.Project)(cmd.Flags())
 	return cmd
 }
@@ -25,10 +25,14 @@ kargo get stages --project=my-project
 
 # List all promotions for the given stage
 kargo get promotions --project=my-project --stage=my-stage
+
+# Display the pipeline graph of the project
+kargo get graph --project=my-project -o mermaid
 `,
 	}
 	// Subcommands
 	cmd.AddCommand(newGetFreightCommand(opt))
+	cmd.AddCommand(newGetGraphCommand(opt))
 	cmd.AddCommand(newGetProjectsCommand(opt))
 	cmd.AddCommand(newGetPromotionsCommand(opt))
 	cmd.AddCommand(newGetStagesCommand(opt))
@@ -48,7 +52,11 @@ func printObjects[T runtime.Object](opt *option.Option, objects []T) error {
 		Items: items,
 	}
 
-	if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") != "" {
+	// The wide output format is handled by the table printer rather than by the
+	// print flags.
+	outputFormat := pointer.StringDeref(opt.PrintFlags.OutputFormat, "")
+	wide := outputFormat == "wide"
+	if outputFormat != "" && !wide {
 		printer, err := opt.PrintFlags.ToPrinter()
 		if err != nil {
 			return errors.Wrap(err, "new printer")
@@ -59,15 +67,16 @@ func printObjects[T runtime.Object](opt *option.Option, objects []T) error {
 		return printer.PrintObj(list, opt.IOStreams.Out)
 	}
 
+	printOpts := printers.PrintOptions{Wide: wide}
 	var t T
 	switch any(t).(type) {
 	case *kargoapi.Stage:
 		table := newStageTable(list)
-		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opt.IOStreams.Out)
+		return printers.NewTablePrinter(printOpts).PrintObj(table, opt.IOStreams.Out)
 	case *kargoapi.Promotion:
 		table := newPromotionTable(list)
-		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opt.IOStreams.Out)
+		return printers.NewTablePrinter(printOpts).PrintObj(table, opt.IOStreams.Out)
 	default:
-		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(list, opt.IOStreams.Out)
+		return printers.NewTablePrinter(printOpts).PrintObj(list, opt.IOStreams.Out)
 	}
 }
@@ -0,0 +1,199 @@
+package get
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	graphOutputFormatDOT     = "dot"
+	graphOutputFormatJSON    = "json"
+	graphOutputFormatMermaid = "mermaid"
+)
+
+type GraphFlags struct {
+	Output string
+}
+
+func newGetGraphCommand(opt *option.Option) *cobra.Command {
+	var flag GraphFlags
+	cmd := &cobra.Command{
+		Use:   "graph --project=project [-o dot|json|mermaid]",
+		Short: "Display the pipeline graph of a project",
+		Example: `
+# Render the pipeline graph of the project using Graphviz
+kargo get graph --project=my-project | dot -Tsvg > graph.svg
+
+# Display the pipeline graph of the project as a Mermaid flowchart
+kargo get graph --project=my-project -o mermaid
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			var render func(io.Writer, string, *v1alpha1.GetProjectGraphResponse) error
+			switch strings.ToLower(strings.TrimSpace(flag.Output)) {
+			case graphOutputFormatDOT:
+				render = renderGraphDOT
+			case graphOutputFormatJSON:
+				render = renderGraphJSON
+			case graphOutputFormatMermaid:
+				render = renderGraphMermaid
+			default:
+				return errors.Errorf(
+					"unsupported output format %q; must be one of %q, %q, or %q",
+					flag.Output,
+					graphOutputFormatDOT,
+					graphOutputFormatJSON,
+					graphOutputFormatMermaid,
+				)
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return errors.New("get client from config")
+			}
+			resp, err := kargoSvcCli.GetProjectGraph(ctx, connect.NewRequest(&v1alpha1.GetProjectGraphRequest{
+				Project: project,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "get project graph")
+			}
+			return render(opt.IOStreams.Out, project, resp.Msg)
+		},
+	}
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	cmd.Flags().StringVarP(&flag.Output, "output", "o", graphOutputFormatDOT,
+		"Output format. One of: dot|json|mermaid")
+	return cmd
+}
+
+// graphNodeLabelLines returns the lines of text with which a node of a project
+// graph should be labeled.
+func graphNodeLabelLines(node *v1alpha1.ProjectGraphNode) []string {
+	lines := []string{node.GetName()}
+	if node.GetKind() != "Stage" {
+		return append(lines, fmt.Sprintf("(%s)", node.GetKind()))
+	}
+	if node.GetCurrentFreight() != "" {
+		lines = append(lines, fmt.Sprintf("freight: %s", node.GetCurrentFreight()))
+	}
+	if node.GetHealth() != "" {
+		lines = append(lines, fmt.Sprintf("health: %s", node.GetHealth()))
+	}
+	if node.GetLocked() {
+		lines = append(lines, fmt.Sprintf("locked: %s", node.GetLockReason()))
+	}
+	return lines
+}
+
+// graphNodeIDs returns a map of short, unique identifiers for each of the
+// nodes of a project graph, indexed by the nodes' kind and name.
+func graphNodeIDs(graph *v1alpha1.GetProjectGraphResponse) map[string]string {
+	ids := make(map[string]string, len(graph.GetNodes()))
+	for i, node := range graph.GetNodes() {
+		ids[graphNodeKey(node.GetKind(), node.GetName())] = fmt.Sprintf("n%d", i)
+	}
+	return ids
+}
+
+func graphNodeKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+func renderGraphDOT(
+	w io.Writer,
+	project string,
+	graph *v1alpha1.GetProjectGraphResponse,
+) error {
+	ids := graphNodeIDs(graph)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph %q {\n", project)
+	sb.WriteString("  rankdir=LR;\n")
+	for _, node := range graph.GetNodes() {
+		shape := "box"
+		if node.GetKind() != "Stage" {
+			shape = "cylinder"
+		}
+		fmt.Fprintf(
+			&sb,
+			"  %s [label=%q, shape=%s];\n",
+			ids[graphNodeKey(node.GetKind(), node.GetName())],
+			strings.Join(graphNodeLabelLines(node), "\n"),
+			shape,
+		)
+	}
+	for _, edge := range graph.GetEdges() {
+		fmt.Fprintf(
+			&sb,
+			"  %s -> %s;\n",
+			ids[graphNodeKey(edge.GetFromKind(), edge.GetFromName())],
+			ids[graphNodeKey(edge.GetToKind(), edge.GetToName())],
+		)
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func renderGraphMermaid(
+	w io.Writer,
+	_ string,
+	graph *v1alpha1.GetProjectGraphResponse,
+) error {
+	ids := graphNodeIDs(graph)
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for _, node := range graph.GetNodes() {
+		label := strings.ReplaceAll(
+			strings.Join(graphNodeLabelLines(node), "<br/>"),
+			`"`,
+			"#quot;",
+		)
+		format := "  %s[\"%s\"]\n"
+		if node.GetKind() != "Stage" {
+			format = "  %s[(\"%s\")]\n"
+		}
+		fmt.Fprintf(&sb, format, ids[graphNodeKey(node.GetKind(), node.GetName())], label)
+	}
+	for _, edge := range graph.GetEdges() {
+		fmt.Fprintf(
+			&sb,
+			"  %s --> %s\n",
+			ids[graphNodeKey(edge.GetFromKind(), edge.GetFromName())],
+			ids[graphNodeKey(edge.GetToKind(), edge.GetToName())],
+		)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func renderGraphJSON(
+	w io.Writer,
+	_ string,
+	graph *v1alpha1.GetProjectGraphResponse,
+) error {
+	b, err := protojson.MarshalOptions{
+		Multiline: true,
+		Indent:    "  ",
+	}.Marshal(graph)
+	if err != nil {
+		return errors.Wrap(err, "marshal project graph")
+	}
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
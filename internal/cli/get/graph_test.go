@@ -0,0 +1,65 @@
+package get
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+var testGraph = &v1alpha1.GetProjectGraphResponse{
+	Nodes: []*v1alpha1.ProjectGraphNode{
+		{
+			Kind: "Warehouse",
+			Name: "fake-warehouse",
+		},
+		{
+			Kind:           "Stage",
+			Name:           "fake-stage",
+			CurrentFreight: "fake-freight",
+			Health:         "Healthy",
+			Locked:         true,
+			LockReason:     `"fake" reason`,
+		},
+	},
+	Edges: []*v1alpha1.ProjectGraphEdge{
+		{
+			FromKind: "Warehouse",
+			FromName: "fake-warehouse",
+			ToKind:   "Stage",
+			ToName:   "fake-stage",
+		},
+	},
+}
+
+func TestRenderGraphDOT(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, renderGraphDOT(buf, "fake-project", testGraph))
+	require.Equal(
+		t,
+		`digraph "fake-project" {
+  rankdir=LR;
+  n0 [label="fake-warehouse\n(Warehouse)", shape=cylinder];
+  n1 [label="fake-stage\nfreight: fake-freight\nhealth: Healthy\nlocked: \"fake\" reason", shape=box];
+  n0 -> n1;
+}
+`,
+		buf.String(),
+	)
+}
+
+func TestRenderGraphMermaid(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, renderGraphMermaid(buf, "fake-project", testGraph))
+	require.Equal(
+		t,
+		`flowchart LR
+  n0[("fake-warehouse<br/>(Warehouse)")]
+  n1["fake-stage<br/>freight: fake-freight<br/>health: Healthy<br/>locked: #quot;fake#quot; reason"]
+  n0 --> n1
+`,
+		buf.String(),
+	)
+}
@@ -2,6 +2,7 @@ package get
 
 import (
 	goerrors "errors"
+	"fmt"
 	"time"
 
 	"connectrpc.com/connect"
@@ -38,6 +39,9 @@ kargo get promotions --project=my-project
 # List all promotions in JSON output format
 kargo get promotions --project=my-project -o json
 
+# List all promotions along with a summary of their steps
+kargo get promotions --project=my-project -o wide
+
 # List all promotions for the stage
 kargo get promotions --project=my-project --stage=my-stage
 
@@ -110,6 +114,8 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 				promo.Spec.Freight,
 				promo.GetStatus().Phase,
 				duration.HumanDuration(time.Since(promo.CreationTimestamp.Time)),
+				promotionStepsSummary(promo.Status.Steps),
+				promotionLastStep(promo.Status.Steps),
 			},
 			Object: list.Items[i],
 		}
@@ -121,7 +127,50 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 			{Name: "Freight", Type: "string"},
 			{Name: "Phase", Type: "string"},
 			{Name: "Age", Type: "string"},
+			{Name: "Steps", Type: "string", Priority: 1},
+			{Name: "Last Step", Type: "string", Priority: 1},
 		},
 		Rows: rows,
 	}
 }
+
+// promotionStepsSummary returns the number of steps that succeeded out of the
+// total number of steps executed so far.
+func promotionStepsSummary(steps []kargoapi.PromotionStepResult) string {
+	var succeeded int
+	for _, step := range steps {
+		if step.Outcome == kargoapi.PromotionStepOutcomeSucceeded {
+			succeeded++
+		}
+	}
+	return fmt.Sprintf("%d/%d", succeeded, len(steps))
+}
+
+// promotionLastStep describes the most recently executed step, including its
+// target, outcome, and duration, along with its commit, pull request, Job, or
+// error, if any.
+func promotionLastStep(steps []kargoapi.PromotionStepResult) string {
+	if len(steps) == 0 {
+		return ""
+	}
+	step := steps[len(steps)-1]
+	desc := fmt.Sprintf("%s: %s", step.Target, step.Outcome)
+	if step.StartTime != nil && step.EndTime != nil {
+		desc = fmt.Sprintf(
+			"%s in %s",
+			desc,
+			step.EndTime.Sub(step.StartTime.Time).Round(time.Millisecond),
+		)
+	}
+	switch {
+	case step.Error != "":
+		desc = fmt.Sprintf("%s (%s)", desc, step.Error)
+	case step.Commit != "":
+		desc = fmt.Sprintf("%s (commit %s)", desc, step.Commit)
+	case step.PullRequestURL != "":
+		desc = fmt.Sprintf("%s (pull request %s)", desc, step.PullRequestURL)
+	case step.Job != "":
+		desc = fmt.Sprintf("%s (job %s)", desc, step.Job)
+	}
+	return desc
+}
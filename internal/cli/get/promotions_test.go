@@ -0,0 +1,76 @@
+package get
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestPromotionStepsColumns(t *testing.T) {
+	start := time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC)
+	end := start.Add(1500 * time.Millisecond)
+	testCases := []struct {
+		name             string
+		steps            []kargoapi.PromotionStepResult
+		expectedSummary  string
+		expectedLastStep string
+	}{
+		{
+			name:            "no steps",
+			expectedSummary: "0/0",
+		},
+		{
+			name: "last step produced a commit",
+			steps: []kargoapi.PromotionStepResult{
+				{
+					Target:    "fake-repo (branch fake-branch)",
+					StartTime: &metav1.Time{Time: start},
+					EndTime:   &metav1.Time{Time: end},
+					Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+					Commit:    "fake-commit-id",
+				},
+			},
+			expectedSummary:  "1/1",
+			expectedLastStep: "fake-repo (branch fake-branch): Succeeded in 1.5s (commit fake-commit-id)",
+		},
+		{
+			name: "last step ran a Job",
+			steps: []kargoapi.PromotionStepResult{
+				{
+					Target:  "hook migrate",
+					Outcome: kargoapi.PromotionStepOutcomeSucceeded,
+					Job:     "fake-stage-migrate-abcde",
+				},
+			},
+			expectedSummary:  "1/1",
+			expectedLastStep: "hook migrate: Succeeded (job fake-stage-migrate-abcde)",
+		},
+		{
+			name: "last step errored",
+			steps: []kargoapi.PromotionStepResult{
+				{
+					Target:  "fake-repo",
+					Outcome: kargoapi.PromotionStepOutcomeSucceeded,
+				},
+				{
+					Target:  "Argo CD Application argocd/fake-app",
+					Outcome: kargoapi.PromotionStepOutcomeErrored,
+					Error:   errors.New("something went wrong").Error(),
+				},
+			},
+			expectedSummary:  "1/2",
+			expectedLastStep: "Argo CD Application argocd/fake-app: Errored (something went wrong)",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedSummary, promotionStepsSummary(testCase.steps))
+			require.Equal(t, testCase.expectedLastStep, promotionLastStep(testCase.steps))
+		})
+	}
+}
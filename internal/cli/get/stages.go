@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/duration"
 
@@ -95,12 +96,22 @@ func newStageTable(list *metav1.List) *metav1.Table {
 		if stage.Status.Health != nil {
 			health = string(stage.Status.Health.Status)
 		}
+		var ready, readyReason string
+		if condition := meta.FindStatusCondition(
+			stage.Status.Conditions,
+			kargoapi.ConditionTypeReady,
+		); condition != nil {
+			ready = string(condition.Status)
+			readyReason = condition.Reason
+		}
 		rows[i] = metav1.TableRow{
 			Cells: []any{
 				stage.Name,
 				currentFreightID,
 				health,
+				ready,
 				duration.HumanDuration(time.Since(stage.CreationTimestamp.Time)),
+				readyReason,
 			},
 			Object: list.Items[i],
 		}
@@ -110,7 +121,9 @@ func newStageTable(list *metav1.List) *metav1.Table {
 			{Name: "Name", Type: "string"},
 			{Name: "Current Freight", Type: "string"},
 			{Name: "Health", Type: "string"},
+			{Name: "Ready", Type: "string"},
 			{Name: "Age", Type: "string"},
+			{Name: "Reason", Type: "string", Priority: 1},
 		},
 		Rows: rows,
 	}
@@ -0,0 +1,68 @@
+package promotion
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/pointer"
+
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newAbortCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "abort [NAME]",
+		Short: "Abort a pending or running promotion",
+		Args:  cobra.ExactArgs(1),
+		Example: `
+# Abort a promotion
+kargo promotion abort --project=my-project my-promotion
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project.OrElse("")
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return err
+			}
+			res, err := kargoSvcCli.AbortPromotion(ctx, connect.NewRequest(&v1alpha1.AbortPromotionRequest{
+				Project: project,
+				Name:    name,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "abort promotion")
+			}
+
+			if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") == "" {
+				fmt.Fprintf(opt.IOStreams.Out,
+					"Promotion Abort Requested: %q\n", res.Msg.GetPromotion().GetMetadata().GetName())
+				return nil
+			}
+			printer, err := opt.PrintFlags.ToPrinter()
+			if err != nil {
+				return errors.Wrap(err, "new printer")
+			}
+			promo := typesv1alpha1.FromPromotionProto(res.Msg.GetPromotion())
+			_ = printer.PrintObj(promo, opt.IOStreams.Out)
+			return nil
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	option.OptionalProject(opt.Project)(cmd.Flags())
+	return cmd
+}
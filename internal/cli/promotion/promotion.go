@@ -0,0 +1,16 @@
+package promotion
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promotion",
+		Short: "Manage promotions",
+	}
+	cmd.AddCommand(newAbortCommand(opt))
+	return cmd
+}
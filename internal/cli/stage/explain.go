@@ -0,0 +1,134 @@
+package stage
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/utils/pointer"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newExplainCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain",
+		Short: "Explain why a stage is or is not being auto-promoted",
+		Args:  cobra.ExactArgs(2),
+		Example: `
+# Explain which freight is eligible for auto-promotion into the stage
+kargo stage explain my-project my-stage
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return err
+			}
+			res, err := kargoSvcCli.ExplainStage(ctx, connect.NewRequest(&v1alpha1.ExplainStageRequest{
+				Project: project,
+				Name:    name,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "explain stage")
+			}
+			switch format := pointer.StringDeref(opt.PrintFlags.OutputFormat, ""); format {
+			case "":
+				return printStageExplanation(opt.IOStreams.Out, res.Msg, time.Now())
+			case "json":
+				b, err := protojson.MarshalOptions{
+					Multiline: true,
+					Indent:    "  ",
+				}.Marshal(res.Msg)
+				if err != nil {
+					return errors.Wrap(err, "marshal stage explanation")
+				}
+				_, err = fmt.Fprintln(opt.IOStreams.Out, string(b))
+				return err
+			default:
+				return errors.Errorf("output format %q is not supported", format)
+			}
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	return cmd
+}
+
+func printStageExplanation(
+	w io.Writer,
+	explanation *v1alpha1.ExplainStageResponse,
+	now time.Time,
+) error {
+	var sb strings.Builder
+	if decision := explanation.GetLastDecision(); decision != nil {
+		fmt.Fprintf(&sb, "Last decision: %s", decision.GetReason())
+		if decision.GetTime() != nil {
+			fmt.Fprintf(
+				&sb,
+				" (%s ago)",
+				duration.HumanDuration(now.Sub(decision.GetTime().AsTime())),
+			)
+		}
+		if decision.GetMessage() != "" {
+			fmt.Fprintf(&sb, ": %s", decision.GetMessage())
+		}
+		sb.WriteString("\n")
+	}
+	if blocker := explanation.GetBlocker(); blocker != nil {
+		fmt.Fprintf(
+			&sb,
+			"Auto-promotion blocked: %s: %s\n",
+			blocker.GetReason(),
+			blocker.GetMessage(),
+		)
+	} else {
+		sb.WriteString("Auto-promotion is not blocked\n")
+	}
+	sb.WriteString("\n")
+	if len(explanation.GetCandidates()) == 0 {
+		sb.WriteString("No Freight is available to the Stage\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+	tw := tabwriter.NewWriter(&sb, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "FREIGHT\tAGE\tELIGIBLE\tREASON\tMESSAGE")
+	for _, candidate := range explanation.GetCandidates() {
+		var age string
+		if candidate.GetCreated() != nil {
+			age = duration.HumanDuration(now.Sub(candidate.GetCreated().AsTime()))
+		}
+		fmt.Fprintf(
+			tw,
+			"%s\t%s\t%t\t%s\t%s\n",
+			candidate.GetFreight(),
+			age,
+			candidate.GetEligible(),
+			candidate.GetReason(),
+			candidate.GetMessage(),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return errors.Wrap(err, "flush table")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,76 @@
+package stage
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	pkgv1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestPrintStageExplanation(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name        string
+		explanation *v1alpha1.ExplainStageResponse
+		expected    string
+	}{
+		{
+			name:        "no Freight",
+			explanation: &v1alpha1.ExplainStageResponse{},
+			expected: `Auto-promotion is not blocked
+
+No Freight is available to the Stage
+`,
+		},
+		{
+			name: "blocked",
+			explanation: &v1alpha1.ExplainStageResponse{
+				LastDecision: &pkgv1alpha1.AutoPromotionDecision{
+					Time:    timestamppb.New(now.Add(-5 * time.Minute)),
+					Reason:  "Locked",
+					Message: "fake-message",
+				},
+				Blocker: &pkgv1alpha1.AutoPromotionDecision{
+					Reason:  "Locked",
+					Message: "fake-message",
+				},
+				Candidates: []*v1alpha1.FreightEligibility{
+					{
+						Freight: "newer-freight",
+						Created: timestamppb.New(now.Add(-time.Hour)),
+						Reason:  "Locked",
+						Message: "fake-message",
+					},
+					{
+						Freight: "older-freight",
+						Created: timestamppb.New(now.Add(-2 * time.Hour)),
+						Reason:  "NotLatest",
+						Message: "another-fake-message",
+					},
+				},
+			},
+			expected: `Last decision: Locked (5m ago): fake-message
+Auto-promotion blocked: Locked: fake-message
+
+FREIGHT         AGE    ELIGIBLE   REASON      MESSAGE
+newer-freight   60m    false      Locked      fake-message
+older-freight   120m   false      NotLatest   another-fake-message
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(
+				t,
+				printStageExplanation(buf, testCase.explanation, now),
+			)
+			require.Equal(t, testCase.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package stage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/pointer"
+
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	pkgv1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+type LockFlags struct {
+	Reason   string
+	Until    string
+	Duration time.Duration
+}
+
+func newLockCommand(opt *option.Option) *cobra.Command {
+	var flag LockFlags
+	cmd := &cobra.Command{
+		Use:  "lock",
+		Args: cobra.ExactArgs(2),
+		Example: "kargo stage lock (PROJECT) (NAME) --reason=reason " +
+			"[--until=2006-01-02T15:04:05Z | --duration=1h]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return errors.New("name is required")
+			}
+			reason := strings.TrimSpace(flag.Reason)
+			if reason == "" {
+				return errors.New("reason is required")
+			}
+			req := &v1alpha1.LockStageRequest{
+				Project: project,
+				Name:    name,
+				Reason:  reason,
+			}
+			until := strings.TrimSpace(flag.Until)
+			switch {
+			case until != "" && flag.Duration != 0:
+				return errors.New("only one of until or duration may be specified")
+			case until != "":
+				t, err := time.Parse(time.RFC3339, until)
+				if err != nil {
+					return errors.Wrap(err, "parse until")
+				}
+				req.Until = timestamppb.New(t)
+			case flag.Duration < 0:
+				return errors.New("duration must be positive")
+			case flag.Duration > 0:
+				req.Until = timestamppb.New(time.Now().Add(flag.Duration))
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return err
+			}
+			res, err := kargoSvcCli.LockStage(ctx, connect.NewRequest(req))
+			if err != nil {
+				return errors.Wrap(err, "lock stage")
+			}
+			return printStageLockResult(opt, res.Msg.GetStage(), "Locked")
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	cmd.Flags().StringVar(&flag.Reason, "reason", "", "Reason for locking the Stage")
+	cmd.Flags().StringVar(&flag.Until, "until", "",
+		"Time (RFC3339) at which the lock expires")
+	cmd.Flags().DurationVar(&flag.Duration, "duration", 0,
+		"Duration after which the lock expires")
+	return cmd
+}
+
+func newUnlockCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unlock",
+		Args:    cobra.ExactArgs(2),
+		Example: "kargo stage unlock (PROJECT) (NAME)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return err
+			}
+			res, err := kargoSvcCli.UnlockStage(ctx, connect.NewRequest(&v1alpha1.UnlockStageRequest{
+				Project: project,
+				Name:    name,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "unlock stage")
+			}
+			return printStageLockResult(opt, res.Msg.GetStage(), "Unlocked")
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	return cmd
+}
+
+func printStageLockResult(
+	opt *option.Option,
+	stage *pkgv1alpha1.Stage,
+	action string,
+) error {
+	if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") == "" {
+		fmt.Fprintf(opt.IOStreams.Out,
+			"%s Stage %q\n", action, stage.GetMetadata().GetName())
+		return nil
+	}
+	printer, err := opt.PrintFlags.ToPrinter()
+	if err != nil {
+		return errors.Wrap(err, "new printer")
+	}
+	_ = printer.PrintObj(typesv1alpha1.FromStageProto(stage), opt.IOStreams.Out)
+	return nil
+}
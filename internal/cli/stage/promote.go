@@ -2,29 +2,47 @@ package stage
 
 import (
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
 	"k8s.io/utils/pointer"
 
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/option"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	pkgv1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
 )
 
 type PromoteFlags struct {
-	Freight string
+	Freight       string
+	OverrideLock  bool
+	DryRun        bool
+	Include       []string
+	Exclude       []string
+	Selector      string
+	BatchSize     uint32
+	Pause         time.Duration
+	HaltOnFailure bool
 }
 
 func newPromoteCommand(opt *option.Option) *cobra.Command {
 	var flag PromoteFlags
 	cmd := &cobra.Command{
-		Use:     "promote",
-		Args:    cobra.ExactArgs(2),
-		Example: "kargo stage promote (PROJECT) (NAME) [(--freight=)freight-id]",
+		Use:  "promote",
+		Args: cobra.RangeArgs(1, 2),
+		Example: `
+# Promote freight into a single stage
+kargo stage promote (PROJECT) (NAME) [(--freight=)freight-id] [--override-lock] [--dry-run] [--include=image=nginx]
+
+# Promote freight into every eligible stage matching a label selector, in waves
+kargo stage promote (PROJECT) --selector=tier=prod [(--freight=)freight-id] [--batch-size=5] [--pause=10m] [--halt-on-failure]
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
@@ -36,20 +54,81 @@ func newPromoteCommand(opt *option.Option) *cobra.Command {
 			if project == "" {
 				return errors.New("project is required")
 			}
-			name := strings.TrimSpace(args[1])
-			if name == "" {
-				return errors.New("name is required")
-			}
 			freight := strings.TrimSpace(flag.Freight)
 			if freight == "" {
 				// TODO: Get latest available freight if empty
 				return errors.New("freight is required")
 			}
+			if flag.Selector != "" {
+				if len(args) > 1 {
+					return errors.New("name cannot be specified with --selector")
+				}
+				if flag.OverrideLock || flag.DryRun ||
+					len(flag.Include) > 0 || len(flag.Exclude) > 0 {
+					return errors.New(
+						"--override-lock, --dry-run, --include and --exclude " +
+							"cannot be used with --selector",
+					)
+				}
+				return promoteStages(ctx, opt, kargoSvcCli, project, freight, flag)
+			}
+			if flag.BatchSize > 0 || flag.Pause > 0 || flag.HaltOnFailure {
+				return errors.New(
+					"--batch-size, --pause and --halt-on-failure require --selector",
+				)
+			}
+			if len(args) < 2 {
+				return errors.New("name is required")
+			}
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return errors.New("name is required")
+			}
+			artifacts, err := newArtifactSelector(flag.Include, flag.Exclude)
+			if err != nil {
+				return err
+			}
+
+			if flag.DryRun {
+				res, err := kargoSvcCli.PreviewPromotion(
+					ctx,
+					connect.NewRequest(&v1alpha1.PreviewPromotionRequest{
+						Project:   project,
+						Stage:     name,
+						Freight:   freight,
+						Artifacts: artifacts,
+					}),
+				)
+				if err != nil {
+					return errors.Wrap(err, "preview promotion")
+				}
+				switch format := pointer.StringDeref(opt.PrintFlags.OutputFormat, ""); format {
+				case "":
+					return printPromotionPreview(opt.IOStreams.Out, res.Msg)
+				case "json":
+					b, err := protojson.MarshalOptions{
+						Multiline: true,
+						Indent:    "  ",
+					}.Marshal(res.Msg)
+					if err != nil {
+						return errors.Wrap(err, "marshal promotion preview")
+					}
+					_, err = fmt.Fprintln(opt.IOStreams.Out, string(b))
+					return err
+				default:
+					return errors.Errorf(
+						"output format %q is not supported with --dry-run",
+						format,
+					)
+				}
+			}
 
 			res, err := kargoSvcCli.PromoteStage(ctx, connect.NewRequest(&v1alpha1.PromoteStageRequest{
-				Project: project,
-				Name:    name,
-				Freight: freight,
+				Project:      project,
+				Name:         name,
+				Freight:      freight,
+				OverrideLock: flag.OverrideLock,
+				Artifacts:    artifacts,
 			}))
 			if err != nil {
 				return errors.Wrap(err, "promote stage")
@@ -70,5 +149,122 @@ func newPromoteCommand(opt *option.Option) *cobra.Command {
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Freight(&flag.Freight)(cmd.Flags())
+	cmd.Flags().BoolVar(&flag.OverrideLock, "override-lock", false,
+		"Promote even if the Stage is locked (requires permission to override Stage locks)")
+	cmd.Flags().BoolVar(&flag.DryRun, "dry-run", false,
+		"Show the changes the promotion would make without creating a Promotion")
+	cmd.Flags().StringArrayVar(&flag.Include, "include", nil,
+		"Promote only the specified artifact of the freight, given as image=<repo URL>, "+
+			"chart=<name> or commit=<repo URL> (may be repeated)")
+	cmd.Flags().StringArrayVar(&flag.Exclude, "exclude", nil,
+		"Do not promote the specified artifact of the freight, given as image=<repo URL>, "+
+			"chart=<name> or commit=<repo URL> (may be repeated)")
+	cmd.Flags().StringVar(&flag.Selector, "selector", "",
+		"Promote every Stage matching this label selector into which the freight is "+
+			"eligible for promotion, instead of a single named Stage")
+	cmd.Flags().Uint32Var(&flag.BatchSize, "batch-size", 0,
+		"With --selector, promote at most this many Stages at once, waiting for each "+
+			"wave of Promotions to complete before starting the next (0 promotes all at once); "+
+			"waves are only started while this command is running")
+	cmd.Flags().DurationVar(&flag.Pause, "pause", 0,
+		"With --selector and --batch-size, how long to wait between waves")
+	cmd.Flags().BoolVar(&flag.HaltOnFailure, "halt-on-failure", false,
+		"With --selector and --batch-size, do not start further waves once a Promotion fails")
 	return cmd
 }
+
+// newArtifactSelector returns an artifact selector built from the provided
+// --include and --exclude flag values, or nil if both are empty.
+func newArtifactSelector(
+	include []string,
+	exclude []string,
+) (*pkgv1alpha1.ArtifactSelector, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, nil
+	}
+	selector := &pkgv1alpha1.ArtifactSelector{}
+	var err error
+	if selector.Include, err = parseArtifactReferences(include); err != nil {
+		return nil, errors.Wrap(err, "invalid --include")
+	}
+	if selector.Exclude, err = parseArtifactReferences(exclude); err != nil {
+		return nil, errors.Wrap(err, "invalid --exclude")
+	}
+	return selector, nil
+}
+
+// parseArtifactReferences parses values of the form <kind>=<reference>, where
+// kind is one of image, chart or commit. It returns nil if no values are
+// provided.
+func parseArtifactReferences(
+	values []string,
+) (*pkgv1alpha1.ArtifactReferences, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	refs := &pkgv1alpha1.ArtifactReferences{}
+	for _, value := range values {
+		kind, ref, ok := strings.Cut(value, "=")
+		ref = strings.TrimSpace(ref)
+		if !ok || ref == "" {
+			return nil, errors.Errorf(
+				"%q is not of the form (image|chart|commit)=reference",
+				value,
+			)
+		}
+		switch strings.TrimSpace(kind) {
+		case "image":
+			refs.Images = append(refs.Images, ref)
+		case "chart":
+			refs.Charts = append(refs.Charts, ref)
+		case "commit":
+			refs.Commits = append(refs.Commits, ref)
+		default:
+			return nil, errors.Errorf(
+				"unknown artifact kind %q; must be image, chart or commit",
+				kind,
+			)
+		}
+	}
+	return refs, nil
+}
+
+// printPromotionPreview writes a human-readable description of the provided
+// promotion preview to the provided writer.
+func printPromotionPreview(
+	w io.Writer,
+	preview *v1alpha1.PreviewPromotionResponse,
+) error {
+	var sb strings.Builder
+	if len(preview.GetGitRepoDiffs()) == 0 &&
+		len(preview.GetArgocdAppPatches()) == 0 {
+		sb.WriteString("Promotion would make no changes\n")
+	}
+	for _, diff := range preview.GetGitRepoDiffs() {
+		fmt.Fprintf(&sb, "# %s: %s", diff.GetMechanism(), diff.GetRepoUrl())
+		if diff.GetBranch() != "" {
+			fmt.Fprintf(&sb, " (branch %s)", diff.GetBranch())
+		}
+		sb.WriteString("\n")
+		if diff.GetDiff() == "" {
+			sb.WriteString("No changes\n\n")
+			continue
+		}
+		sb.WriteString(diff.GetDiff())
+		if !strings.HasSuffix(diff.GetDiff(), "\n") {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+	for _, patch := range preview.GetArgocdAppPatches() {
+		fmt.Fprintf(
+			&sb,
+			"# Argo CD Application %s/%s\n%s\n\n",
+			patch.GetNamespace(),
+			patch.GetName(),
+			patch.GetPatch(),
+		)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,110 @@
+package stage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/utils/pointer"
+
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/kargo"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+// promoteStages promotes the specified Freight into every Stage matching the
+// label selector in the provided flags, printing the outcome for each Stage as
+// it is reported. An error is returned if promotion of any Stage failed.
+func promoteStages(
+	ctx context.Context,
+	opt *option.Option,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+	freight string,
+	flag PromoteFlags,
+) error {
+	if flag.BatchSize == 0 && (flag.Pause > 0 || flag.HaltOnFailure) {
+		return errors.New("--pause and --halt-on-failure require --batch-size")
+	}
+	format := pointer.StringDeref(opt.PrintFlags.OutputFormat, "")
+	if format != "" && format != "json" {
+		return errors.Errorf(
+			"output format %q is not supported with --selector",
+			format,
+		)
+	}
+	req := &v1alpha1.PromoteStagesRequest{
+		Project:       project,
+		Selector:      flag.Selector,
+		Freight:       freight,
+		BatchSize:     flag.BatchSize,
+		HaltOnFailure: flag.HaltOnFailure,
+	}
+	if flag.Pause > 0 {
+		req.Pause = flag.Pause.String()
+	}
+	stream, err := kargoSvcCli.PromoteStages(ctx, connect.NewRequest(req))
+	if err != nil {
+		return errors.Wrap(err, "promote stages")
+	}
+	defer stream.Close()
+	var stages, failures int
+	for stream.Receive() {
+		res := stream.Msg()
+		stages++
+		if res.GetOutcome() == kargo.StagePromotionOutcomeFailed {
+			failures++
+		}
+		if format == "json" {
+			b, err := protojson.Marshal(res)
+			if err != nil {
+				return errors.Wrap(err, "marshal stage promotion result")
+			}
+			if _, err = fmt.Fprintln(opt.IOStreams.Out, string(b)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = printStagePromotionResult(opt.IOStreams.Out, res); err != nil {
+			return err
+		}
+	}
+	if err = stream.Err(); err != nil {
+		return errors.Wrap(err, "promote stages")
+	}
+	if failures > 0 {
+		return errors.Errorf(
+			"promotion failed for %d of %d matching Stages",
+			failures,
+			stages,
+		)
+	}
+	return nil
+}
+
+// printStagePromotionResult writes a single line describing the provided
+// outcome of promoting a Stage to the provided writer.
+func printStagePromotionResult(
+	w io.Writer,
+	res *v1alpha1.PromoteStagesResponse,
+) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: %s", res.GetStage(), res.GetOutcome())
+	if res.GetWave() > 0 {
+		fmt.Fprintf(&sb, " (wave %d)", res.GetWave())
+	}
+	if name := res.GetPromotion().GetMetadata().GetName(); name != "" {
+		fmt.Fprintf(&sb, " Promotion %q", name)
+	}
+	if res.GetMessage() != "" {
+		fmt.Fprintf(&sb, ": %s", res.GetMessage())
+	}
+	sb.WriteString("\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,52 @@
+package stage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	svcv1meta1 "github.com/akuity/kargo/pkg/api/metav1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	pkgv1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestPrintStagePromotionResult(t *testing.T) {
+	testCases := []struct {
+		name     string
+		result   *v1alpha1.PromoteStagesResponse
+		expected string
+	}{
+		{
+			name: "skipped",
+			result: &v1alpha1.PromoteStagesResponse{
+				Stage:   "fake-stage",
+				Outcome: "Skipped",
+				Message: "Stage is locked: change freeze",
+			},
+			expected: "fake-stage: Skipped: Stage is locked: change freeze\n",
+		},
+		{
+			name: "succeeded",
+			result: &v1alpha1.PromoteStagesResponse{
+				Stage:   "fake-stage",
+				Wave:    2,
+				Outcome: "Succeeded",
+				Promotion: &pkgv1alpha1.Promotion{
+					Metadata: &svcv1meta1.ObjectMeta{
+						Name: pointer.String("fake-promotion"),
+					},
+				},
+			},
+			expected: "fake-stage: Succeeded (wave 2) Promotion \"fake-promotion\"\n",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, printStagePromotionResult(&buf, testCase.result))
+			require.Equal(t, testCase.expected, buf.String())
+		})
+	}
+}
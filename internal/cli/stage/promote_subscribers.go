@@ -55,6 +55,9 @@ func newPromoteSubscribersCommand(opt *option.Option) *cobra.Command {
 					for _, p := range res.Msg.GetPromotions() {
 						fmt.Fprintf(opt.IOStreams.Out, "Promotion Created: %q\n", *p.Metadata.Name)
 					}
+					for _, s := range res.Msg.GetLockedStages() {
+						fmt.Fprintf(opt.IOStreams.Out, "Skipped locked Stage: %q\n", s)
+					}
 				}
 				if promoteErr != nil {
 					return errors.Wrap(promoteErr, "promote subscribers")
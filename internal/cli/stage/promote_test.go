@@ -0,0 +1,121 @@
+package stage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	pkgv1alpha1 "github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestPrintPromotionPreview(t *testing.T) {
+	testCases := []struct {
+		name     string
+		preview  *v1alpha1.PreviewPromotionResponse
+		expected string
+	}{
+		{
+			name:     "no changes",
+			preview:  &v1alpha1.PreviewPromotionResponse{},
+			expected: "Promotion would make no changes\n",
+		},
+		{
+			name: "changes",
+			preview: &v1alpha1.PreviewPromotionResponse{
+				GitRepoDiffs: []*v1alpha1.GitRepoDiff{
+					{
+						Mechanism: "fake-mechanism",
+						RepoUrl:   "fake-url",
+						Branch:    "fake-branch",
+						Diff:      "fake-diff\n",
+					},
+					{
+						Mechanism: "fake-mechanism",
+						RepoUrl:   "another-fake-url",
+					},
+				},
+				ArgocdAppPatches: []*v1alpha1.ArgoCDAppPatch{
+					{
+						Namespace: "fake-namespace",
+						Name:      "fake-app",
+						Patch:     "{}",
+					},
+				},
+			},
+			expected: `# fake-mechanism: fake-url (branch fake-branch)
+fake-diff
+
+# fake-mechanism: another-fake-url
+No changes
+
+# Argo CD Application fake-namespace/fake-app
+{}
+
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, printPromotionPreview(buf, testCase.preview))
+			require.Equal(t, testCase.expected, buf.String())
+		})
+	}
+}
+
+func TestNewArtifactSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		include    []string
+		exclude    []string
+		assertions func(*pkgv1alpha1.ArtifactSelector, error)
+	}{
+		{
+			name: "no flags",
+			assertions: func(selector *pkgv1alpha1.ArtifactSelector, err error) {
+				require.NoError(t, err)
+				require.Nil(t, selector)
+			},
+		},
+		{
+			name:    "malformed value",
+			include: []string{"nginx"},
+			assertions: func(_ *pkgv1alpha1.ArtifactSelector, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid --include")
+			},
+		},
+		{
+			name:    "unknown kind",
+			exclude: []string{"manifest=foo"},
+			assertions: func(_ *pkgv1alpha1.ArtifactSelector, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid --exclude")
+				require.Contains(t, err.Error(), `unknown artifact kind "manifest"`)
+			},
+		},
+		{
+			name:    "success",
+			include: []string{"image=nginx", "commit=https://github.com/example/repo.git"},
+			exclude: []string{"chart=example-chart"},
+			assertions: func(selector *pkgv1alpha1.ArtifactSelector, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"nginx"}, selector.GetInclude().GetImages())
+				require.Equal(
+					t,
+					[]string{"https://github.com/example/repo.git"},
+					selector.GetInclude().GetCommits(),
+				)
+				require.Empty(t, selector.GetInclude().GetCharts())
+				require.Equal(t, []string{"example-chart"}, selector.GetExclude().GetCharts())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(newArtifactSelector(testCase.include, testCase.exclude))
+		})
+	}
+}
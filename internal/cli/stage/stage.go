@@ -15,5 +15,8 @@ func NewCommand(opt *option.Option) *cobra.Command {
 	cmd.AddCommand(newEnableAutoPromotion(opt))
 	cmd.AddCommand(newDisableAutoPromotion(opt))
 	cmd.AddCommand(newPromoteSubscribersCommand(opt))
+	cmd.AddCommand(newLockCommand(opt))
+	cmd.AddCommand(newUnlockCommand(opt))
+	cmd.AddCommand(newExplainCommand(opt))
 	return cmd
 }
@@ -27,6 +27,7 @@ type ApplicationSource struct {
 	Helm           *ApplicationSourceHelm      `json:"helm,omitempty"`
 	Kustomize      *ApplicationSourceKustomize `json:"kustomize,omitempty"`
 	Chart          string                      `json:"chart,omitempty"`
+	Ref            string                      `json:"ref,omitempty"`
 }
 
 type ApplicationSources []ApplicationSource
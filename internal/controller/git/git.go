@@ -3,6 +3,7 @@ package git
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"net/url"
 	"os"
@@ -29,6 +30,37 @@ type RepoCredentials struct {
 	// field, can be used for both reading from and writing to some remote
 	// repository.
 	Password string `json:"password,omitempty"`
+	// SigningKey is a private key used to sign commits made to some remote
+	// repository. If empty, commits are not signed.
+	SigningKey string `json:"signingKey,omitempty"`
+	// SigningKeyType indicates the type of SigningKey. If empty, SigningKey is
+	// assumed to be an ASCII-armored GPG key.
+	SigningKeyType SigningKeyType `json:"signingKeyType,omitempty"`
+}
+
+// SigningKeyType is a string type used to represent a type of key used to sign
+// commits.
+type SigningKeyType string
+
+const (
+	// SigningKeyTypeGPG represents an ASCII-armored GPG private key.
+	SigningKeyTypeGPG SigningKeyType = "gpg"
+	// SigningKeyTypeSSH represents an SSH private key.
+	SigningKeyTypeSSH SigningKeyType = "ssh"
+)
+
+const (
+	defaultUserName  = "Kargo Render"
+	defaultUserEmail = "kargo-render@akuity.io"
+)
+
+// User represents the identity recorded as the author and committer of
+// commits made to a git repository.
+type User struct {
+	// Name is the name of the user.
+	Name string
+	// Email is the email address of the user.
+	Email string
 }
 
 // Repo is an interface for interacting with a git repository.
@@ -54,6 +86,9 @@ type Repo interface {
 	// CreateOrphanedBranch creates a new branch that shares no commit history
 	// with any other branch.
 	CreateOrphanedBranch(branch string) error
+	// HasCommit returns a bool indicating whether the specified commit exists in
+	// the repository and is reachable from the head of the current branch.
+	HasCommit(id string) (bool, error)
 	// HasDiffs returns a bool indicating whether the working directory currently
 	// contains any differences from what's already at the head of the current
 	// branch.
@@ -61,6 +96,9 @@ type Repo interface {
 	// GetDiffPaths returns a string slice indicating the paths, relative to the
 	// root of the repository, of any new or modified files.
 	GetDiffPaths() ([]string, error)
+	// Diff returns a unified diff of all staged changes against the head of the
+	// current branch.
+	Diff() (string, error)
 	// LastCommitID returns the ID (sha) of the most recent commit to the current
 	// branch.
 	LastCommitID() (string, error)
@@ -89,20 +127,29 @@ type Repo interface {
 // repo is an implementation of the Repo interface for interacting with a git
 // repository.
 type repo struct {
-	url           string
-	homeDir       string
-	dir           string
-	currentBranch string
+	ctx            context.Context
+	url            string
+	homeDir        string
+	dir            string
+	currentBranch  string
+	signingKeyType SigningKeyType
 }
 
 // Clone produces a local clone of the remote git repository at the specified
 // URL and returns an implementation of the Repo interface that is stateful and
 // NOT suitable for use across multiple goroutines. This function will also
 // perform any setup that is required for successfully authenticating to the
-// remote repository.
+// remote repository. Commits made to the repository are attributed to the
+// provided user. If the user, or either of its fields, is empty, a default
+// identity is used instead. If the provided credentials include a signing key,
+// all commits made to the repository are signed using that key. Any git
+// command still running against the repository when the provided context is
+// canceled is killed.
 func Clone(
+	ctx context.Context,
 	repoURL string,
 	repoCreds RepoCredentials,
+	user *User,
 ) (Repo, error) {
 	homeDir, err := os.MkdirTemp("", "")
 	if err != nil {
@@ -113,14 +160,29 @@ func Clone(
 		)
 	}
 	r := &repo{
+		ctx:     ctx,
 		url:     repoURL,
 		homeDir: homeDir,
 		dir:     filepath.Join(homeDir, "repo"),
 	}
+	// Once the home directory exists, any failure must clean it up.
+	if err = r.setupUser(user); err != nil {
+		r.Close()
+		return nil, err
+	}
 	if err = r.setupAuth(repoCreds); err != nil {
+		r.Close()
 		return nil, err
 	}
-	return r, r.clone()
+	if err = r.setupSigning(repoCreds); err != nil {
+		r.Close()
+		return nil, errors.Wrap(err, "error configuring commit signing")
+	}
+	if err = r.clone(); err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
 }
 
 func (r *repo) AddAll() error {
@@ -154,6 +216,11 @@ func (r *repo) clone() error {
 }
 
 func (r *repo) Close() error {
+	if r.signingKeyType == SigningKeyTypeGPG {
+		// Importing a GPG key starts an agent that would otherwise outlive the
+		// home directory. Failing to stop it is not worth reporting.
+		_, _ = libExec.Exec(r.buildGPGCommand("gpgconf", "--kill", "gpg-agent"))
+	}
 	return os.RemoveAll(r.homeDir)
 }
 
@@ -177,6 +244,15 @@ func (r *repo) Checkout(branch string) error {
 
 func (r *repo) Commit(message string) error {
 	_, err := libExec.Exec(r.buildCommand("commit", "-m", message))
+	if err != nil && r.signingKeyType != "" {
+		return errors.Wrapf(
+			err,
+			"error committing changes to branch %q; commits are signed using "+
+				"the configured %s signing key, which may not be usable",
+			r.currentBranch,
+			r.signingKeyType,
+		)
+	}
 	return errors.Wrapf(
 		err,
 		"error committing changes to branch %q",
@@ -221,6 +297,30 @@ func (r *repo) CreateOrphanedBranch(branch string) error {
 	return r.Clean()
 }
 
+func (r *repo) HasCommit(id string) (bool, error) {
+	_, err := libExec.Exec(r.buildCommand("cat-file", "-e", id+"^{commit}"))
+	if _, ok := err.(*libExec.ExitError); ok {
+		// Commit does not exist
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "error checking for commit %q", id)
+	}
+	_, err = libExec.Exec(
+		r.buildCommand("merge-base", "--is-ancestor", id, "HEAD"),
+	)
+	if exitErr, ok := err.(*libExec.ExitError); ok && exitErr.ExitCode == 1 {
+		// Commit is not reachable from the head of the current branch
+		return false, nil
+	}
+	return err == nil, errors.Wrapf(
+		err,
+		"error checking if commit %q is an ancestor of branch %q",
+		id,
+		r.currentBranch,
+	)
+}
+
 func (r *repo) HasDiffs() (bool, error) {
 	resBytes, err := libExec.Exec(r.buildCommand("status", "-s"))
 	return len(resBytes) > 0,
@@ -245,6 +345,12 @@ func (r *repo) GetDiffPaths() ([]string, error) {
 	return paths, nil
 }
 
+func (r *repo) Diff() (string, error) {
+	resBytes, err := libExec.Exec(r.buildCommand("diff", "--cached"))
+	return string(resBytes),
+		errors.Wrapf(err, "error diffing staged changes on branch %q", r.currentBranch)
+}
+
 func (r *repo) LastCommitID() (string, error) {
 	shaBytes, err := libExec.Exec(r.buildCommand("rev-parse", "HEAD"))
 	return strings.TrimSpace(string(shaBytes)),
@@ -333,22 +439,32 @@ func (r *repo) WorkingDir() string {
 	return r.dir
 }
 
-// SetupAuth configures the git CLI for authentication using either SSH or the
-// "store" (username/password-based) credential helper.
-func (r *repo) setupAuth(repoCreds RepoCredentials) error {
-	// Configure the git client
-	cmd := r.buildCommand("config", "--global", "user.name", "Kargo Render")
+// setupUser configures the git CLI to attribute commits to the provided user,
+// falling back to a default identity for any of its fields that are empty.
+func (r *repo) setupUser(user *User) error {
+	name, email := defaultUserName, defaultUserEmail
+	if user != nil && user.Name != "" {
+		name = user.Name
+	}
+	if user != nil && user.Email != "" {
+		email = user.Email
+	}
+	cmd := r.buildCommand("config", "--global", "user.name", name)
 	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
 	if _, err := libExec.Exec(cmd); err != nil {
 		return errors.Wrapf(err, "error configuring git username")
 	}
-	cmd =
-		r.buildCommand("config", "--global", "user.email", "kargo-render@akuity.io")
+	cmd = r.buildCommand("config", "--global", "user.email", email)
 	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
 	if _, err := libExec.Exec(cmd); err != nil {
 		return errors.Wrapf(err, "error configuring git user email address")
 	}
+	return nil
+}
 
+// SetupAuth configures the git CLI for authentication using either SSH or the
+// "store" (username/password-based) credential helper.
+func (r *repo) setupAuth(repoCreds RepoCredentials) error {
 	// If an SSH key was provided, use that.
 	if repoCreds.SSHPrivateKey != "" {
 		sshConfigPath := filepath.Join(r.homeDir, ".ssh", "config")
@@ -373,7 +489,7 @@ func (r *repo) setupAuth(repoCreds RepoCredentials) error {
 	// If we get to here, we're authenticating using a password
 
 	// Set up the credential helper
-	cmd = r.buildCommand("config", "--global", "credential.helper", "store")
+	cmd := r.buildCommand("config", "--global", "credential.helper", "store")
 	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
 	if _, err := libExec.Exec(cmd); err != nil {
 		return errors.Wrapf(err, "error configuring git credential helper")
@@ -411,8 +527,120 @@ func (r *repo) setupAuth(repoCreds RepoCredentials) error {
 	return nil
 }
 
+// setupSigning configures the git CLI to sign all commits using the signing
+// key from the provided credentials, if there is one. GPG keys are imported
+// into a keyring within the home directory. SSH keys are written to a file
+// within the home directory.
+func (r *repo) setupSigning(repoCreds RepoCredentials) error {
+	if repoCreds.SigningKey == "" {
+		return nil
+	}
+	var signingKey string
+	switch keyType := repoCreds.SigningKeyType; keyType {
+	case SigningKeyTypeGPG, "":
+		if _, err := exec.LookPath("gpg"); err != nil {
+			return errors.Wrap(err, "gpg is required to sign commits using a GPG key")
+		}
+		r.signingKeyType = SigningKeyTypeGPG
+		var err error
+		if signingKey, err = r.importGPGKey(repoCreds.SigningKey); err != nil {
+			return err
+		}
+	case SigningKeyTypeSSH:
+		if _, err := exec.LookPath("ssh-keygen"); err != nil {
+			return errors.Wrap(
+				err,
+				"ssh-keygen is required to sign commits using an SSH key",
+			)
+		}
+		r.signingKeyType = SigningKeyTypeSSH
+		signingKey = filepath.Join(r.homeDir, "signing_key")
+		if err := os.WriteFile(
+			signingKey,
+			// ssh-keygen rejects keys without a trailing newline
+			[]byte(strings.TrimSpace(repoCreds.SigningKey)+"\n"),
+			0600,
+		); err != nil {
+			return errors.Wrapf(err, "error writing SSH signing key to %q", signingKey)
+		}
+		// Verify the key is usable now rather than when the first commit is made
+		if _, err := libExec.Exec(
+			r.buildGPGCommand("ssh-keygen", "-y", "-f", signingKey),
+		); err != nil {
+			return errors.Wrap(err, "error reading SSH signing key")
+		}
+	default:
+		return errors.Errorf("unsupported signing key type %q", keyType)
+	}
+	for _, kv := range [][]string{
+		{"gpg.format", gpgFormat(r.signingKeyType)},
+		{"user.signingkey", signingKey},
+		{"commit.gpgsign", "true"},
+	} {
+		cmd := r.buildCommand(append([]string{"config", "--global"}, kv...)...)
+		cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
+		if _, err := libExec.Exec(cmd); err != nil {
+			return errors.Wrapf(err, "error configuring git %s", kv[0])
+		}
+	}
+	return nil
+}
+
+// gpgFormat returns the value of the git CLI's gpg.format setting that
+// corresponds to the provided type of signing key.
+func gpgFormat(keyType SigningKeyType) string {
+	if keyType == SigningKeyTypeSSH {
+		return "ssh"
+	}
+	return "openpgp"
+}
+
+// importGPGKey imports the provided ASCII-armored GPG private key into a
+// keyring within the home directory and returns the fingerprint of the
+// imported key. Keys protected by a passphrase cannot be used.
+func (r *repo) importGPGKey(key string) (string, error) {
+	cmd := r.buildGPGCommand("gpg", "--batch", "--import")
+	cmd.Stdin = strings.NewReader(key)
+	if _, err := libExec.Exec(cmd); err != nil {
+		return "", errors.Wrap(err, "error importing GPG signing key")
+	}
+	res, err := libExec.Exec(r.buildGPGCommand(
+		"gpg",
+		"--batch",
+		"--with-colons",
+		"--list-secret-keys",
+	))
+	if err != nil {
+		return "", errors.Wrap(err, "error listing imported GPG signing keys")
+	}
+	// The fingerprint of the first secret key is on the first "fpr" record that
+	// follows the first "sec" record.
+	var foundKey bool
+	scanner := bufio.NewScanner(bytes.NewReader(res))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		switch {
+		case fields[0] == "sec":
+			foundKey = true
+		case foundKey && fields[0] == "fpr" && len(fields) > 9:
+			return fields[9], nil
+		}
+	}
+	return "", errors.New("GPG signing key does not contain a private key")
+}
+
+// buildGPGCommand returns a command for executing the specified signing tool
+// (gpg, gpgconf or ssh-keygen) using the home directory, and therefore the
+// keyring, of the repository.
+func (r *repo) buildGPGCommand(name string, arg ...string) *exec.Cmd {
+	cmd := exec.CommandContext(r.ctx, name, arg...)
+	cmd.Env = []string{fmt.Sprintf("HOME=%s", r.homeDir)}
+	cmd.Dir = r.homeDir
+	return cmd
+}
+
 func (r *repo) buildCommand(arg ...string) *exec.Cmd {
-	cmd := exec.Command("git", arg...)
+	cmd := exec.CommandContext(r.ctx, "git", arg...)
 	homeEnvVar := fmt.Sprintf("HOME=%s", r.homeDir)
 	if cmd.Env == nil {
 		cmd.Env = []string{homeEnvVar}
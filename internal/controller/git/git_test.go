@@ -0,0 +1,235 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneWithSigningKey(t *testing.T) {
+	for _, tool := range []string{"git", "gpg", "gpgconf", "ssh-keygen"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s is required for this test", tool)
+		}
+	}
+
+	gpgKey := generateGPGKey(t)
+	sshKey, sshPublicKey := generateSSHKey(t)
+
+	testCases := []struct {
+		name       string
+		repoURL    string
+		creds      RepoCredentials
+		assertions func(*testing.T, *repo, error)
+	}{
+		{
+			name: "GPG key",
+			creds: RepoCredentials{
+				SigningKey:     gpgKey,
+				SigningKeyType: SigningKeyTypeGPG,
+			},
+			assertions: func(t *testing.T, r *repo, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "true", gitConfig(t, r, "commit.gpgsign"))
+				require.Equal(t, "openpgp", gitConfig(t, r, "gpg.format"))
+				commitFile(t, r)
+				requireSignedCommit(t, r)
+				// The keyring of the repo holds the public key as well
+				_, err = r.buildCommand("verify-commit", "HEAD").CombinedOutput()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "GPG key without type",
+			creds: RepoCredentials{
+				SigningKey: gpgKey,
+			},
+			assertions: func(t *testing.T, r *repo, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "openpgp", gitConfig(t, r, "gpg.format"))
+				commitFile(t, r)
+				requireSignedCommit(t, r)
+			},
+		},
+		{
+			name: "SSH key",
+			creds: RepoCredentials{
+				SigningKey:     sshKey,
+				SigningKeyType: SigningKeyTypeSSH,
+			},
+			assertions: func(t *testing.T, r *repo, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "true", gitConfig(t, r, "commit.gpgsign"))
+				require.Equal(t, "ssh", gitConfig(t, r, "gpg.format"))
+				commitFile(t, r)
+				requireSignedCommit(t, r)
+				allowedSignersPath := filepath.Join(t.TempDir(), "allowed_signers")
+				require.NoError(
+					t,
+					os.WriteFile(
+						allowedSignersPath,
+						[]byte(fmt.Sprintf("* %s", sshPublicKey)),
+						0600,
+					),
+				)
+				_, err = r.buildCommand(
+					"-c", "gpg.ssh.allowedSignersFile="+allowedSignersPath,
+					"verify-commit", "HEAD",
+				).CombinedOutput()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "error cloning repo",
+			repoURL: "file:///nonexistent/repo.git",
+			creds: RepoCredentials{
+				SigningKey:     gpgKey,
+				SigningKeyType: SigningKeyTypeGPG,
+			},
+			assertions: func(t *testing.T, r *repo, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error cloning repo")
+				require.Nil(t, r)
+			},
+		},
+		{
+			name: "invalid GPG key",
+			creds: RepoCredentials{
+				SigningKey:     "not a key",
+				SigningKeyType: SigningKeyTypeGPG,
+			},
+			assertions: func(t *testing.T, r *repo, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error configuring commit signing")
+				require.Contains(t, err.Error(), "error importing GPG signing key")
+				require.Nil(t, r)
+			},
+		},
+		{
+			name: "invalid SSH key",
+			creds: RepoCredentials{
+				SigningKey:     "not a key",
+				SigningKeyType: SigningKeyTypeSSH,
+			},
+			assertions: func(t *testing.T, r *repo, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error configuring commit signing")
+				require.Contains(t, err.Error(), "error reading SSH signing key")
+				require.Nil(t, r)
+			},
+		},
+		{
+			name: "unknown signing key type",
+			creds: RepoCredentials{
+				SigningKey:     sshKey,
+				SigningKeyType: "x509",
+			},
+			assertions: func(t *testing.T, r *repo, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `unsupported signing key type "x509"`)
+				require.Nil(t, r)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repoURL := testCase.repoURL
+			if repoURL == "" {
+				repoURL = newTestRemoteRepo(t)
+			}
+			// Clone creates the home directory of the repo within this directory,
+			// making it possible to verify that it is removed after a failure.
+			tempDir := t.TempDir()
+			t.Setenv("TMPDIR", tempDir)
+			r, err := Clone(context.Background(), repoURL, testCase.creds, nil)
+			if err != nil {
+				entries, readErr := os.ReadDir(tempDir)
+				require.NoError(t, readErr)
+				require.Empty(t, entries)
+				testCase.assertions(t, nil, err)
+				return
+			}
+			defer r.Close()
+			testCase.assertions(t, r.(*repo), err)
+		})
+	}
+}
+
+// newTestRemoteRepo creates an empty bare repository to serve as a remote and
+// returns its URL.
+func newTestRemoteRepo(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "remote.git")
+	out, err := exec.Command("git", "init", "--bare", dir).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return "file://" + dir
+}
+
+// generateGPGKey generates a GPG signing key without a passphrase and returns
+// its ASCII-armored private key.
+func generateGPGKey(t *testing.T) string {
+	gpgHome := t.TempDir()
+	t.Cleanup(func() {
+		cmd := exec.Command("gpgconf", "--kill", "gpg-agent")
+		cmd.Env = append(os.Environ(), "GNUPGHOME="+gpgHome)
+		_ = cmd.Run()
+	})
+	runGPG := func(arg ...string) []byte {
+		cmd := exec.Command("gpg", append([]string{"--batch"}, arg...)...)
+		cmd.Env = append(os.Environ(), "GNUPGHOME="+gpgHome)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return out
+	}
+	runGPG(
+		"--passphrase", "",
+		"--quick-gen-key", "Kargo Bot <bot@example.com>",
+		"ed25519", "sign", "never",
+	)
+	return string(runGPG("--armor", "--export-secret-keys"))
+}
+
+// generateSSHKey generates an SSH key without a passphrase and returns its
+// private and public keys.
+func generateSSHKey(t *testing.T) (string, string) {
+	keyPath := filepath.Join(t.TempDir(), "key")
+	out, err := exec.Command(
+		"ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath,
+	).CombinedOutput()
+	require.NoError(t, err, string(out))
+	privateKey, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+	publicKey, err := os.ReadFile(keyPath + ".pub")
+	require.NoError(t, err)
+	return string(privateKey), string(publicKey)
+}
+
+// gitConfig returns the value of the specified global git setting of the
+// provided repo.
+func gitConfig(t *testing.T, r *repo, key string) string {
+	out, err := r.buildCommand("config", "--global", key).Output()
+	require.NoError(t, err)
+	return strings.TrimSpace(string(out))
+}
+
+// commitFile commits a new file to the provided repo.
+func commitFile(t *testing.T, r *repo) {
+	require.NoError(
+		t,
+		os.WriteFile(filepath.Join(r.WorkingDir(), "file"), []byte("data"), 0600),
+	)
+	require.NoError(t, r.AddAllAndCommit("add file"))
+}
+
+// requireSignedCommit asserts that the head of the current branch of the
+// provided repo is a signed commit.
+func requireSignedCommit(t *testing.T, r *repo) {
+	out, err := r.buildCommand("cat-file", "-p", "HEAD").Output()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "gpgsig ")
+}
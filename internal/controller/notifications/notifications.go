@@ -0,0 +1,589 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller"
+	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/notifications"
+)
+
+const (
+	// maxDeliveryAttempts is the maximum number of times delivery of a single
+	// notification to a single sink is attempted before giving up.
+	maxDeliveryAttempts = 5
+	// deliveryWorkers is the number of notifications that may be delivered
+	// concurrently.
+	deliveryWorkers = 4
+)
+
+// delivery describes a notification to be delivered to a single sink of a
+// NotificationPolicy.
+type delivery struct {
+	policy   types.NamespacedName
+	sink     string
+	event    notifications.Event
+	attempts int32
+}
+
+// reconciler reconciles NotificationPolicy resources and delivers
+// notifications of events concerning Promotions, Stages, and Freight to the
+// sinks of any NotificationPolicies they match.
+type reconciler struct {
+	kargoClient client.Client
+
+	// deliveries holds notifications waiting to be delivered, including those
+	// waiting to be retried.
+	deliveries workqueue.RateLimitingInterface
+
+	// startTime is the time at which the reconciler was created. Freight created
+	// before this time is not considered new.
+	startTime time.Time
+
+	// statusMu serializes updates to the status of NotificationPolicies.
+	statusMu sync.Mutex
+
+	// The following behaviors are overridable for testing purposes:
+
+	newSinkFn func(
+		context.Context,
+		client.Client,
+		string,
+		kargoapi.NotificationSink,
+	) (notifications.Sink, error)
+
+	nowFn func() time.Time
+}
+
+// SetupReconcilerWithManager initializes a reconciler for NotificationPolicy
+// resources and registers it with the provided Manager.
+func SetupReconcilerWithManager(
+	ctx context.Context,
+	kargoMgr manager.Manager,
+	shardName string,
+) error {
+	shardPredicate, err := controller.GetShardPredicate(shardName)
+	if err != nil {
+		return errors.Wrap(err, "error creating shard selector predicate")
+	}
+
+	r := newReconciler(kargoMgr.GetClient())
+
+	c, err := ctrl.NewControllerManagedBy(kargoMgr).
+		For(&kargoapi.NotificationPolicy{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		WithOptions(controller.CommonOptions()).
+		Build(r)
+	if err != nil {
+		return errors.Wrap(err, "error building NotificationPolicy reconciler")
+	}
+
+	// Watch for events worth notifying users about. Only this shard's
+	// Promotions, Stages, and Freight are watched so that each event is
+	// notified exactly once.
+	if err = c.Watch(
+		&source.Kind{Type: &kargoapi.Promotion{}},
+		handler.Funcs{
+			UpdateFunc: func(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+				oldPromo, oldOK := e.ObjectOld.(*kargoapi.Promotion)
+				newPromo, newOK := e.ObjectNew.(*kargoapi.Promotion)
+				if oldOK && newOK {
+					r.notify(ctx, getPromotionEvents(oldPromo, newPromo, r.nowFn())...)
+				}
+			},
+		},
+		shardPredicate,
+	); err != nil {
+		return errors.Wrap(err, "unable to watch Promotions")
+	}
+	if err = c.Watch(
+		&source.Kind{Type: &kargoapi.Stage{}},
+		handler.Funcs{
+			UpdateFunc: func(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+				oldStage, oldOK := e.ObjectOld.(*kargoapi.Stage)
+				newStage, newOK := e.ObjectNew.(*kargoapi.Stage)
+				if oldOK && newOK {
+					r.notify(ctx, getStageEvents(oldStage, newStage, r.nowFn())...)
+				}
+			},
+		},
+		shardPredicate,
+	); err != nil {
+		return errors.Wrap(err, "unable to watch Stages")
+	}
+	if err = c.Watch(
+		&source.Kind{Type: &kargoapi.Freight{}},
+		handler.Funcs{
+			CreateFunc: func(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+				// Freight that existed before the reconciler started is reported as
+				// created when the cache is first populated. It is not new.
+				if freight, ok := e.Object.(*kargoapi.Freight); ok &&
+					!freight.CreationTimestamp.Time.Before(r.startTime) {
+					r.notify(ctx, getFreightCreatedEvents(freight, r.nowFn())...)
+				}
+			},
+			UpdateFunc: func(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+				oldFreight, oldOK := e.ObjectOld.(*kargoapi.Freight)
+				newFreight, newOK := e.ObjectNew.(*kargoapi.Freight)
+				if oldOK && newOK {
+					r.notify(
+						ctx,
+						getFreightVerificationEvents(oldFreight, newFreight, r.nowFn())...,
+					)
+				}
+			},
+		},
+		shardPredicate,
+	); err != nil {
+		return errors.Wrap(err, "unable to watch Freight")
+	}
+
+	return errors.Wrap(
+		kargoMgr.Add(manager.RunnableFunc(r.runDeliveryWorkers)),
+		"error adding notification delivery workers to manager",
+	)
+}
+
+func newReconciler(kargoClient client.Client) *reconciler {
+	r := &reconciler{
+		kargoClient: kargoClient,
+		deliveries: workqueue.NewNamedRateLimitingQueue(
+			workqueue.NewItemExponentialFailureRateLimiter(
+				5*time.Second,
+				5*time.Minute,
+			),
+			"notifications",
+		),
+		startTime: time.Now(),
+	}
+	r.newSinkFn = notifications.NewSink
+	r.nowFn = time.Now
+	return r
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state. For a
+// NotificationPolicy, this only involves discarding the status of sinks that
+// have been removed from the policy.
+func (r *reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request,
+) (ctrl.Result, error) {
+	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"namespace":          req.NamespacedName.Namespace,
+		"notificationPolicy": req.NamespacedName.Name,
+	})
+	logger.Debug("reconciling NotificationPolicy")
+
+	policy, err := r.getNotificationPolicy(ctx, req.NamespacedName)
+	if err != nil || policy == nil {
+		return ctrl.Result{}, err
+	}
+
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	return ctrl.Result{}, kubeclient.PatchStatus(
+		ctx,
+		r.kargoClient,
+		policy,
+		func(status *kargoapi.NotificationPolicyStatus) {
+			for name := range status.Sinks {
+				if getSink(policy, name) == nil {
+					delete(status.Sinks, name)
+				}
+			}
+		},
+	)
+}
+
+// notify queues notifications of the provided events for delivery to the
+// sinks of all NotificationPolicies they match.
+func (r *reconciler) notify(ctx context.Context, events ...notifications.Event) {
+	logger := logging.LoggerFromContext(ctx)
+	for _, e := range events {
+		policies := kargoapi.NotificationPolicyList{}
+		if err := r.kargoClient.List(
+			ctx,
+			&policies,
+			client.InNamespace(e.Project),
+		); err != nil {
+			logger.Errorf(
+				"error listing NotificationPolicies in namespace %q: %s",
+				e.Project,
+				err,
+			)
+			continue
+		}
+		for _, policy := range policies.Items {
+			if !policyMatches(policy.Spec, e) {
+				continue
+			}
+			for _, sink := range policy.Spec.Sinks {
+				r.deliveries.Add(&delivery{
+					policy: types.NamespacedName{
+						Namespace: policy.Namespace,
+						Name:      policy.Name,
+					},
+					sink:  sink.Name,
+					event: e,
+				})
+			}
+		}
+	}
+}
+
+// policyMatches returns true if the provided event should trigger a
+// notification according to the provided NotificationPolicySpec.
+func policyMatches(
+	spec *kargoapi.NotificationPolicySpec,
+	e notifications.Event,
+) bool {
+	if spec == nil || !slices.Contains(spec.Events, e.Type) {
+		return false
+	}
+	if e.Stage != "" && len(spec.Stages) > 0 &&
+		!slices.Contains(spec.Stages, e.Stage) {
+		return false
+	}
+	if e.Type == kargoapi.NotificationEventTypePromotionPhaseChanged &&
+		len(spec.PromotionPhases) > 0 &&
+		!slices.Contains(spec.PromotionPhases, kargoapi.PromotionPhase(e.Phase)) {
+		return false
+	}
+	return true
+}
+
+// runDeliveryWorkers delivers queued notifications until the provided context
+// is canceled.
+func (r *reconciler) runDeliveryWorkers(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for i := 0; i < deliveryWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, shutdown := r.deliveries.Get()
+				if shutdown {
+					return
+				}
+				r.deliver(ctx, item.(*delivery)) // nolint: forcetypeassert
+				r.deliveries.Done(item)
+			}
+		}()
+	}
+	<-ctx.Done()
+	r.deliveries.ShutDown()
+	wg.Wait()
+	return nil
+}
+
+// deliver attempts to deliver the provided notification. If delivery fails
+// and attempts remain, the notification is queued to be retried after a
+// backoff. Otherwise, the outcome is recorded in the status of the
+// NotificationPolicy.
+func (r *reconciler) deliver(ctx context.Context, d *delivery) {
+	logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"namespace":          d.policy.Namespace,
+		"notificationPolicy": d.policy.Name,
+		"sink":               d.sink,
+		"event":              d.event.Type,
+	})
+
+	policy, err := r.getNotificationPolicy(ctx, d.policy)
+	if err != nil {
+		logger.Errorf("error getting NotificationPolicy: %s", err)
+	}
+	if policy == nil {
+		// The policy is gone or cannot be read. Either way, there is nowhere to
+		// deliver the notification and nowhere to record its outcome.
+		r.deliveries.Forget(d)
+		return
+	}
+	sinkSpec := getSink(policy, d.sink)
+	if sinkSpec == nil {
+		// The sink was removed from the policy after the notification was queued
+		r.deliveries.Forget(d)
+		return
+	}
+
+	d.attempts++
+	var sink notifications.Sink
+	if sink, err = r.newSinkFn(
+		ctx,
+		r.kargoClient,
+		policy.Namespace,
+		*sinkSpec,
+	); err == nil {
+		err = sink.Send(ctx, d.event)
+	}
+	if err != nil && d.attempts < maxDeliveryAttempts && ctx.Err() == nil {
+		logger.Debugf("error delivering notification; will retry: %s", err)
+		r.deliveries.AddRateLimited(d)
+		return
+	}
+	r.deliveries.Forget(d)
+
+	if err != nil {
+		logger.Errorf(
+			"error delivering notification after %d attempts: %s",
+			d.attempts,
+			err,
+		)
+	} else {
+		logger.Debug("delivered notification")
+	}
+	if err = r.recordDelivery(ctx, policy, d, err); err != nil {
+		logger.Errorf("error updating NotificationPolicy status: %s", err)
+	}
+}
+
+// recordDelivery records the outcome of the provided delivery in the status of
+// the provided NotificationPolicy.
+func (r *reconciler) recordDelivery(
+	ctx context.Context,
+	policy *kargoapi.NotificationPolicy,
+	d *delivery,
+	deliveryErr error,
+) error {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	now := metav1.NewTime(r.nowFn())
+	return kubeclient.PatchStatus(
+		ctx,
+		r.kargoClient,
+		policy,
+		func(status *kargoapi.NotificationPolicyStatus) {
+			if status.Sinks == nil {
+				status.Sinks = map[string]kargoapi.NotificationSinkStatus{}
+			}
+			sinkStatus := status.Sinks[d.sink]
+			sinkStatus.LastDelivery = &kargoapi.NotificationDelivery{
+				Time:     now,
+				Event:    d.event.Type,
+				Message:  d.event.Message,
+				Attempts: d.attempts,
+			}
+			if deliveryErr != nil {
+				sinkStatus.LastDelivery.Error = deliveryErr.Error()
+			} else {
+				sinkStatus.LastSuccessTime = &now
+			}
+			status.Sinks[d.sink] = sinkStatus
+		},
+	)
+}
+
+func (r *reconciler) getNotificationPolicy(
+	ctx context.Context,
+	namespacedName types.NamespacedName,
+) (*kargoapi.NotificationPolicy, error) {
+	policy := kargoapi.NotificationPolicy{}
+	if err := r.kargoClient.Get(ctx, namespacedName, &policy); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(
+			err,
+			"error getting NotificationPolicy %q in namespace %q",
+			namespacedName.Name,
+			namespacedName.Namespace,
+		)
+	}
+	return &policy, nil
+}
+
+// getSink returns the named sink of the provided NotificationPolicy, or nil if
+// the policy has no such sink.
+func getSink(
+	policy *kargoapi.NotificationPolicy,
+	name string,
+) *kargoapi.NotificationSink {
+	if policy.Spec == nil {
+		return nil
+	}
+	for i := range policy.Spec.Sinks {
+		if policy.Spec.Sinks[i].Name == name {
+			return &policy.Spec.Sinks[i]
+		}
+	}
+	return nil
+}
+
+// getPromotionEvents returns an event describing the Promotion's move into a
+// new phase, if it has moved into one.
+func getPromotionEvents(
+	oldPromo *kargoapi.Promotion,
+	newPromo *kargoapi.Promotion,
+	now time.Time,
+) []notifications.Event {
+	if newPromo.Spec == nil || newPromo.Status.Phase == "" ||
+		newPromo.Status.Phase == oldPromo.Status.Phase {
+		return nil
+	}
+	e := notifications.Event{
+		Type:      kargoapi.NotificationEventTypePromotionPhaseChanged,
+		Time:      now,
+		Project:   newPromo.Namespace,
+		Stage:     newPromo.Spec.Stage,
+		Freight:   newPromo.Spec.Freight,
+		Promotion: newPromo.Name,
+		Phase:     string(newPromo.Status.Phase),
+		Message: fmt.Sprintf(
+			"Promotion %s of Stage %s to Freight %s is %s",
+			newPromo.Name,
+			newPromo.Spec.Stage,
+			newPromo.Spec.Freight,
+			newPromo.Status.Phase,
+		),
+	}
+	if newPromo.Status.Phase.IsTerminal() && newPromo.Status.Error != "" {
+		e.Details = newPromo.Status.Error
+		e.Message = fmt.Sprintf("%s: %s", e.Message, e.Details)
+	}
+	return []notifications.Event{e}
+}
+
+// getStageEvents returns an event describing a change in the Stage's health,
+// if its health has changed.
+func getStageEvents(
+	oldStage *kargoapi.Stage,
+	newStage *kargoapi.Stage,
+	now time.Time,
+) []notifications.Event {
+	if newStage.Status.Health == nil {
+		return nil
+	}
+	var previousHealth kargoapi.HealthState
+	if oldStage.Status.Health != nil {
+		previousHealth = oldStage.Status.Health.Status
+	}
+	health := newStage.Status.Health.Status
+	if health == previousHealth {
+		return nil
+	}
+	e := notifications.Event{
+		Type:           kargoapi.NotificationEventTypeStageHealthChanged,
+		Time:           now,
+		Project:        newStage.Namespace,
+		Stage:          newStage.Name,
+		Health:         string(health),
+		PreviousHealth: string(previousHealth),
+		Details:        strings.Join(newStage.Status.Health.Issues, "; "),
+		Message:        fmt.Sprintf("Stage %s is %s", newStage.Name, health),
+	}
+	if newStage.Status.CurrentFreight != nil {
+		e.Freight = newStage.Status.CurrentFreight.ID
+	}
+	if previousHealth != "" {
+		e.Message = fmt.Sprintf("%s (previously %s)", e.Message, previousHealth)
+	}
+	if e.Details != "" {
+		e.Message = fmt.Sprintf("%s: %s", e.Message, e.Details)
+	}
+	return []notifications.Event{e}
+}
+
+// getFreightCreatedEvents returns an event describing the creation of the
+// provided Freight.
+func getFreightCreatedEvents(
+	freight *kargoapi.Freight,
+	now time.Time,
+) []notifications.Event {
+	e := notifications.Event{
+		Type:    kargoapi.NotificationEventTypeFreightCreated,
+		Time:    now,
+		Project: freight.Namespace,
+		Freight: freight.Name,
+		Message: fmt.Sprintf("New Freight %s was created", freight.Name),
+	}
+	for _, ownerRef := range freight.OwnerReferences {
+		if ownerRef.APIVersion == kargoapi.GroupVersion.String() &&
+			ownerRef.Kind == "Warehouse" {
+			e.Warehouse = ownerRef.Name
+			e.Message = fmt.Sprintf(
+				"Warehouse %s produced new Freight %s",
+				e.Warehouse,
+				freight.Name,
+			)
+			break
+		}
+	}
+	return []notifications.Event{e}
+}
+
+// getFreightVerificationEvents returns events describing the Stages the
+// provided Freight has newly been qualified for or found to be faulty in.
+func getFreightVerificationEvents(
+	oldFreight *kargoapi.Freight,
+	newFreight *kargoapi.Freight,
+	now time.Time,
+) []notifications.Event {
+	var events []notifications.Event
+	for _, stage := range sortedKeys(newFreight.Status.Qualifications) {
+		if _, ok := oldFreight.Status.Qualifications[stage]; ok {
+			continue
+		}
+		events = append(events, notifications.Event{
+			Type:    kargoapi.NotificationEventTypeFreightQualified,
+			Time:    now,
+			Project: newFreight.Namespace,
+			Stage:   stage,
+			Freight: newFreight.Name,
+			Message: fmt.Sprintf(
+				"Freight %s was verified in Stage %s and qualified for promotion",
+				newFreight.Name,
+				stage,
+			),
+		})
+	}
+	for _, stage := range sortedKeys(newFreight.Status.Failures) {
+		if _, ok := oldFreight.Status.Failures[stage]; ok {
+			continue
+		}
+		reason := newFreight.Status.Failures[stage].Reason
+		events = append(events, notifications.Event{
+			Type:    kargoapi.NotificationEventTypeFreightFailed,
+			Time:    now,
+			Project: newFreight.Namespace,
+			Stage:   stage,
+			Freight: newFreight.Name,
+			Details: reason,
+			Message: fmt.Sprintf(
+				"Freight %s failed in Stage %s: %s",
+				newFreight.Name,
+				stage,
+				reason,
+			),
+		})
+	}
+	return events
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,575 @@
+package notifications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/notifications"
+)
+
+type fakeSink struct {
+	err error
+}
+
+func (f *fakeSink) Send(context.Context, notifications.Event) error {
+	return f.err
+}
+
+func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	return newReconciler(
+		fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+	)
+}
+
+func newTestPolicy() *kargoapi.NotificationPolicy {
+	return &kargoapi.NotificationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-policy",
+		},
+		Spec: &kargoapi.NotificationPolicySpec{
+			Events: []kargoapi.NotificationEventType{
+				kargoapi.NotificationEventTypePromotionPhaseChanged,
+			},
+			Sinks: []kargoapi.NotificationSink{
+				{
+					Name: "fake-sink",
+					HTTP: &kargoapi.HTTPNotificationSink{
+						URL:      "https://example.com",
+						Template: "{{ .Message }}",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewReconciler(t *testing.T) {
+	r := newReconciler(fake.NewClientBuilder().Build())
+	require.NotNil(t, r.kargoClient)
+	require.NotNil(t, r.deliveries)
+	require.False(t, r.startTime.IsZero())
+	require.NotNil(t, r.newSinkFn)
+	require.NotNil(t, r.nowFn)
+}
+
+func TestReconcile(t *testing.T) {
+	policy := newTestPolicy()
+	policy.Status.Sinks = map[string]kargoapi.NotificationSinkStatus{
+		"fake-sink":    {},
+		"removed-sink": {},
+	}
+	r := newFakeReconciler(t, policy)
+	_, err := r.Reconcile(
+		context.Background(),
+		ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: policy.Namespace,
+				Name:      policy.Name,
+			},
+		},
+	)
+	require.NoError(t, err)
+	updated, err := r.getNotificationPolicy(
+		context.Background(),
+		types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name},
+	)
+	require.NoError(t, err)
+	require.Contains(t, updated.Status.Sinks, "fake-sink")
+	require.NotContains(t, updated.Status.Sinks, "removed-sink")
+}
+
+func TestNotify(t *testing.T) {
+	otherPolicy := newTestPolicy()
+	otherPolicy.Name = "other-policy"
+	otherPolicy.Spec.Events = []kargoapi.NotificationEventType{
+		kargoapi.NotificationEventTypeFreightCreated,
+	}
+	r := newFakeReconciler(t, newTestPolicy(), otherPolicy)
+	r.notify(
+		context.Background(),
+		notifications.Event{
+			Type:    kargoapi.NotificationEventTypePromotionPhaseChanged,
+			Project: "fake-namespace",
+			Phase:   string(kargoapi.PromotionPhaseSucceeded),
+		},
+		notifications.Event{
+			Type:    kargoapi.NotificationEventTypePromotionPhaseChanged,
+			Project: "other-namespace",
+		},
+	)
+	require.Equal(t, 1, r.deliveries.Len())
+	item, _ := r.deliveries.Get()
+	d := item.(*delivery) // nolint: forcetypeassert
+	require.Equal(t, "fake-policy", d.policy.Name)
+	require.Equal(t, "fake-sink", d.sink)
+}
+
+func TestPolicyMatches(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *kargoapi.NotificationPolicySpec
+		event   notifications.Event
+		matches bool
+	}{
+		{
+			name: "nil spec",
+			event: notifications.Event{
+				Type: kargoapi.NotificationEventTypeFreightCreated,
+			},
+			matches: false,
+		},
+		{
+			name: "event type not selected",
+			spec: &kargoapi.NotificationPolicySpec{
+				Events: []kargoapi.NotificationEventType{
+					kargoapi.NotificationEventTypeStageHealthChanged,
+				},
+			},
+			event: notifications.Event{
+				Type: kargoapi.NotificationEventTypeFreightCreated,
+			},
+			matches: false,
+		},
+		{
+			name: "stage not selected",
+			spec: &kargoapi.NotificationPolicySpec{
+				Events: []kargoapi.NotificationEventType{
+					kargoapi.NotificationEventTypeStageHealthChanged,
+				},
+				Stages: []string{"prod"},
+			},
+			event: notifications.Event{
+				Type:  kargoapi.NotificationEventTypeStageHealthChanged,
+				Stage: "test",
+			},
+			matches: false,
+		},
+		{
+			name: "event without stage ignores stage selection",
+			spec: &kargoapi.NotificationPolicySpec{
+				Events: []kargoapi.NotificationEventType{
+					kargoapi.NotificationEventTypeFreightCreated,
+				},
+				Stages: []string{"prod"},
+			},
+			event: notifications.Event{
+				Type: kargoapi.NotificationEventTypeFreightCreated,
+			},
+			matches: true,
+		},
+		{
+			name: "promotion phase not selected",
+			spec: &kargoapi.NotificationPolicySpec{
+				Events: []kargoapi.NotificationEventType{
+					kargoapi.NotificationEventTypePromotionPhaseChanged,
+				},
+				PromotionPhases: []kargoapi.PromotionPhase{
+					kargoapi.PromotionPhaseErrored,
+				},
+			},
+			event: notifications.Event{
+				Type:  kargoapi.NotificationEventTypePromotionPhaseChanged,
+				Phase: string(kargoapi.PromotionPhaseSucceeded),
+			},
+			matches: false,
+		},
+		{
+			name: "match",
+			spec: &kargoapi.NotificationPolicySpec{
+				Events: []kargoapi.NotificationEventType{
+					kargoapi.NotificationEventTypePromotionPhaseChanged,
+				},
+				Stages: []string{"prod"},
+				PromotionPhases: []kargoapi.PromotionPhase{
+					kargoapi.PromotionPhaseErrored,
+				},
+			},
+			event: notifications.Event{
+				Type:  kargoapi.NotificationEventTypePromotionPhaseChanged,
+				Stage: "prod",
+				Phase: string(kargoapi.PromotionPhaseErrored),
+			},
+			matches: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.matches,
+				policyMatches(testCase.spec, testCase.event),
+			)
+		})
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	testNow := time.Now().Truncate(time.Second)
+	testCases := []struct {
+		name       string
+		sink       string
+		attempts   int32
+		sendErr    error
+		assertions func(*reconciler, *delivery, *kargoapi.NotificationPolicy)
+	}{
+		{
+			name: "sink no longer exists",
+			sink: "removed-sink",
+			assertions: func(
+				r *reconciler,
+				d *delivery,
+				policy *kargoapi.NotificationPolicy,
+			) {
+				require.Zero(t, d.attempts)
+				require.Zero(t, r.deliveries.NumRequeues(d))
+				require.Empty(t, policy.Status.Sinks)
+			},
+		},
+		{
+			name:    "failure with attempts remaining",
+			sink:    "fake-sink",
+			sendErr: errors.New("something went wrong"),
+			assertions: func(
+				r *reconciler,
+				d *delivery,
+				policy *kargoapi.NotificationPolicy,
+			) {
+				require.Equal(t, int32(1), d.attempts)
+				require.Equal(t, 1, r.deliveries.NumRequeues(d))
+				require.Empty(t, policy.Status.Sinks)
+			},
+		},
+		{
+			name:     "failure with no attempts remaining",
+			sink:     "fake-sink",
+			attempts: maxDeliveryAttempts - 1,
+			sendErr:  errors.New("something went wrong"),
+			assertions: func(
+				r *reconciler,
+				d *delivery,
+				policy *kargoapi.NotificationPolicy,
+			) {
+				require.Zero(t, r.deliveries.NumRequeues(d))
+				status := policy.Status.Sinks["fake-sink"]
+				require.NotNil(t, status.LastDelivery)
+				require.Equal(t, int32(maxDeliveryAttempts), status.LastDelivery.Attempts)
+				require.Equal(t, "something went wrong", status.LastDelivery.Error)
+				require.Nil(t, status.LastSuccessTime)
+			},
+		},
+		{
+			name: "success",
+			sink: "fake-sink",
+			assertions: func(
+				r *reconciler,
+				d *delivery,
+				policy *kargoapi.NotificationPolicy,
+			) {
+				require.Zero(t, r.deliveries.NumRequeues(d))
+				status := policy.Status.Sinks["fake-sink"]
+				require.NotNil(t, status.LastDelivery)
+				require.Equal(
+					t,
+					kargoapi.NotificationEventTypePromotionPhaseChanged,
+					status.LastDelivery.Event,
+				)
+				require.Equal(t, "fake-message", status.LastDelivery.Message)
+				require.Equal(t, int32(1), status.LastDelivery.Attempts)
+				require.Empty(t, status.LastDelivery.Error)
+				require.NotNil(t, status.LastSuccessTime)
+				require.True(t, testNow.Equal(status.LastSuccessTime.Time))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			policy := newTestPolicy()
+			r := newFakeReconciler(t, policy)
+			r.nowFn = func() time.Time {
+				return testNow
+			}
+			r.newSinkFn = func(
+				context.Context,
+				client.Client,
+				string,
+				kargoapi.NotificationSink,
+			) (notifications.Sink, error) {
+				return &fakeSink{err: testCase.sendErr}, nil
+			}
+			d := &delivery{
+				policy: types.NamespacedName{
+					Namespace: policy.Namespace,
+					Name:      policy.Name,
+				},
+				sink: testCase.sink,
+				event: notifications.Event{
+					Type:    kargoapi.NotificationEventTypePromotionPhaseChanged,
+					Message: "fake-message",
+				},
+				attempts: testCase.attempts,
+			}
+			r.deliver(context.Background(), d)
+			updated, err := r.getNotificationPolicy(context.Background(), d.policy)
+			require.NoError(t, err)
+			testCase.assertions(r, d, updated)
+		})
+	}
+}
+
+func TestGetPromotionEvents(t *testing.T) {
+	testNow := time.Now()
+	newPromo := func(phase kargoapi.PromotionPhase, err string) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-promotion",
+			},
+			Spec: &kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: phase,
+				Error: err,
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		oldPromo   *kargoapi.Promotion
+		newPromo   *kargoapi.Promotion
+		assertions func([]notifications.Event)
+	}{
+		{
+			name:     "phase unchanged",
+			oldPromo: newPromo(kargoapi.PromotionPhaseRunning, ""),
+			newPromo: newPromo(kargoapi.PromotionPhaseRunning, "transient error"),
+			assertions: func(events []notifications.Event) {
+				require.Empty(t, events)
+			},
+		},
+		{
+			name:     "phase changed",
+			oldPromo: newPromo(kargoapi.PromotionPhasePending, ""),
+			newPromo: newPromo(kargoapi.PromotionPhaseRunning, ""),
+			assertions: func(events []notifications.Event) {
+				require.Equal(
+					t,
+					[]notifications.Event{
+						{
+							Type:      kargoapi.NotificationEventTypePromotionPhaseChanged,
+							Time:      testNow,
+							Project:   "fake-namespace",
+							Stage:     "fake-stage",
+							Freight:   "fake-freight",
+							Promotion: "fake-promotion",
+							Phase:     string(kargoapi.PromotionPhaseRunning),
+							Message: "Promotion fake-promotion of Stage fake-stage to " +
+								"Freight fake-freight is Running",
+						},
+					},
+					events,
+				)
+			},
+		},
+		{
+			name:     "failed",
+			oldPromo: newPromo(kargoapi.PromotionPhaseRunning, ""),
+			newPromo: newPromo(kargoapi.PromotionPhaseErrored, "something went wrong"),
+			assertions: func(events []notifications.Event) {
+				require.Len(t, events, 1)
+				require.Equal(t, "something went wrong", events[0].Details)
+				require.Equal(
+					t,
+					"Promotion fake-promotion of Stage fake-stage to Freight "+
+						"fake-freight is Errored: something went wrong",
+					events[0].Message,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				getPromotionEvents(testCase.oldPromo, testCase.newPromo, testNow),
+			)
+		})
+	}
+}
+
+func TestGetStageEvents(t *testing.T) {
+	testNow := time.Now()
+	newStage := func(health *kargoapi.Health) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				Health:         health,
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		oldStage   *kargoapi.Stage
+		newStage   *kargoapi.Stage
+		assertions func([]notifications.Event)
+	}{
+		{
+			name:     "no health",
+			oldStage: newStage(&kargoapi.Health{Status: kargoapi.HealthStateHealthy}),
+			newStage: newStage(nil),
+			assertions: func(events []notifications.Event) {
+				require.Empty(t, events)
+			},
+		},
+		{
+			name:     "health unchanged",
+			oldStage: newStage(&kargoapi.Health{Status: kargoapi.HealthStateHealthy}),
+			newStage: newStage(&kargoapi.Health{Status: kargoapi.HealthStateHealthy}),
+			assertions: func(events []notifications.Event) {
+				require.Empty(t, events)
+			},
+		},
+		{
+			name:     "initial health",
+			oldStage: newStage(nil),
+			newStage: newStage(&kargoapi.Health{Status: kargoapi.HealthStateHealthy}),
+			assertions: func(events []notifications.Event) {
+				require.Len(t, events, 1)
+				require.Empty(t, events[0].PreviousHealth)
+				require.Equal(t, "Stage fake-stage is Healthy", events[0].Message)
+			},
+		},
+		{
+			name:     "health changed",
+			oldStage: newStage(&kargoapi.Health{Status: kargoapi.HealthStateHealthy}),
+			newStage: newStage(&kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Issues: []string{"issue 1", "issue 2"},
+			}),
+			assertions: func(events []notifications.Event) {
+				require.Equal(
+					t,
+					[]notifications.Event{
+						{
+							Type:           kargoapi.NotificationEventTypeStageHealthChanged,
+							Time:           testNow,
+							Project:        "fake-namespace",
+							Stage:          "fake-stage",
+							Freight:        "fake-freight",
+							Health:         string(kargoapi.HealthStateUnhealthy),
+							PreviousHealth: string(kargoapi.HealthStateHealthy),
+							Details:        "issue 1; issue 2",
+							Message: "Stage fake-stage is Unhealthy (previously " +
+								"Healthy): issue 1; issue 2",
+						},
+					},
+					events,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				getStageEvents(testCase.oldStage, testCase.newStage, testNow),
+			)
+		})
+	}
+}
+
+func TestGetFreightCreatedEvents(t *testing.T) {
+	testNow := time.Now()
+	events := getFreightCreatedEvents(
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-freight",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: kargoapi.GroupVersion.String(),
+						Kind:       "Warehouse",
+						Name:       "fake-warehouse",
+					},
+				},
+			},
+		},
+		testNow,
+	)
+	require.Equal(
+		t,
+		[]notifications.Event{
+			{
+				Type:      kargoapi.NotificationEventTypeFreightCreated,
+				Time:      testNow,
+				Project:   "fake-namespace",
+				Warehouse: "fake-warehouse",
+				Freight:   "fake-freight",
+				Message:   "Warehouse fake-warehouse produced new Freight fake-freight",
+			},
+		},
+		events,
+	)
+}
+
+func TestGetFreightVerificationEvents(t *testing.T) {
+	testNow := time.Now()
+	oldFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+		Status: kargoapi.FreightStatus{
+			Qualifications: map[string]kargoapi.Qualification{
+				"test": {},
+			},
+		},
+	}
+	newFreight := oldFreight.DeepCopy()
+	newFreight.Status.Qualifications["uat"] = kargoapi.Qualification{}
+	newFreight.Status.Failures = map[string]kargoapi.Failure{
+		"prod": {Reason: "smoke tests failed"},
+	}
+	events := getFreightVerificationEvents(oldFreight, newFreight, testNow)
+	require.Equal(
+		t,
+		[]notifications.Event{
+			{
+				Type:    kargoapi.NotificationEventTypeFreightQualified,
+				Time:    testNow,
+				Project: "fake-namespace",
+				Stage:   "uat",
+				Freight: "fake-freight",
+				Message: "Freight fake-freight was verified in Stage uat and " +
+					"qualified for promotion",
+			},
+			{
+				Type:    kargoapi.NotificationEventTypeFreightFailed,
+				Time:    testNow,
+				Project: "fake-namespace",
+				Stage:   "prod",
+				Freight: "fake-freight",
+				Details: "smoke tests failed",
+				Message: "Freight fake-freight failed in Stage prod: smoke tests failed",
+			},
+		},
+		events,
+	)
+}
@@ -9,3 +9,7 @@ func CommonOptions() controller.Options {
 		RecoverPanic: true,
 	}
 }
+
+// EventSource is the name of the component recorded as the source of
+// Kubernetes Events emitted by Kargo's controllers.
+const EventSource = "kargo-controller"
@@ -45,9 +45,9 @@ type argoCDMechanism struct {
 	) error
 }
 
-// newArgoCDMechanism returns an implementation of the Mechanism interface that
+// NewArgoCDMechanism returns an implementation of the Mechanism interface that
 // updates Argo CD Application resources.
-func newArgoCDMechanism(
+func NewArgoCDMechanism(
 	argoClient client.Client,
 ) Mechanism {
 	a := &argoCDMechanism{}
@@ -79,6 +79,16 @@ func (a *argoCDMechanism) Promote(
 	logger.Debug("executing Argo CD-based promotion mechanisms")
 
 	for _, update := range updates {
+		// Stop between updates if the promotion has been aborted.
+		if err := ctx.Err(); err != nil {
+			return newFreight, errors.Wrapf(
+				err,
+				"%s interrupted before updating Argo CD Application %s/%s",
+				a.GetName(),
+				update.AppNamespaceOrDefault(),
+				update.AppName,
+			)
+		}
 		if err := a.doSingleUpdateFn(
 			ctx,
 			stage.ObjectMeta,
@@ -99,11 +109,136 @@ func (a *argoCDMechanism) doSingleUpdate(
 	stageMeta metav1.ObjectMeta,
 	update kargoapi.ArgoCDAppUpdate,
 	newFreight kargoapi.SimpleFreight,
+) (err error) {
+	step := startStep(
+		ctx,
+		a.GetName(),
+		fmt.Sprintf(
+			"Argo CD Application %s/%s",
+			update.AppNamespaceOrDefault(),
+			update.AppName,
+		),
+	)
+	if _, ok := step.completed(); ok {
+		return nil
+	}
+	defer func() {
+		step.conclude("", err)
+	}()
+
+	app, err := a.getAuthorizedArgoCDApp(ctx, stageMeta, update)
+	if err != nil {
+		return err
+	}
+	patch := client.MergeFrom(app.DeepCopy())
+	if err = updateArgoCDAppSources(
+		app,
+		update,
+		newFreight,
+		a.applyArgoCDSourceUpdateFn,
+	); err != nil {
+		return err
+	}
+	app.ObjectMeta.Annotations[argocd.AnnotationKeyRefresh] =
+		string(argocd.RefreshTypeHard)
+	app.Operation = &argocd.Operation{
+		InitiatedBy: argocd.OperationInitiator{
+			Username:  "kargo-controller",
+			Automated: true,
+		},
+		Info: []*argocd.Info{
+			{
+				Name:  "Reason",
+				Value: "Promotion triggered a sync of this Application resource.",
+			},
+		},
+		Sync: &argocd.SyncOperation{
+			Revisions: []string{},
+		},
+	}
+	if app.Spec.SyncPolicy != nil {
+		if app.Spec.SyncPolicy.Retry != nil {
+			app.Operation.Retry = *app.Spec.SyncPolicy.Retry
+		}
+		if app.Spec.SyncPolicy.SyncOptions != nil {
+			app.Operation.Sync.SyncOptions = app.Spec.SyncPolicy.SyncOptions
+		}
+	}
+	if len(app.Spec.Sources) > 0 {
+		for _, source := range app.Spec.Sources {
+			app.Operation.Sync.Revisions =
+				append(app.Operation.Sync.Revisions, source.TargetRevision)
+		}
+	} else if app.Spec.Source != nil {
+		app.Operation.Sync.Revisions = []string{app.Spec.Source.TargetRevision}
+	}
+	if err = a.argoCDAppPatchFn(
+		ctx,
+		app,
+		patch,
+		&client.PatchOptions{},
+	); err != nil {
+		return errors.Wrapf(err, "error patching Argo CD Application %q", app.Name)
+	}
+	logging.LoggerFromContext(ctx).WithField("app", app.Name).
+		Debug("patched Argo CD Application")
+	return nil
+}
+
+// Preview implements the Previewer interface.
+func (a *argoCDMechanism) Preview(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	newFreight kargoapi.SimpleFreight,
+	preview *Preview,
 ) error {
+	for _, update := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
+		app, err := a.getAuthorizedArgoCDApp(ctx, stage.ObjectMeta, update)
+		if err != nil {
+			return err
+		}
+		patch := client.MergeFrom(app.DeepCopy())
+		if err = updateArgoCDAppSources(
+			app,
+			update,
+			newFreight,
+			a.applyArgoCDSourceUpdateFn,
+		); err != nil {
+			return err
+		}
+		patchBytes, err := patch.Data(app)
+		if err != nil {
+			return errors.Wrapf(
+				err,
+				"error computing patch for Argo CD Application %q in namespace %q",
+				app.Name,
+				app.Namespace,
+			)
+		}
+		preview.ArgoCDAppPatches = append(
+			preview.ArgoCDAppPatches,
+			ArgoCDAppPatch{
+				Namespace: app.Namespace,
+				Name:      app.Name,
+				Patch:     string(patchBytes),
+			},
+		)
+	}
+	return nil
+}
+
+// getAuthorizedArgoCDApp retrieves the Argo CD Application referenced by the
+// provided ArgoCDAppUpdate and verifies that the Stage described by the
+// provided metadata is permitted to update it.
+func (a *argoCDMechanism) getAuthorizedArgoCDApp(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	update kargoapi.ArgoCDAppUpdate,
+) (*argocd.Application, error) {
 	app, err :=
 		a.getArgoCDAppFn(ctx, update.AppNamespaceOrDefault(), update.AppName)
 	if err != nil {
-		return errors.Wrapf(
+		return nil, errors.Wrapf(
 			err,
 			"error finding Argo CD Application %q in namespace %q",
 			update.AppName,
@@ -111,7 +246,7 @@ func (a *argoCDMechanism) doSingleUpdate(
 		)
 	}
 	if app == nil {
-		return errors.Errorf(
+		return nil, errors.Errorf(
 			"unable to find Argo CD Application %q in namespace %q",
 			update.AppName,
 			update.AppNamespaceOrDefault(),
@@ -119,13 +254,53 @@ func (a *argoCDMechanism) doSingleUpdate(
 	}
 	// Make sure this is allowed!
 	if err = authorizeArgoCDAppUpdate(stageMeta, app.ObjectMeta); err != nil {
-		return err
+		return nil, err
 	}
-	patch := client.MergeFrom(app.DeepCopy())
+	return app, nil
+}
+
+// updateArgoCDAppSources applies the source updates specified by the provided
+// ArgoCDAppUpdate to the provided Argo CD Application in place, using the
+// provided function to update each individual source.
+func updateArgoCDAppSources(
+	app *argocd.Application,
+	update kargoapi.ArgoCDAppUpdate,
+	newFreight kargoapi.SimpleFreight,
+	applySourceUpdateFn func(
+		argocd.ApplicationSource,
+		kargoapi.SimpleFreight,
+		kargoapi.ArgoCDSourceUpdate,
+	) (argocd.ApplicationSource, error),
+) error {
+	var err error
 	for _, srcUpdate := range update.SourceUpdates {
-		if app.Spec.Source != nil {
+		if srcUpdate.SourceIndex != nil || srcUpdate.Ref != "" {
+			// This update explicitly addresses one source of a multi-source App
+			var i int
+			if i, err = findArgoCDAppSourceIndex(app, srcUpdate); err != nil {
+				return err
+			}
 			var source argocd.ApplicationSource
-			if source, err = a.applyArgoCDSourceUpdateFn(
+			if source, err = applySourceUpdateFn(
+				app.Spec.Sources[i],
+				newFreight,
+				srcUpdate,
+			); err != nil {
+				return errors.Wrapf(
+					err,
+					"error updating source %d of Argo CD Application %q in "+
+						"namespace %q",
+					i,
+					app.Name,
+					app.Namespace,
+				)
+			}
+			app.Spec.Sources[i] = source
+			continue
+		}
+		if app.Spec.Source != nil && len(app.Spec.Sources) == 0 {
+			var source argocd.ApplicationSource
+			if source, err = applySourceUpdateFn(
 				*app.Spec.Source,
 				newFreight,
 				srcUpdate,
@@ -133,14 +308,14 @@ func (a *argoCDMechanism) doSingleUpdate(
 				return errors.Wrapf(
 					err,
 					"error updating source of Argo CD Application %q in namespace %q",
-					update.AppName,
-					update.AppNamespaceOrDefault(),
+					app.Name,
+					app.Namespace,
 				)
 			}
 			app.Spec.Source = &source
 		}
 		for i, source := range app.Spec.Sources {
-			if source, err = a.applyArgoCDSourceUpdateFn(
+			if source, err = applySourceUpdateFn(
 				source,
 				newFreight,
 				srcUpdate,
@@ -148,55 +323,13 @@ func (a *argoCDMechanism) doSingleUpdate(
 				return errors.Wrapf(
 					err,
 					"error updating source(s) of Argo CD Application %q in namespace %q",
-					update.AppName,
-					update.AppNamespaceOrDefault(),
+					app.Name,
+					app.Namespace,
 				)
 			}
 			app.Spec.Sources[i] = source
 		}
 	}
-	app.ObjectMeta.Annotations[argocd.AnnotationKeyRefresh] =
-		string(argocd.RefreshTypeHard)
-	app.Operation = &argocd.Operation{
-		InitiatedBy: argocd.OperationInitiator{
-			Username:  "kargo-controller",
-			Automated: true,
-		},
-		Info: []*argocd.Info{
-			{
-				Name:  "Reason",
-				Value: "Promotion triggered a sync of this Application resource.",
-			},
-		},
-		Sync: &argocd.SyncOperation{
-			Revisions: []string{},
-		},
-	}
-	if app.Spec.SyncPolicy != nil {
-		if app.Spec.SyncPolicy.Retry != nil {
-			app.Operation.Retry = *app.Spec.SyncPolicy.Retry
-		}
-		if app.Spec.SyncPolicy.SyncOptions != nil {
-			app.Operation.Sync.SyncOptions = app.Spec.SyncPolicy.SyncOptions
-		}
-	}
-	if app.Spec.Source != nil {
-		app.Operation.Sync.Revisions = []string{app.Spec.Source.TargetRevision}
-	}
-	for _, source := range app.Spec.Sources {
-		app.Operation.Sync.Revisions =
-			append(app.Operation.Sync.Revisions, source.TargetRevision)
-	}
-	if err = a.argoCDAppPatchFn(
-		ctx,
-		app,
-		patch,
-		&client.PatchOptions{},
-	); err != nil {
-		return errors.Wrapf(err, "error patching Argo CD Application %q", app.Name)
-	}
-	logging.LoggerFromContext(ctx).WithField("app", app.Name).
-		Debug("patched Argo CD Application")
 	return nil
 }
 
@@ -216,6 +349,64 @@ func getApplicationFn(
 	}
 }
 
+// findArgoCDAppSourceIndex returns the index of the source of the provided
+// multi-source Argo CD Application that is explicitly addressed by the provided
+// ArgoCDSourceUpdate, either by index or by ref. An error is returned if no
+// such source exists or if that source does not match the update's RepoURL and
+// Chart.
+func findArgoCDAppSourceIndex(
+	app *argocd.Application,
+	update kargoapi.ArgoCDSourceUpdate,
+) (int, error) {
+	if len(app.Spec.Sources) == 0 {
+		return -1, errors.Errorf(
+			"Argo CD Application %q in namespace %q is not a multi-source "+
+				"Application; its sources cannot be addressed by index or ref",
+			app.Name,
+			app.Namespace,
+		)
+	}
+	i := -1
+	if update.SourceIndex != nil {
+		if i = int(*update.SourceIndex); i < 0 || i >= len(app.Spec.Sources) {
+			return -1, errors.Errorf(
+				"Argo CD Application %q in namespace %q has no source with index %d",
+				app.Name,
+				app.Namespace,
+				*update.SourceIndex,
+			)
+		}
+	} else {
+		for idx, source := range app.Spec.Sources {
+			if source.Ref == update.Ref {
+				i = idx
+				break
+			}
+		}
+		if i < 0 {
+			return -1, errors.Errorf(
+				"Argo CD Application %q in namespace %q has no source with ref %q",
+				app.Name,
+				app.Namespace,
+				update.Ref,
+			)
+		}
+	}
+	if source := app.Spec.Sources[i]; source.RepoURL != update.RepoURL ||
+		source.Chart != update.Chart {
+		return -1, errors.Errorf(
+			"source %d of Argo CD Application %q in namespace %q does not match "+
+				"repoURL %q and chart %q",
+			i,
+			app.Name,
+			app.Namespace,
+			update.RepoURL,
+			update.Chart,
+		)
+	}
+	return i, nil
+}
+
 // authorizeArgoCDAppUpdate returns an error if the Argo CD Application
 // represented by appMeta does not explicitly permit mutation by the Kargo Stage
 // represented by stageMeta.
@@ -0,0 +1,149 @@
+package promotion
+
+import (
+	"fmt"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+)
+
+// GetArgoCDAppDrift compares the live sources of the provided Argo CD
+// Application to what the Argo CD promotion mechanism would set them to when
+// applying the provided ArgoCDAppUpdate for the provided Freight. It returns a
+// description of each discrepancy found. An empty result indicates the
+// Application has not drifted from the Freight.
+func GetArgoCDAppDrift(
+	app *argocd.Application,
+	update kargoapi.ArgoCDAppUpdate,
+	freight kargoapi.SimpleFreight,
+) ([]string, error) {
+	desiredApp := app.DeepCopy()
+	if err := updateArgoCDAppSources(
+		desiredApp,
+		update,
+		freight,
+		applyArgoCDSourceUpdate,
+	); err != nil {
+		return nil, err
+	}
+	var drift []string
+	if app.Spec.Source != nil && len(app.Spec.Sources) == 0 {
+		drift = append(
+			drift,
+			getArgoCDSourceDrift("source", *app.Spec.Source, *desiredApp.Spec.Source)...,
+		)
+	}
+	for i := range app.Spec.Sources {
+		drift = append(
+			drift,
+			getArgoCDSourceDrift(
+				fmt.Sprintf("source %d", i),
+				app.Spec.Sources[i],
+				desiredApp.Spec.Sources[i],
+			)...,
+		)
+	}
+	for i := range drift {
+		drift[i] = fmt.Sprintf(
+			"Argo CD Application %q in namespace %q %s",
+			app.Name,
+			app.Namespace,
+			drift[i],
+		)
+	}
+	return drift, nil
+}
+
+// getArgoCDSourceDrift describes each difference between the target revision,
+// Kustomize images, and Helm parameters of the provided live and desired Argo
+// CD ApplicationSources.
+func getArgoCDSourceDrift(
+	sourceName string,
+	live argocd.ApplicationSource,
+	desired argocd.ApplicationSource,
+) []string {
+	var drift []string
+	if live.TargetRevision != desired.TargetRevision {
+		drift = append(
+			drift,
+			fmt.Sprintf(
+				"%s has targetRevision %q; expected %q",
+				sourceName,
+				live.TargetRevision,
+				desired.TargetRevision,
+			),
+		)
+	}
+	var liveImages, desiredImages argocd.KustomizeImages
+	if live.Kustomize != nil {
+		liveImages = live.Kustomize.Images
+	}
+	if desired.Kustomize != nil {
+		desiredImages = desired.Kustomize.Images
+	}
+	if !kustomizeImagesEqual(liveImages, desiredImages) {
+		drift = append(
+			drift,
+			fmt.Sprintf(
+				"%s has Kustomize images [%s]; expected [%s]",
+				sourceName,
+				joinKustomizeImages(liveImages),
+				joinKustomizeImages(desiredImages),
+			),
+		)
+	}
+	if desired.Helm != nil {
+		liveParams := map[string]string{}
+		if live.Helm != nil {
+			for _, param := range live.Helm.Parameters {
+				liveParams[param.Name] = param.Value
+			}
+		}
+		for _, param := range desired.Helm.Parameters {
+			if liveValue, ok := liveParams[param.Name]; !ok {
+				drift = append(
+					drift,
+					fmt.Sprintf(
+						"%s is missing Helm parameter %q; expected %q",
+						sourceName,
+						param.Name,
+						param.Value,
+					),
+				)
+			} else if liveValue != param.Value {
+				drift = append(
+					drift,
+					fmt.Sprintf(
+						"%s has Helm parameter %q set to %q; expected %q",
+						sourceName,
+						param.Name,
+						liveValue,
+						param.Value,
+					),
+				)
+			}
+		}
+	}
+	return drift
+}
+
+func kustomizeImagesEqual(a, b argocd.KustomizeImages) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func joinKustomizeImages(images argocd.KustomizeImages) string {
+	strs := make([]string, len(images))
+	for i, image := range images {
+		strs[i] = string(image)
+	}
+	return strings.Join(strs, ", ")
+}
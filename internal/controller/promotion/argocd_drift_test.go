@@ -0,0 +1,224 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+)
+
+func TestGetArgoCDAppDrift(t *testing.T) {
+	freight := kargoapi.SimpleFreight{
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "fake-url",
+				ID:      "fake-commit",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-image",
+				Tag:     "fake-tag",
+			},
+		},
+	}
+	update := kargoapi.ArgoCDAppUpdate{
+		AppName: "fake-app",
+		SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+			{
+				RepoURL:              "fake-url",
+				UpdateTargetRevision: true,
+				Kustomize: &kargoapi.ArgoCDKustomize{
+					Images: []string{"fake-image"},
+				},
+				Helm: &kargoapi.ArgoCDHelm{
+					Images: []kargoapi.ArgoCDHelmImageUpdate{
+						{
+							Image: "fake-image",
+							Key:   "image.tag",
+							Value: kargoapi.ImageUpdateValueTypeTag,
+						},
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		app        *argocd.Application
+		update     kargoapi.ArgoCDAppUpdate
+		assertions func([]string, error)
+	}{
+		{
+			name: "error finding addressed source",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{},
+				},
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+					{Ref: "fake-ref"},
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not a multi-source Application")
+			},
+		},
+		{
+			name: "no drift",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						RepoURL:        "fake-url",
+						TargetRevision: "fake-commit",
+						Kustomize: &argocd.ApplicationSourceKustomize{
+							Images: argocd.KustomizeImages{
+								"fake-image=fake-image:fake-tag",
+							},
+						},
+						Helm: &argocd.ApplicationSourceHelm{
+							Parameters: []argocd.HelmParameter{
+								{
+									Name:  "image.tag",
+									Value: "fake-tag",
+								},
+							},
+						},
+					},
+				},
+			},
+			update: update,
+			assertions: func(drift []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, drift)
+			},
+		},
+		{
+			name: "drift in single-source Application",
+			app: &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-app",
+					Namespace: "fake-namespace",
+				},
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						RepoURL:        "fake-url",
+						TargetRevision: "another-commit",
+						Helm: &argocd.ApplicationSourceHelm{
+							Parameters: []argocd.HelmParameter{
+								{
+									Name:  "image.tag",
+									Value: "another-tag",
+								},
+							},
+						},
+					},
+				},
+			},
+			update: update,
+			assertions: func(drift []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						`Argo CD Application "fake-app" in namespace "fake-namespace" ` +
+							`source has targetRevision "another-commit"; expected ` +
+							`"fake-commit"`,
+						`Argo CD Application "fake-app" in namespace "fake-namespace" ` +
+							`source has Kustomize images []; expected ` +
+							`[fake-image=fake-image:fake-tag]`,
+						`Argo CD Application "fake-app" in namespace "fake-namespace" ` +
+							`source has Helm parameter "image.tag" set to "another-tag"; ` +
+							`expected "fake-tag"`,
+					},
+					drift,
+				)
+			},
+		},
+		{
+			name: "drift in multi-source Application",
+			app: &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-app",
+					Namespace: "fake-namespace",
+				},
+				Spec: argocd.ApplicationSpec{
+					Sources: argocd.ApplicationSources{
+						{
+							RepoURL: "another-url",
+						},
+						{
+							RepoURL:        "fake-url",
+							TargetRevision: "another-commit",
+						},
+					},
+				},
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+					{
+						RepoURL:              "fake-url",
+						UpdateTargetRevision: true,
+					},
+				},
+			},
+			assertions: func(drift []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						`Argo CD Application "fake-app" in namespace "fake-namespace" ` +
+							`source 1 has targetRevision "another-commit"; expected ` +
+							`"fake-commit"`,
+					},
+					drift,
+				)
+			},
+		},
+		{
+			name: "single source of multi-source Application is disregarded",
+			app: &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-app",
+					Namespace: "fake-namespace",
+				},
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						RepoURL:        "fake-url",
+						TargetRevision: "another-commit",
+					},
+					Sources: argocd.ApplicationSources{
+						{
+							RepoURL:        "fake-url",
+							TargetRevision: "fake-commit",
+						},
+					},
+				},
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+					{
+						RepoURL:              "fake-url",
+						UpdateTargetRevision: true,
+					},
+				},
+			},
+			assertions: func(drift []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, drift)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				GetArgoCDAppDrift(testCase.app, testCase.update, freight),
+			)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -15,7 +16,7 @@ import (
 )
 
 func TestNewArgoCDMechanism(t *testing.T) {
-	pm := newArgoCDMechanism(
+	pm := NewArgoCDMechanism(
 		fake.NewClientBuilder().Build(),
 	)
 	apm, ok := pm.(*argoCDMechanism)
@@ -137,6 +138,181 @@ func TestArgoCDPromote(t *testing.T) {
 	}
 }
 
+func TestArgoCDPromoteCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var updated []string
+	_, err := (&argoCDMechanism{
+		doSingleUpdateFn: func(
+			_ context.Context,
+			_ metav1.ObjectMeta,
+			update kargoapi.ArgoCDAppUpdate,
+			_ kargoapi.SimpleFreight,
+		) error {
+			updated = append(updated, update.AppName)
+			cancel()
+			return nil
+		},
+	}).Promote(
+		ctx,
+		&kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+						{AppName: "first", AppNamespace: "fake-namespace"},
+						{AppName: "second", AppNamespace: "fake-namespace"},
+					},
+				},
+			},
+		},
+		kargoapi.SimpleFreight{},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Contains(
+		t,
+		err.Error(),
+		"interrupted before updating Argo CD Application fake-namespace/second",
+	)
+	require.Equal(t, []string{"first"}, updated)
+}
+
+func TestArgoCDPreview(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-name",
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+					{
+						AppName:      "fake-app",
+						AppNamespace: "fake-namespace",
+						SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+							{},
+						},
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *argoCDMechanism
+		assertions func(preview Preview, err error)
+	}{
+		{
+			name: "error getting Argo CD App",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Argo CD Application")
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Empty(t, preview.ArgoCDAppPatches)
+			},
+		},
+		{
+			name: "update not authorized",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-app",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not permit mutation by")
+				require.Empty(t, preview.ArgoCDAppPatches)
+			},
+		},
+		{
+			name: "success",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-app",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Spec: argocd.ApplicationSpec{
+							Source: &argocd.ApplicationSource{
+								TargetRevision: "fake-old-revision",
+							},
+						},
+					}, nil
+				},
+				applyArgoCDSourceUpdateFn: func(
+					source argocd.ApplicationSource,
+					_ kargoapi.SimpleFreight,
+					_ kargoapi.ArgoCDSourceUpdate,
+				) (argocd.ApplicationSource, error) {
+					source.TargetRevision = "fake-new-revision"
+					return source, nil
+				},
+				argoCDAppPatchFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					require.FailNow(t, "Argo CD Application should not be patched")
+					return nil
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]ArgoCDAppPatch{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-app",
+							Patch: `{"spec":{"source":` +
+								`{"targetRevision":"fake-new-revision"}}}`,
+						},
+					},
+					preview.ArgoCDAppPatches,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			preview := Preview{}
+			err := testCase.promoMech.Preview(
+				context.Background(),
+				testStage,
+				kargoapi.SimpleFreight{},
+				&preview,
+			)
+			testCase.assertions(preview, err)
+		})
+	}
+}
+
 func TestArgoCDDoSingleUpdate(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -302,6 +478,101 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				require.Contains(t, err.Error(), "something went wrong")
 			},
 		},
+		{
+			name: "error finding addressed source",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Spec: argocd.ApplicationSpec{
+							Sources: []argocd.ApplicationSource{
+								{},
+							},
+						},
+					}, nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+					{
+						Ref: "values",
+					},
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "has no source with ref")
+			},
+		},
+		{
+			name: "error updating addressed source",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Spec: argocd.ApplicationSpec{
+							Sources: []argocd.ApplicationSource{
+								{},
+								{
+									Ref: "values",
+								},
+							},
+						},
+					}, nil
+				},
+				applyArgoCDSourceUpdateFn: func(
+					argocd.ApplicationSource,
+					kargoapi.SimpleFreight,
+					kargoapi.ArgoCDSourceUpdate,
+				) (argocd.ApplicationSource, error) {
+					return argocd.ApplicationSource{}, errors.New("something went wrong")
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+					{
+						Ref: "values",
+					},
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error updating source 1 of Argo CD Application",
+				)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
 		{
 			name: "error patching Application",
 			promoMech: &argoCDMechanism{
@@ -393,6 +664,117 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 	}
 }
 
+func TestFindArgoCDAppSourceIndex(t *testing.T) {
+	app := &argocd.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-name",
+			Namespace: "fake-namespace",
+		},
+		Spec: argocd.ApplicationSpec{
+			Sources: argocd.ApplicationSources{
+				{
+					RepoURL: "fake-registry",
+					Chart:   "fake-chart",
+				},
+				{
+					RepoURL: "fake-git-url",
+					Ref:     "values",
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		app        *argocd.Application
+		update     kargoapi.ArgoCDSourceUpdate
+		assertions func(int, error)
+	}{
+		{
+			name: "Application is not multi-source",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{},
+				},
+			},
+			update: kargoapi.ArgoCDSourceUpdate{
+				SourceIndex: pointer.Int32(0),
+			},
+			assertions: func(_ int, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not a multi-source Application")
+			},
+		},
+		{
+			name: "index out of range",
+			app:  app,
+			update: kargoapi.ArgoCDSourceUpdate{
+				RepoURL:     "fake-git-url",
+				SourceIndex: pointer.Int32(2),
+			},
+			assertions: func(_ int, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "has no source with index 2")
+			},
+		},
+		{
+			name: "ref not found",
+			app:  app,
+			update: kargoapi.ArgoCDSourceUpdate{
+				RepoURL: "fake-git-url",
+				Ref:     "bogus",
+			},
+			assertions: func(_ int, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `has no source with ref "bogus"`)
+			},
+		},
+		{
+			name: "addressed source does not match",
+			app:  app,
+			update: kargoapi.ArgoCDSourceUpdate{
+				RepoURL:     "fake-git-url",
+				SourceIndex: pointer.Int32(0),
+			},
+			assertions: func(_ int, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not match")
+			},
+		},
+		{
+			name: "success by index",
+			app:  app,
+			update: kargoapi.ArgoCDSourceUpdate{
+				RepoURL:     "fake-registry",
+				Chart:       "fake-chart",
+				SourceIndex: pointer.Int32(0),
+			},
+			assertions: func(i int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 0, i)
+			},
+		},
+		{
+			name: "success by ref",
+			app:  app,
+			update: kargoapi.ArgoCDSourceUpdate{
+				RepoURL: "fake-git-url",
+				Ref:     "values",
+			},
+			assertions: func(i int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, i)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				findArgoCDAppSourceIndex(testCase.app, testCase.update),
+			)
+		})
+	}
+}
+
 func TestAuthorizeArgoCDAppUpdate(t *testing.T) {
 	permErr := "does not permit mutation"
 	parseErr := "unable to parse"
@@ -0,0 +1,190 @@
+package promotion
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+)
+
+type promotionContextKey struct{}
+
+// ContextWithPromotion returns a copy of the provided context that carries the
+// provided Promotion. Promotion mechanisms use it to describe the Promotion in
+// the changes they make.
+func ContextWithPromotion(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) context.Context {
+	return context.WithValue(ctx, promotionContextKey{}, promo)
+}
+
+// promotionFromContext returns the Promotion carried by the provided context,
+// or nil if there is none.
+func promotionFromContext(ctx context.Context) *kargoapi.Promotion {
+	promo, _ := ctx.Value(promotionContextKey{}).(*kargoapi.Promotion)
+	return promo
+}
+
+// gitCommitOptions describes how the commits made by a GitRepoUpdate are
+// attributed and described.
+type gitCommitOptions struct {
+	// user is the identity to which commits are attributed. If nil, a default
+	// identity is used.
+	user *git.User
+	// messageTemplate is the template from which commit messages are rendered.
+	// If empty, the summary of changes is used as the commit message.
+	messageTemplate string
+	// trailers indicates whether trailers identifying the Promotion should be
+	// appended to commit messages.
+	trailers bool
+	// data is made available to the message template. Its Changes and Summary
+	// fields are populated once the changes are known.
+	data commitMessageData
+}
+
+// commitMessageData is the data made available to commit message templates.
+type commitMessageData struct {
+	Project   string
+	Stage     *kargoapi.Stage
+	Promotion *kargoapi.Promotion
+	Freight   kargoapi.SimpleFreight
+	Initiator string
+	Changes   []string
+	Summary   string
+}
+
+// getProjectFn returns a function that closes over the provided client and,
+// when invoked, uses that client to obtain the namespace of the specified
+// project. If the namespace is not found, nil is returned.
+func getProjectFn(
+	kargoClient client.Client,
+) func(ctx context.Context, project string) (*corev1.Namespace, error) {
+	return func(ctx context.Context, project string) (*corev1.Namespace, error) {
+		ns := &corev1.Namespace{}
+		if err := kargoClient.Get(
+			ctx,
+			types.NamespacedName{Name: project},
+			ns,
+		); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrapf(err, "error getting namespace %q", project)
+		}
+		return ns, nil
+	}
+}
+
+// getCommitOptions returns options for the commits made by the provided update
+// to promote the provided Freight into the provided Stage. Settings from the
+// update's own commit options take precedence over those specified using
+// annotations on the namespace of the Stage's project.
+func (g *gitMechanism) getCommitOptions(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+) (gitCommitOptions, error) {
+	opts := gitCommitOptions{
+		trailers: true,
+		data: commitMessageData{
+			Project:   stage.Namespace,
+			Stage:     stage,
+			Promotion: promotionFromContext(ctx),
+			Freight:   newFreight,
+		},
+	}
+	if opts.data.Promotion != nil {
+		opts.data.Initiator =
+			opts.data.Promotion.Annotations[kargoapi.AnnotationKeyCreateActor]
+	}
+
+	user := git.User{}
+	project, err := g.getProjectFn(ctx, stage.Namespace)
+	if err != nil {
+		return opts, err
+	}
+	if project != nil {
+		user.Name = project.Annotations[kargoapi.AnnotationKeyGitAuthorName]
+		user.Email = project.Annotations[kargoapi.AnnotationKeyGitAuthorEmail]
+		opts.messageTemplate =
+			project.Annotations[kargoapi.AnnotationKeyGitCommitMessageTemplate]
+	}
+	if commit := update.Commit; commit != nil {
+		if commit.AuthorName != "" {
+			user.Name = commit.AuthorName
+		}
+		if commit.AuthorEmail != "" {
+			user.Email = commit.AuthorEmail
+		}
+		if commit.MessageTemplate != "" {
+			opts.messageTemplate = commit.MessageTemplate
+		}
+		opts.trailers = !commit.DisableTrailers
+	}
+	if user.Name != "" || user.Email != "" {
+		opts.user = &user
+	}
+	return opts, nil
+}
+
+// renderCommitMessage returns the message for a commit that makes the provided
+// changes, rendered according to the provided options.
+func renderCommitMessage(
+	opts gitCommitOptions,
+	changes []string,
+) (string, error) {
+	data := opts.data
+	data.Changes = changes
+	data.Summary = buildCommitMessage(changes)
+
+	msg := data.Summary
+	if opts.messageTemplate != "" {
+		tmpl, err := template.New("commit").Parse(opts.messageTemplate)
+		if err != nil {
+			return "", errors.Wrap(err, "error parsing commit message template")
+		}
+		var buf bytes.Buffer
+		if err = tmpl.Execute(&buf, data); err != nil {
+			return "", errors.Wrap(err, "error rendering commit message template")
+		}
+		msg = strings.TrimSpace(buf.String())
+		if msg == "" {
+			return "", errors.New("commit message template rendered an empty message")
+		}
+	}
+
+	if !opts.trailers {
+		return msg, nil
+	}
+	trailers := []string{
+		fmt.Sprintf("Kargo-Project: %s", data.Project),
+	}
+	if data.Stage != nil {
+		trailers = append(trailers, fmt.Sprintf("Kargo-Stage: %s", data.Stage.Name))
+	}
+	if data.Promotion != nil {
+		trailers = append(
+			trailers,
+			fmt.Sprintf("Kargo-Promotion: %s", data.Promotion.Name),
+		)
+	}
+	if data.Freight.ID != "" {
+		trailers = append(trailers, fmt.Sprintf("Kargo-Freight: %s", data.Freight.ID))
+	}
+	if data.Initiator != "" {
+		trailers = append(trailers, fmt.Sprintf("Kargo-Initiator: %s", data.Initiator))
+	}
+	return fmt.Sprintf("%s\n\n%s", msg, strings.Join(trailers, "\n")), nil
+}
@@ -0,0 +1,291 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+)
+
+func TestContextWithPromotion(t *testing.T) {
+	require.Nil(t, promotionFromContext(context.Background()))
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+	}
+	require.Same(
+		t,
+		promo,
+		promotionFromContext(ContextWithPromotion(context.Background(), promo)),
+	)
+}
+
+func TestGetProjectFn(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	getProject := getProjectFn(
+		fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-project"},
+			},
+		).Build(),
+	)
+	ns, err := getProject(context.Background(), "fake-project")
+	require.NoError(t, err)
+	require.NotNil(t, ns)
+	require.Equal(t, "fake-project", ns.Name)
+	ns, err = getProject(context.Background(), "missing-project")
+	require.NoError(t, err)
+	require.Nil(t, ns)
+}
+
+func TestGetCommitOptions(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+	}
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-promotion",
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyCreateActor: "fake-user",
+			},
+		},
+	}
+	testFreight := kargoapi.SimpleFreight{ID: "fake-freight"}
+	testProject := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-project",
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyGitAuthorName:            "Project Bot",
+				kargoapi.AnnotationKeyGitAuthorEmail:           "project-bot@example.com",
+				kargoapi.AnnotationKeyGitCommitMessageTemplate: "project template",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		project    *corev1.Namespace
+		projectErr error
+		update     kargoapi.GitRepoUpdate
+		assertions func(gitCommitOptions, error)
+	}{
+		{
+			name:       "error getting project",
+			projectErr: errors.New("something went wrong"),
+			assertions: func(_ gitCommitOptions, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "defaults",
+			assertions: func(opts gitCommitOptions, err error) {
+				require.NoError(t, err)
+				require.Nil(t, opts.user)
+				require.Empty(t, opts.messageTemplate)
+				require.True(t, opts.trailers)
+				require.Equal(t, "fake-project", opts.data.Project)
+				require.Same(t, testStage, opts.data.Stage)
+				require.Same(t, testPromo, opts.data.Promotion)
+				require.Equal(t, testFreight, opts.data.Freight)
+				require.Equal(t, "fake-user", opts.data.Initiator)
+			},
+		},
+		{
+			name:    "project settings",
+			project: testProject,
+			assertions: func(opts gitCommitOptions, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.User{
+						Name:  "Project Bot",
+						Email: "project-bot@example.com",
+					},
+					opts.user,
+				)
+				require.Equal(t, "project template", opts.messageTemplate)
+				require.True(t, opts.trailers)
+			},
+		},
+		{
+			name:    "update settings take precedence",
+			project: testProject,
+			update: kargoapi.GitRepoUpdate{
+				Commit: &kargoapi.GitCommitOptions{
+					AuthorEmail:     "update-bot@example.com",
+					MessageTemplate: "update template",
+					DisableTrailers: true,
+				},
+			},
+			assertions: func(opts gitCommitOptions, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.User{
+						Name:  "Project Bot",
+						Email: "update-bot@example.com",
+					},
+					opts.user,
+				)
+				require.Equal(t, "update template", opts.messageTemplate)
+				require.False(t, opts.trailers)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := &gitMechanism{
+				getProjectFn: func(
+					context.Context,
+					string,
+				) (*corev1.Namespace, error) {
+					return testCase.project, testCase.projectErr
+				},
+			}
+			testCase.assertions(
+				g.getCommitOptions(
+					ContextWithPromotion(context.Background(), testPromo),
+					testStage,
+					testCase.update,
+					testFreight,
+				),
+			)
+		})
+	}
+}
+
+func TestRenderCommitMessage(t *testing.T) {
+	testData := commitMessageData{
+		Project: "fake-project",
+		Stage: &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+		},
+		Promotion: &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+		},
+		Freight:   kargoapi.SimpleFreight{ID: "fake-freight"},
+		Initiator: "fake-user",
+	}
+	testCases := []struct {
+		name       string
+		opts       gitCommitOptions
+		changes    []string
+		assertions func(string, error)
+	}{
+		{
+			name:    "summary without trailers",
+			opts:    gitCommitOptions{data: testData},
+			changes: []string{"updated image"},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "updated image", msg)
+			},
+		},
+		{
+			name: "summary with trailers",
+			opts: gitCommitOptions{
+				trailers: true,
+				data:     testData,
+			},
+			changes: []string{"updated image"},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"updated image\n\n"+
+						"Kargo-Project: fake-project\n"+
+						"Kargo-Stage: fake-stage\n"+
+						"Kargo-Promotion: fake-promotion\n"+
+						"Kargo-Freight: fake-freight\n"+
+						"Kargo-Initiator: fake-user",
+					msg,
+				)
+			},
+		},
+		{
+			name: "trailers omit unknown values",
+			opts: gitCommitOptions{
+				trailers: true,
+				data:     commitMessageData{Project: "fake-project"},
+			},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"Kargo applied some changes\n\nKargo-Project: fake-project",
+					msg,
+				)
+			},
+		},
+		{
+			name: "template",
+			opts: gitCommitOptions{
+				messageTemplate: "Promote {{ .Freight.ID }} to {{ .Stage.Name }}\n\n" +
+					"Requested by {{ .Initiator }}\n" +
+					"{{ range .Changes }}\n- {{ . }}{{ end }}\n",
+				data: testData,
+			},
+			changes: []string{"updated image", "updated chart"},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"Promote fake-freight to fake-stage\n\n"+
+						"Requested by fake-user\n\n"+
+						"- updated image\n"+
+						"- updated chart",
+					msg,
+				)
+			},
+		},
+		{
+			name: "invalid template",
+			opts: gitCommitOptions{
+				messageTemplate: "{{ .Freight.ID",
+				data:            testData,
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing commit message template")
+			},
+		},
+		{
+			name: "template references unknown field",
+			opts: gitCommitOptions{
+				messageTemplate: "{{ .Bogus }}",
+				data:            testData,
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error rendering commit message template")
+			},
+		},
+		{
+			name: "template renders empty message",
+			opts: gitCommitOptions{
+				messageTemplate: "{{ if false }}message{{ end }}",
+				data:            testData,
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "empty message")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(renderCommitMessage(testCase.opts, testCase.changes))
+		})
+	}
+}
@@ -53,6 +53,15 @@ func (c *compositeMechanism) Promote(
 	logger.Debugf("executing %s", c.name)
 
 	for _, childMechanism := range c.childMechanisms {
+		// Stop between steps if the promotion has been aborted.
+		if err := ctx.Err(); err != nil {
+			return newFreight, errors.Wrapf(
+				err,
+				"%s interrupted before executing %s",
+				c.name,
+				childMechanism.GetName(),
+			)
+		}
 		var err error
 		newFreight, err = childMechanism.Promote(ctx, stage, newFreight)
 		if err != nil {
@@ -68,3 +77,30 @@ func (c *compositeMechanism) Promote(
 
 	return newFreight, nil
 }
+
+// Preview implements the Previewer interface. Child Mechanisms that do not
+// implement the Previewer interface are skipped.
+func (c *compositeMechanism) Preview(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	newFreight kargoapi.SimpleFreight,
+	preview *Preview,
+) error {
+	if stage.Spec.PromotionMechanisms == nil {
+		return nil
+	}
+	for _, childMechanism := range c.childMechanisms {
+		previewer, ok := childMechanism.(Previewer)
+		if !ok {
+			continue
+		}
+		if err := previewer.Preview(ctx, stage, newFreight, preview); err != nil {
+			return errors.Wrapf(
+				err,
+				"error previewing %s",
+				childMechanism.GetName(),
+			)
+		}
+	}
+	return nil
+}
@@ -129,3 +129,146 @@ func TestCompositePromote(t *testing.T) {
 		})
 	}
 }
+
+func TestCompositePromoteCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var executed []string
+	newFakeMechanism := func(name string, cancelCtx bool) Mechanism {
+		return &FakeMechanism{
+			Name: name,
+			PromoteFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				newFreight kargoapi.SimpleFreight,
+			) (kargoapi.SimpleFreight, error) {
+				executed = append(executed, name)
+				if cancelCtx {
+					cancel()
+				}
+				return newFreight, nil
+			},
+		}
+	}
+	_, err := (&compositeMechanism{
+		name: "fake composite mechanism",
+		childMechanisms: []Mechanism{
+			newFakeMechanism("first", true),
+			newFakeMechanism("second", false),
+		},
+	}).Promote(
+		ctx,
+		&kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+			},
+		},
+		kargoapi.SimpleFreight{},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Contains(t, err.Error(), "interrupted before executing second")
+	require.Equal(t, []string{"first"}, executed)
+}
+
+// fakePreviewerMechanism is a Mechanism that also implements the Previewer
+// interface.
+type fakePreviewerMechanism struct {
+	FakeMechanism
+	previewFn func(*Preview) error
+}
+
+func (f *fakePreviewerMechanism) Preview(
+	_ context.Context,
+	_ *kargoapi.Stage,
+	_ kargoapi.SimpleFreight,
+	preview *Preview,
+) error {
+	return f.previewFn(preview)
+}
+
+func TestCompositePreview(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+		},
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *compositeMechanism
+		assertions func(preview Preview, err error)
+	}{
+		{
+			name: "error previewing child promotion mechanism",
+			promoMech: &compositeMechanism{
+				childMechanisms: []Mechanism{
+					&fakePreviewerMechanism{
+						FakeMechanism: FakeMechanism{
+							Name: "fake promotion mechanism",
+						},
+						previewFn: func(*Preview) error {
+							return errors.New("something went wrong")
+						},
+					},
+				},
+			},
+			assertions: func(_ Preview, err error) {
+				require.Error(t, err)
+				require.Equal(
+					t,
+					"error previewing fake promotion mechanism: something went wrong",
+					err.Error(),
+				)
+			},
+		},
+		{
+			name: "success",
+			promoMech: &compositeMechanism{
+				childMechanisms: []Mechanism{
+					// Doesn't implement Previewer and should be skipped
+					&FakeMechanism{},
+					&fakePreviewerMechanism{
+						previewFn: func(preview *Preview) error {
+							preview.GitRepoDiffs = append(
+								preview.GitRepoDiffs,
+								GitRepoDiff{RepoURL: "fake-url"},
+							)
+							return nil
+						},
+					},
+					&fakePreviewerMechanism{
+						previewFn: func(preview *Preview) error {
+							preview.ArgoCDAppPatches = append(
+								preview.ArgoCDAppPatches,
+								ArgoCDAppPatch{Name: "fake-app"},
+							)
+							return nil
+						},
+					},
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					Preview{
+						GitRepoDiffs:     []GitRepoDiff{{RepoURL: "fake-url"}},
+						ArgoCDAppPatches: []ArgoCDAppPatch{{Name: "fake-app"}},
+					},
+					preview,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			preview := Preview{}
+			err := testCase.promoMech.Preview(
+				context.Background(),
+				testStage,
+				kargoapi.SimpleFreight{},
+				&preview,
+			)
+			testCase.assertions(preview, err)
+		})
+	}
+}
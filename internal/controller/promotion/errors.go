@@ -0,0 +1,82 @@
+package promotion
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// transientErrorMessages are fragments of error messages (in lower case) that
+// indicate a failure that is likely to resolve itself if the operation is
+// retried. Many errors encountered by promotion mechanisms originate from the
+// output of external commands like git, so they can only be recognized by
+// their messages.
+var transientErrorMessages = []string{
+	// Network errors
+	"connection refused",
+	"connection reset",
+	"connection timed out",
+	"i/o timeout",
+	"tls handshake timeout",
+	"temporary failure in name resolution",
+	"could not resolve host",
+	"unexpected eof",
+	"broken pipe",
+	// Rejected pushes to Git repositories, which typically mean someone else
+	// pushed first
+	"failed to push some refs",
+	"non-fast-forward",
+	"fetch first",
+	"cannot lock ref",
+	// Server errors from Git hosts and registries
+	"the requested url returned error: 5",
+	"500 internal server error",
+	"502 bad gateway",
+	"503 service unavailable",
+	"504 gateway timeout",
+	"too many requests",
+}
+
+// signatureRejectionMessages are fragments of error messages (in lower case)
+// with which Git hosts reject pushes to branches that require signed commits.
+// These accompany messages that would otherwise indicate a transient failure,
+// but retrying will not help.
+var signatureRejectionMessages = []string{
+	"verified signatures", // GitHub
+	"signed commits",      // GitHub and GitLab
+	"unsigned commit",     // GitLab
+}
+
+// IsTransientError returns a bool indicating whether the provided error, which
+// was returned from a promotion mechanism, represents a failure that is likely
+// to resolve itself if the promotion is retried.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	if apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range signatureRejectionMessages {
+		if strings.Contains(msg, fragment) {
+			return false
+		}
+	}
+	for _, fragment := range transientErrorMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package promotion
+
+import (
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIsTransientError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil error",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name: "network error",
+			err: errors.Wrap(
+				&net.OpError{Op: "dial", Err: errors.New("something went wrong")},
+				"error cloning git repo",
+			),
+			expected: true,
+		},
+		{
+			name: "Kubernetes API server unavailable",
+			err: errors.Wrap(
+				apierrors.NewServiceUnavailable("something went wrong"),
+				"error patching Argo CD Application",
+			),
+			expected: true,
+		},
+		{
+			name: "Kubernetes resource not found",
+			err: apierrors.NewNotFound(
+				schema.GroupResource{Resource: "applications"},
+				"fake-app",
+			),
+			expected: false,
+		},
+		{
+			name: "rejected push",
+			err: errors.New(
+				"error pushing updates to git repo: ! [rejected] main -> main " +
+					"(fetch first)\nerror: failed to push some refs",
+			),
+			expected: true,
+		},
+		{
+			name: "push rejected for lack of signed commits",
+			err: errors.New(
+				"error pushing updates to git repo: remote: error: GH006: " +
+					"Protected branch update failed for refs/heads/main.\n" +
+					"remote: error: Commits must have verified signatures.\n" +
+					"error: failed to push some refs",
+			),
+			expected: false,
+		},
+		{
+			name:     "server error from Git host",
+			err:      errors.New("The requested URL returned error: 503"),
+			expected: true,
+		},
+		{
+			name:     "server error from registry",
+			err:      errors.New("unexpected status code 502 Bad Gateway"),
+			expected: true,
+		},
+		{
+			name:     "authentication failure",
+			err:      errors.New("The requested URL returned error: 403"),
+			expected: false,
+		},
+		{
+			name:     "other error",
+			err:      errors.New("something went wrong"),
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, IsTransientError(testCase.err))
+		})
+	}
+}
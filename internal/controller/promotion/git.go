@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -23,7 +25,7 @@ type gitMechanism struct {
 	selectUpdatesFn  func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate
 	doSingleUpdateFn func(
 		ctx context.Context,
-		namespace string,
+		stage *kargoapi.Stage,
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.SimpleFreight,
 	) (kargoapi.SimpleFreight, error)
@@ -36,7 +38,22 @@ type gitMechanism struct {
 		namespace string,
 		repoURL string,
 	) (*git.RepoCredentials, error)
+	getProjectFn func(
+		ctx context.Context,
+		project string,
+	) (*corev1.Namespace, error)
 	gitCommitFn func(
+		ctx context.Context,
+		update kargoapi.GitRepoUpdate,
+		newFreight kargoapi.SimpleFreight,
+		readRef string,
+		writeBranch string,
+		creds *git.RepoCredentials,
+		commitOpts gitCommitOptions,
+		step *step,
+	) (string, error)
+	gitDiffFn func(
+		ctx context.Context,
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.SimpleFreight,
 		readRef string,
@@ -57,6 +74,7 @@ type gitMechanism struct {
 // functions that select and carry out the relevant subset of updates.
 func newGitMechanism(
 	name string,
+	kargoClient client.Client,
 	credentialsDB credentials.Database,
 	selectUpdatesFn func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate,
 	applyConfigManagementFn func(
@@ -73,7 +91,9 @@ func newGitMechanism(
 	g.doSingleUpdateFn = g.doSingleUpdate
 	g.getReadRefFn = getReadRef
 	g.getCredentialsFn = getRepoCredentialsFn(credentialsDB)
+	g.getProjectFn = getProjectFn(kargoClient)
 	g.gitCommitFn = g.gitCommit
+	g.gitDiffFn = g.gitDiff
 	g.applyConfigManagementFn = applyConfigManagementFn
 	return g
 }
@@ -101,10 +121,19 @@ func (g *gitMechanism) Promote(
 	logger.Debugf("executing %s", g.name)
 
 	for _, update := range updates {
+		// Stop between updates if the promotion has been aborted.
+		if err := ctx.Err(); err != nil {
+			return newFreight, errors.Wrapf(
+				err,
+				"%s interrupted before updating %s",
+				g.name,
+				gitUpdateTarget(update),
+			)
+		}
 		var err error
 		if newFreight, err = g.doSingleUpdateFn(
 			ctx,
-			stage.Namespace,
+			stage,
 			update,
 			newFreight,
 		); err != nil {
@@ -117,33 +146,97 @@ func (g *gitMechanism) Promote(
 	return newFreight, nil
 }
 
+// Preview implements the Previewer interface.
+func (g *gitMechanism) Preview(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	newFreight kargoapi.SimpleFreight,
+	preview *Preview,
+) error {
+	updates := g.selectUpdatesFn(stage.Spec.PromotionMechanisms.GitRepoUpdates)
+	for _, update := range updates {
+		readRef, _, err := g.getReadRefFn(update, newFreight.Commits)
+		if err != nil {
+			return err
+		}
+		creds, err := g.getCredentialsFn(ctx, stage.Namespace, update.RepoURL)
+		if err != nil {
+			return err
+		}
+		diff, err := g.gitDiffFn(
+			ctx,
+			update,
+			newFreight,
+			readRef,
+			update.WriteBranch,
+			creds,
+		)
+		if err != nil {
+			return err
+		}
+		preview.GitRepoDiffs = append(
+			preview.GitRepoDiffs,
+			GitRepoDiff{
+				Mechanism: g.name,
+				RepoURL:   update.RepoURL,
+				Branch:    update.WriteBranch,
+				Diff:      diff,
+			},
+		)
+	}
+	return nil
+}
+
 // doSingleUpdate updates configuration in a single Git repository.
 func (g *gitMechanism) doSingleUpdate(
 	ctx context.Context,
-	namespace string,
+	stage *kargoapi.Stage,
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.SimpleFreight,
-) (kargoapi.SimpleFreight, error) {
+) (_ kargoapi.SimpleFreight, err error) {
+	step := startStep(ctx, g.name, gitUpdateTarget(update))
+
 	readRef, commitIndex, err := g.getReadRefFn(update, newFreight.Commits)
 	if err != nil {
+		step.conclude("", err)
 		return newFreight, err
 	}
 
+	if previous, ok := step.completed(); ok {
+		if commitIndex > -1 {
+			newFreight.Commits[commitIndex].HealthCheckCommit = previous.Commit
+		}
+		return newFreight, nil
+	}
+
+	var commitID string
+	defer func() {
+		step.conclude(commitID, err)
+	}()
+
 	creds, err := g.getCredentialsFn(
 		ctx,
-		namespace,
+		stage.Namespace,
 		update.RepoURL,
 	)
 	if err != nil {
 		return newFreight, err
 	}
 
-	commitID, err := g.gitCommitFn(
+	commitOpts, err := g.getCommitOptions(ctx, stage, update, newFreight)
+	if err != nil {
+		return newFreight, err
+	}
+
+	commitID, err = g.gitCommitFn(
+		ctx,
 		update,
 		newFreight,
 		readRef,
 		update.WriteBranch,
 		creds,
+		commitOpts,
+		step,
 	)
 	if err != nil {
 		return newFreight, err
@@ -156,6 +249,15 @@ func (g *gitMechanism) doSingleUpdate(
 	return newFreight, nil
 }
 
+// gitUpdateTarget returns a description of the repository and branch written
+// to by the provided update.
+func gitUpdateTarget(update kargoapi.GitRepoUpdate) string {
+	if update.WriteBranch == "" {
+		return update.RepoURL
+	}
+	return fmt.Sprintf("%s (branch %s)", update.RepoURL, update.WriteBranch)
+}
+
 // getReadRef steps through the provided slice of commits to determine if any of
 // them are from the same repository referenced by the provided update. If so,
 // it returns the commit ID and index of the commit in the slice. If not, it
@@ -220,9 +322,11 @@ func getRepoCredentialsFn(
 		}
 		logger.Debug("obtained credentials for git repo")
 		return &git.RepoCredentials{
-			Username:      creds.Username,
-			Password:      creds.Password,
-			SSHPrivateKey: creds.SSHPrivateKey,
+			Username:       creds.Username,
+			Password:       creds.Password,
+			SSHPrivateKey:  creds.SSHPrivateKey,
+			SigningKey:     creds.SigningKey,
+			SigningKeyType: git.SigningKeyType(creds.SigningKeyType),
 		}, nil
 	}
 }
@@ -230,30 +334,188 @@ func getRepoCredentialsFn(
 // gitCommit clones the specified git repository using the provided credentials
 // (which may be nil), checks out the specified readRef (if non-empty), applies
 // the provided update function to the cloned repository, and then commits and
-// pushes any changes to the specified writeBranch. The function returns the
+// pushes any changes to the specified writeBranch. Commits are attributed and
+// described according to the provided commit options. The function returns the
 // commit ID of the last commit made to the repository, or an error if any of
-// the above fails.
+// the above fails. If the provided step was interrupted in a previous attempt
+// after making a commit that has since been pushed, no new commit is made and
+// the ID of that commit is returned instead.
 func (g *gitMechanism) gitCommit(
+	ctx context.Context,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	readRef string,
+	writeBranch string,
+	creds *git.RepoCredentials,
+	commitOpts gitCommitOptions,
+	step *step,
+) (string, error) {
+	repo, changes, err := g.prepareChanges(
+		ctx,
+		update,
+		newFreight,
+		readRef,
+		writeBranch,
+		creds,
+		commitOpts.user,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer repo.Close()
+
+	commitMsg, err := renderCommitMessage(commitOpts, changes)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error building commit message for git repo %q",
+			update.RepoURL,
+		)
+	}
+
+	if previousCommit := step.previousCommit(); previousCommit != "" {
+		pushed, err := repo.HasCommit(previousCommit)
+		if err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error checking for commit %q in git repo %q",
+				previousCommit,
+				update.RepoURL,
+			)
+		}
+		if pushed {
+			return previousCommit, nil
+		}
+	}
+
+	hasDiffs, err := repo.HasDiffs()
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error checking for diffs in git repo %q",
+			update.RepoURL,
+		)
+	}
+
+	if hasDiffs {
+		if err = repo.AddAllAndCommit(commitMsg); err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error committing updates to git repo %q",
+				update.RepoURL,
+			)
+		}
+		// Record the new commit before pushing it so that, if the push is
+		// interrupted, a subsequent attempt can tell whether it succeeded.
+		commitID, err := repo.LastCommitID()
+		if err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error getting last commit ID from git repo %q",
+				update.RepoURL,
+			)
+		}
+		step.setCommit(commitID)
+		if err = repo.Push(); err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error pushing updates to git repo %q",
+				update.RepoURL,
+			)
+		}
+	}
+
+	commitID, err := repo.LastCommitID()
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error getting last commit ID from git repo %q",
+			update.RepoURL,
+		)
+	}
+
+	return commitID, nil
+}
+
+// gitDiff works like gitCommit, but instead of committing and pushing changes
+// to the specified writeBranch, it returns a unified diff of the changes that
+// would have been committed.
+func (g *gitMechanism) gitDiff(
+	ctx context.Context,
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.SimpleFreight,
 	readRef string,
 	writeBranch string,
 	creds *git.RepoCredentials,
 ) (string, error) {
+	repo, _, err := g.prepareChanges(
+		ctx,
+		update,
+		newFreight,
+		readRef,
+		writeBranch,
+		creds,
+		nil,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer repo.Close()
+
+	if err = repo.AddAll(); err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error staging updates in git repo %q",
+			update.RepoURL,
+		)
+	}
+	diff, err := repo.Diff()
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error diffing updates in git repo %q",
+			update.RepoURL,
+		)
+	}
+	return diff, nil
+}
+
+// prepareChanges clones the specified git repository using the provided
+// credentials (which may be nil), checks out the specified readRef (if
+// non-empty), applies the provided update function to the cloned repository,
+// and then leaves the resulting changes uncommitted in a working tree based on
+// the specified writeBranch. Any commits subsequently made to the repository
+// are attributed to the provided user (which may be nil). It returns the
+// repository, which the caller is responsible for closing, along with a
+// summary of the changes.
+func (g *gitMechanism) prepareChanges(
+	ctx context.Context,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	readRef string,
+	writeBranch string,
+	creds *git.RepoCredentials,
+	user *git.User,
+) (_ git.Repo, _ []string, err error) {
 	if creds == nil {
 		creds = &git.RepoCredentials{}
 	}
-	repo, err := git.Clone(update.RepoURL, *creds)
+	repo, err := git.Clone(ctx, update.RepoURL, *creds, user)
 	if err != nil {
-		return "", errors.Wrapf(err, "error cloning git repo %q", update.RepoURL)
+		return nil, nil,
+			errors.Wrapf(err, "error cloning git repo %q", update.RepoURL)
 	}
-	defer repo.Close()
+	defer func() {
+		if err != nil {
+			repo.Close()
+		}
+	}()
 
 	// If readRef is non-empty, check out the specified commit or branch,
 	// otherwise just move using the repository's default branch as the source.
 	if readRef != "" {
 		if err = repo.Checkout(readRef); err != nil {
-			return "", errors.Wrapf(
+			return nil, nil, errors.Wrapf(
 				err,
 				"error checking out %q from git repo",
 				readRef,
@@ -269,17 +531,15 @@ func (g *gitMechanism) gitCommit(
 			repo.HomeDir(),
 			repo.WorkingDir(),
 		); err != nil {
-			return "", err
+			return nil, nil, err
 		}
 	}
-	commitMsg := buildCommitMessage(changes)
-
 	// Sometimes we don't write to the same branch we read from...
 	if readRef != writeBranch {
 		var tempDir string
 		tempDir, err = os.MkdirTemp("", "")
 		if err != nil {
-			return "", errors.Wrap(
+			return nil, nil, errors.Wrap(
 				err,
 				"error creating temp directory for pending changes",
 			)
@@ -287,19 +547,19 @@ func (g *gitMechanism) gitCommit(
 		defer os.RemoveAll(tempDir)
 
 		if err = moveRepoContents(repo.WorkingDir(), tempDir); err != nil {
-			return "", errors.Wrap(
+			return nil, nil, errors.Wrap(
 				err,
 				"error moving repository working tree to temporary location",
 			)
 		}
 
 		if err = repo.ResetHard(); err != nil {
-			return "", errors.Wrap(err, "error resetting repository working tree")
+			return nil, nil, errors.Wrap(err, "error resetting repository working tree")
 		}
 
 		var branchExists bool
 		if branchExists, err = repo.RemoteBranchExists(writeBranch); err != nil {
-			return "", errors.Wrapf(
+			return nil, nil, errors.Wrapf(
 				err,
 				"error checking for existence of branch %q in remote repo %q",
 				writeBranch,
@@ -307,7 +567,7 @@ func (g *gitMechanism) gitCommit(
 			)
 		} else if !branchExists {
 			if err = repo.CreateOrphanedBranch(writeBranch); err != nil {
-				return "", errors.Wrapf(
+				return nil, nil, errors.Wrapf(
 					err,
 					"error creating branch %q in repo %q",
 					writeBranch,
@@ -316,7 +576,7 @@ func (g *gitMechanism) gitCommit(
 			}
 		} else {
 			if err = repo.Checkout(writeBranch); err != nil {
-				return "", errors.Wrapf(
+				return nil, nil, errors.Wrapf(
 					err,
 					"error checking out branch %q from git repo %q",
 					writeBranch,
@@ -326,54 +586,19 @@ func (g *gitMechanism) gitCommit(
 		}
 
 		if err = deleteRepoContents(repo.WorkingDir()); err != nil {
-			return "",
+			return nil, nil,
 				errors.Wrap(err, "error clearing contents from repository working tree")
 		}
 
 		if err = moveRepoContents(tempDir, repo.WorkingDir()); err != nil {
-			return "", errors.Wrap(
+			return nil, nil, errors.Wrap(
 				err,
 				"error restoring repository working tree from temporary location",
 			)
 		}
 	}
 
-	hasDiffs, err := repo.HasDiffs()
-	if err != nil {
-		return "", errors.Wrapf(
-			err,
-			"error checking for diffs in git repo %q",
-			update.RepoURL,
-		)
-	}
-
-	if hasDiffs {
-		if err = repo.AddAllAndCommit(commitMsg); err != nil {
-			return "", errors.Wrapf(
-				err,
-				"error committing updates to git repo %q",
-				update.RepoURL,
-			)
-		}
-		if err = repo.Push(); err != nil {
-			return "", errors.Wrapf(
-				err,
-				"error pushing updates to git repo %q",
-				update.RepoURL,
-			)
-		}
-	}
-
-	commitID, err := repo.LastCommitID()
-	if err != nil {
-		return "", errors.Wrapf(
-			err,
-			"error getting last commit ID from git repo %q",
-			update.RepoURL,
-		)
-	}
-
-	return commitID, nil
+	return repo, changes, nil
 }
 
 // moveRepoContents transplants the entire contents of the source directory
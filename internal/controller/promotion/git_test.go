@@ -10,6 +10,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -19,6 +22,7 @@ import (
 func TestNewGitMechanism(t *testing.T) {
 	pm := newGitMechanism(
 		"fake-name",
+		fake.NewClientBuilder().Build(),
 		&credentials.FakeDB{},
 		func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
 			return nil
@@ -39,16 +43,144 @@ func TestNewGitMechanism(t *testing.T) {
 	require.NotNil(t, gpm.doSingleUpdateFn)
 	require.NotNil(t, gpm.getReadRefFn)
 	require.NotNil(t, gpm.getCredentialsFn)
+	require.NotNil(t, gpm.getProjectFn)
 	require.NotNil(t, gpm.gitCommitFn)
+	require.NotNil(t, gpm.gitDiffFn)
 	require.NotNil(t, gpm.applyConfigManagementFn)
 }
 
 func TestGitGetName(t *testing.T) {
 	const testName = "fake name"
-	pm := newGitMechanism(testName, nil, nil, nil)
+	pm := newGitMechanism(testName, nil, nil, nil, nil)
 	require.Equal(t, testName, pm.GetName())
 }
 
+func TestGitPreview(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+		},
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *gitMechanism
+		assertions func(preview Preview, err error)
+	}{
+		{
+			name: "error getting credentials",
+			promoMech: &gitMechanism{
+				selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+					return []kargoapi.GitRepoUpdate{{}}
+				},
+				getReadRefFn: getReadRef,
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+				require.Empty(t, preview.GitRepoDiffs)
+			},
+		},
+		{
+			name: "error computing diff",
+			promoMech: &gitMechanism{
+				selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+					return []kargoapi.GitRepoUpdate{{}}
+				},
+				getReadRefFn: getReadRef,
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, nil
+				},
+				gitDiffFn: func(
+					context.Context,
+					kargoapi.GitRepoUpdate,
+					kargoapi.SimpleFreight,
+					string,
+					string,
+					*git.RepoCredentials,
+				) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+				require.Empty(t, preview.GitRepoDiffs)
+			},
+		},
+		{
+			name: "success",
+			promoMech: &gitMechanism{
+				name: "fake-mechanism",
+				selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+					return []kargoapi.GitRepoUpdate{
+						{
+							RepoURL:     "fake-url",
+							ReadBranch:  "fake-read-branch",
+							WriteBranch: "fake-write-branch",
+						},
+					}
+				},
+				getReadRefFn: getReadRef,
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, nil
+				},
+				gitDiffFn: func(
+					_ context.Context,
+					_ kargoapi.GitRepoUpdate,
+					_ kargoapi.SimpleFreight,
+					readRef string,
+					writeBranch string,
+					_ *git.RepoCredentials,
+				) (string, error) {
+					return fmt.Sprintf("%s -> %s", readRef, writeBranch), nil
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]GitRepoDiff{
+						{
+							Mechanism: "fake-mechanism",
+							RepoURL:   "fake-url",
+							Branch:    "fake-write-branch",
+							Diff:      "fake-read-branch -> fake-write-branch",
+						},
+					},
+					preview.GitRepoDiffs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			preview := Preview{}
+			err := testCase.promoMech.Preview(
+				context.Background(),
+				testStage,
+				kargoapi.SimpleFreight{},
+				&preview,
+			)
+			testCase.assertions(preview, err)
+		})
+	}
+}
+
 func TestGitPromote(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -83,7 +215,7 @@ func TestGitPromote(t *testing.T) {
 				},
 				doSingleUpdateFn: func(
 					_ context.Context,
-					_ string,
+					_ *kargoapi.Stage,
 					_ kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 				) (kargoapi.SimpleFreight, error) {
@@ -108,7 +240,7 @@ func TestGitPromote(t *testing.T) {
 				},
 				doSingleUpdateFn: func(
 					_ context.Context,
-					_ string,
+					_ *kargoapi.Stage,
 					_ kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 				) (kargoapi.SimpleFreight, error) {
@@ -142,6 +274,50 @@ func TestGitPromote(t *testing.T) {
 	}
 }
 
+func TestGitPromoteCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var updated []string
+	_, err := (&gitMechanism{
+		name: "fake git mechanism",
+		selectUpdatesFn: func(
+			updates []kargoapi.GitRepoUpdate,
+		) []kargoapi.GitRepoUpdate {
+			return updates
+		},
+		doSingleUpdateFn: func(
+			_ context.Context,
+			_ *kargoapi.Stage,
+			update kargoapi.GitRepoUpdate,
+			newFreight kargoapi.SimpleFreight,
+		) (kargoapi.SimpleFreight, error) {
+			updated = append(updated, update.RepoURL)
+			cancel()
+			return newFreight, nil
+		},
+	}).Promote(
+		ctx,
+		&kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					GitRepoUpdates: []kargoapi.GitRepoUpdate{
+						{RepoURL: "https://github.com/akuity/first"},
+						{RepoURL: "https://github.com/akuity/second"},
+					},
+				},
+			},
+		},
+		kargoapi.SimpleFreight{},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Contains(
+		t,
+		err.Error(),
+		"interrupted before updating https://github.com/akuity/second",
+	)
+	require.Equal(t, []string{"https://github.com/akuity/first"}, updated)
+}
+
 func TestGitDoSingleUpdate(t *testing.T) {
 	const testRef = "fake-ref"
 	testCases := []struct {
@@ -216,12 +392,21 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				) (*git.RepoCredentials, error) {
 					return nil, nil
 				},
+				getProjectFn: func(
+					context.Context,
+					string,
+				) (*corev1.Namespace, error) {
+					return nil, nil
+				},
 				gitCommitFn: func(
+					_ context.Context,
 					update kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 					readRef string,
 					writeBranch string,
 					creds *git.RepoCredentials,
+					commitOpts gitCommitOptions,
+					step *step,
 				) (string, error) {
 					return "", errors.New("something went wrong")
 				},
@@ -252,12 +437,21 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				) (*git.RepoCredentials, error) {
 					return nil, nil
 				},
+				getProjectFn: func(
+					context.Context,
+					string,
+				) (*corev1.Namespace, error) {
+					return nil, nil
+				},
 				gitCommitFn: func(
+					_ context.Context,
 					update kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 					readRef string,
 					writeBranch string,
 					creds *git.RepoCredentials,
+					commitOpts gitCommitOptions,
+					step *step,
 				) (string, error) {
 					return "fake-commit-id", nil
 				},
@@ -286,7 +480,9 @@ func TestGitDoSingleUpdate(t *testing.T) {
 			}
 			newFreightOut, err := testCase.promoMech.doSingleUpdate(
 				context.Background(),
-				"fake-namespace",
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace"},
+				},
 				kargoapi.GitRepoUpdate{},
 				newFreightIn,
 			)
@@ -416,8 +612,10 @@ func TestGetRepoCredentials(t *testing.T) {
 					string,
 				) (credentials.Credentials, bool, error) {
 					return credentials.Credentials{
-						Username: "fake-username",
-						Password: "fake-password",
+						Username:       "fake-username",
+						Password:       "fake-password",
+						SigningKey:     "fake-signing-key",
+						SigningKeyType: "ssh",
 					}, true, nil
 				},
 			},
@@ -426,8 +624,10 @@ func TestGetRepoCredentials(t *testing.T) {
 				require.Equal(
 					t,
 					&git.RepoCredentials{
-						Username: "fake-username",
-						Password: "fake-password",
+						Username:       "fake-username",
+						Password:       "fake-password",
+						SigningKey:     "fake-signing-key",
+						SigningKeyType: git.SigningKeyTypeSSH,
 					},
 					creds,
 				)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -17,10 +18,12 @@ import (
 // newGenericGitMechanism returns a gitMechanism that only only selects and
 // performs updates that involve Helm.
 func newHelmMechanism(
+	kargoClient client.Client,
 	credentialsDB credentials.Database,
 ) Mechanism {
 	return newGitMechanism(
 		"Helm promotion mechanism",
+		kargoClient,
 		credentialsDB,
 		selectHelmUpdates,
 		(&helmer{
@@ -7,13 +7,17 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
 func TestNewHelmMechanism(t *testing.T) {
-	pm := newHelmMechanism(&credentials.FakeDB{})
+	pm := newHelmMechanism(
+		fake.NewClientBuilder().Build(),
+		&credentials.FakeDB{},
+	)
 	hpm, ok := pm.(*gitMechanism)
 	require.True(t, ok)
 	require.NotNil(t, hpm.selectUpdatesFn)
@@ -0,0 +1,369 @@
+package promotion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// defaultHookPollInterval is how often the status of a hook's Job is checked
+	// while waiting for it to complete.
+	defaultHookPollInterval = 5 * time.Second
+
+	// maxHookStageNamePrefixLength is the maximum length of the Stage name used
+	// in the prefix of the names of hook Jobs. Together with the hook's name, a
+	// separator and a five character suffix, this keeps Job names within the 63
+	// characters that Job names are limited to in practice.
+	maxHookStageNamePrefixLength = 16
+	// hookJobNameSuffixLength is the length of the suffix of the names of hook
+	// Jobs, which matches the length of the suffixes generated by the API
+	// server.
+	hookJobNameSuffixLength = 5
+)
+
+type hookPhase string
+
+const (
+	hookPhasePre  hookPhase = "pre-promotion"
+	hookPhasePost hookPhase = "post-promotion"
+)
+
+// hookMechanism is an implementation of the Mechanism interface that runs the
+// Kubernetes Jobs described by a Stage's pre- or post-promotion hooks.
+type hookMechanism struct {
+	phase        hookPhase
+	pollInterval time.Duration
+	// These behaviors are overridable for testing purposes:
+	runHookFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		hook kargoapi.PromotionHook,
+		newFreight kargoapi.SimpleFreight,
+	) error
+	createJobFn func(
+		ctx context.Context,
+		obj client.Object,
+		opts ...client.CreateOption,
+	) error
+	getJobFn func(
+		ctx context.Context,
+		key client.ObjectKey,
+		obj client.Object,
+	) error
+}
+
+// newHookMechanism returns an implementation of the Mechanism interface that
+// runs the Kubernetes Jobs described by a Stage's pre- or post-promotion hooks,
+// depending on the specified phase.
+func newHookMechanism(kargoClient client.Client, phase hookPhase) Mechanism {
+	h := &hookMechanism{
+		phase:        phase,
+		pollInterval: defaultHookPollInterval,
+	}
+	h.runHookFn = h.runHook
+	h.createJobFn = kargoClient.Create
+	h.getJobFn = func(
+		ctx context.Context,
+		key client.ObjectKey,
+		obj client.Object,
+	) error {
+		return kargoClient.Get(ctx, key, obj)
+	}
+	return h
+}
+
+// GetName implements the Mechanism interface.
+func (h *hookMechanism) GetName() string {
+	return fmt.Sprintf("%s hooks", h.phase)
+}
+
+// Promote implements the Mechanism interface.
+func (h *hookMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	newFreight kargoapi.SimpleFreight,
+) (kargoapi.SimpleFreight, error) {
+	hooks := stage.Spec.PromotionMechanisms.PreHooks
+	if h.phase == hookPhasePost {
+		hooks = stage.Spec.PromotionMechanisms.PostHooks
+	}
+
+	if len(hooks) == 0 {
+		return newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debugf("executing %s", h.GetName())
+
+	for _, hook := range hooks {
+		// Stop between hooks if the promotion has been aborted.
+		if err := ctx.Err(); err != nil {
+			return newFreight, errors.Wrapf(
+				err,
+				"%s interrupted before running hook %q",
+				h.GetName(),
+				hook.Name,
+			)
+		}
+		if err := h.runHookFn(ctx, stage, hook, newFreight); err != nil {
+			return newFreight, errors.Wrapf(
+				err,
+				"error running %s hook %q",
+				h.phase,
+				hook.Name,
+			)
+		}
+	}
+
+	logger.Debugf("done executing %s", h.GetName())
+
+	return newFreight, nil
+}
+
+// runHook creates a Job from the provided hook and waits for it to complete.
+// An error is returned if the Job fails.
+func (h *hookMechanism) runHook(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	hook kargoapi.PromotionHook,
+	newFreight kargoapi.SimpleFreight,
+) (err error) {
+	step := startStep(ctx, h.GetName(), fmt.Sprintf("hook %s", hook.Name))
+	if _, ok := step.completed(); ok {
+		return nil
+	}
+	defer func() {
+		step.conclude("", err)
+	}()
+
+	job := buildHookJob(stage, hook, newFreight, promotionFromContext(ctx))
+	var resumed bool
+	if previousJob := step.previousJob(); previousJob != "" {
+		// A previous attempt at this step was interrupted after creating its Job.
+		// Wait for that Job to complete instead of running the hook again.
+		existingJob := &batchv1.Job{}
+		if err = h.getJobFn(
+			ctx,
+			client.ObjectKey{Namespace: stage.Namespace, Name: previousJob},
+			existingJob,
+		); client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(
+				err,
+				"error getting Job %q in namespace %q",
+				previousJob,
+				stage.Namespace,
+			)
+		} else if err == nil {
+			job = existingJob
+			resumed = true
+		}
+	}
+	if !resumed {
+		err = h.createJobFn(ctx, job)
+		if apierrors.IsAlreadyExists(err) && job.Name != "" {
+			// A previous run of this attempt was interrupted after creating the Job,
+			// but before recording it. Wait for that Job to complete instead of
+			// running the hook again.
+			existingJob := &batchv1.Job{}
+			if err = h.getJobFn(ctx, client.ObjectKeyFromObject(job), existingJob); err != nil {
+				return errors.Wrapf(
+					err,
+					"error getting Job %q in namespace %q",
+					job.Name,
+					job.Namespace,
+				)
+			}
+			promoName := job.Annotations[kargoapi.AnnotationKeyPromotion]
+			if existingJob.Annotations[kargoapi.AnnotationKeyPromotion] != promoName {
+				return errors.Errorf(
+					"Job %q in namespace %q already exists and was not created for "+
+						"Promotion %q",
+					job.Name,
+					job.Namespace,
+					promoName,
+				)
+			}
+			job = existingJob
+		} else if err != nil {
+			return errors.Wrapf(
+				err,
+				"error creating Job for hook in namespace %q",
+				stage.Namespace,
+			)
+		}
+	}
+	step.setJob(job.Name)
+
+	logger := logging.LoggerFromContext(ctx).WithField("job", job.Name)
+	logger.Debug("waiting for hook Job to complete")
+
+	key := client.ObjectKeyFromObject(job)
+	for {
+		if err = h.getJobFn(ctx, key, job); err != nil {
+			return errors.Wrapf(
+				err,
+				"error getting Job %q in namespace %q",
+				job.Name,
+				job.Namespace,
+			)
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case batchv1.JobComplete:
+				logger.Debug("hook Job completed")
+				return nil
+			case batchv1.JobFailed:
+				return errors.Errorf(
+					"Job %q in namespace %q failed: %s: %s",
+					job.Name,
+					job.Namespace,
+					cond.Reason,
+					cond.Message,
+				)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(
+				ctx.Err(),
+				"stopped waiting for Job %q in namespace %q to complete",
+				job.Name,
+				job.Namespace,
+			)
+		case <-time.After(h.pollInterval):
+		}
+	}
+}
+
+// buildHookJob returns a Job built from the provided hook's template. The Job
+// is created in the Stage's namespace, is owned by the Stage, and has details
+// of the provided Freight added to the environment of all of its containers.
+// If a Promotion is provided, the Job's name is derived from the Promotion, the
+// hook and the Promotion's current attempt, so that an interrupted attempt can
+// find a Job it created even if it never recorded the Job's name. Otherwise,
+// the API server generates a unique name for the Job.
+func buildHookJob(
+	stage *kargoapi.Stage,
+	hook kargoapi.PromotionHook,
+	newFreight kargoapi.SimpleFreight,
+	promo *kargoapi.Promotion,
+) *batchv1.Job {
+	template := hook.Template.DeepCopy()
+	stagePrefix := stage.Name
+	if len(stagePrefix) > maxHookStageNamePrefixLength {
+		stagePrefix = strings.TrimRight(
+			stagePrefix[:maxHookStageNamePrefixLength],
+			"-.",
+		)
+	}
+	job := &batchv1.Job{
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	job.Name = ""
+	job.GenerateName = fmt.Sprintf("%s-%s-", stagePrefix, hook.Name)
+	if promo != nil {
+		job.Name = job.GenerateName + hookJobNameSuffix(promo, hook)
+		job.GenerateName = ""
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		job.Annotations[kargoapi.AnnotationKeyPromotion] = promo.Name
+	}
+	job.Namespace = stage.Namespace
+	job.OwnerReferences = append(
+		job.OwnerReferences,
+		metav1.OwnerReference{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Stage",
+			Name:       stage.Name,
+			UID:        stage.UID,
+		},
+	)
+	podSpec := &job.Spec.Template.Spec
+	if podSpec.RestartPolicy == "" {
+		// The API server's default of Always is not permitted for Jobs
+		podSpec.RestartPolicy = corev1.RestartPolicyNever
+	}
+	// Freight details are added ahead of any variables defined by the template
+	// so that the template's own definitions take precedence
+	env := getHookEnv(stage, newFreight)
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].Env =
+			append(append([]corev1.EnvVar{}, env...), podSpec.InitContainers[i].Env...)
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Env =
+			append(append([]corev1.EnvVar{}, env...), podSpec.Containers[i].Env...)
+	}
+	return job
+}
+
+// hookJobNameSuffix returns a suffix for the name of the Job created by the
+// provided hook for the current attempt at the provided Promotion.
+func hookJobNameSuffix(
+	promo *kargoapi.Promotion,
+	hook kargoapi.PromotionHook,
+) string {
+	sum := sha256.Sum256(
+		[]byte(fmt.Sprintf("%s/%s/%d", promo.Name, hook.Name, promo.Status.Attempts)),
+	)
+	return strings.ToLower(
+		base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sum[:]),
+	)[:hookJobNameSuffixLength]
+}
+
+// getHookEnv returns environment variables describing the provided Stage and
+// Freight. Commits, images, and charts are numbered in the order in which they
+// appear in the Freight, starting from zero.
+func getHookEnv(
+	stage *kargoapi.Stage,
+	newFreight kargoapi.SimpleFreight,
+) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: "KARGO_PROJECT", Value: stage.Namespace},
+		{Name: "KARGO_STAGE", Value: stage.Name},
+		{Name: "KARGO_FREIGHT", Value: newFreight.ID},
+	}
+	for i, commit := range newFreight.Commits {
+		env = append(
+			env,
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_COMMIT_%d_REPO_URL", i), Value: commit.RepoURL},
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_COMMIT_%d_ID", i), Value: commit.ID},
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_COMMIT_%d_BRANCH", i), Value: commit.Branch},
+		)
+	}
+	for i, image := range newFreight.Images {
+		env = append(
+			env,
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_IMAGE_%d_REPO_URL", i), Value: image.RepoURL},
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_IMAGE_%d_TAG", i), Value: image.Tag},
+		)
+	}
+	for i, chart := range newFreight.Charts {
+		env = append(
+			env,
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_CHART_%d_REGISTRY_URL", i), Value: chart.RegistryURL},
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_CHART_%d_NAME", i), Value: chart.Name},
+			corev1.EnvVar{Name: fmt.Sprintf("KARGO_CHART_%d_VERSION", i), Value: chart.Version},
+		)
+	}
+	return env
+}
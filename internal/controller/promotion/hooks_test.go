@@ -0,0 +1,632 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewHookMechanism(t *testing.T) {
+	pm := newHookMechanism(fake.NewClientBuilder().Build(), hookPhasePre)
+	hpm, ok := pm.(*hookMechanism)
+	require.True(t, ok)
+	require.Equal(t, hookPhasePre, hpm.phase)
+	require.Equal(t, defaultHookPollInterval, hpm.pollInterval)
+	require.NotNil(t, hpm.runHookFn)
+	require.NotNil(t, hpm.createJobFn)
+	require.NotNil(t, hpm.getJobFn)
+}
+
+func TestHookGetName(t *testing.T) {
+	require.Equal(
+		t,
+		"post-promotion hooks",
+		(&hookMechanism{phase: hookPhasePost}).GetName(),
+	)
+}
+
+func TestHookPromote(t *testing.T) {
+	stage := &kargoapi.Stage{
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				PreHooks: []kargoapi.PromotionHook{
+					{Name: "pre-1"},
+					{Name: "pre-2"},
+				},
+				PostHooks: []kargoapi.PromotionHook{
+					{Name: "post"},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		phase      hookPhase
+		stage      *kargoapi.Stage
+		runHookErr error
+		assertions func(ran []string, err error)
+	}{
+		{
+			name:  "no hooks",
+			phase: hookPhasePre,
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(ran []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, ran)
+			},
+		},
+		{
+			name:  "pre-promotion hooks run in order",
+			phase: hookPhasePre,
+			stage: stage,
+			assertions: func(ran []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"pre-1", "pre-2"}, ran)
+			},
+		},
+		{
+			name:  "post-promotion hooks run",
+			phase: hookPhasePost,
+			stage: stage,
+			assertions: func(ran []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"post"}, ran)
+			},
+		},
+		{
+			name:       "hook fails",
+			phase:      hookPhasePre,
+			stage:      stage,
+			runHookErr: errors.New("something went wrong"),
+			assertions: func(ran []string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					`error running pre-promotion hook "pre-1"`,
+				)
+				require.Contains(t, err.Error(), "something went wrong")
+				// The second hook is never run
+				require.Equal(t, []string{"pre-1"}, ran)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var ran []string
+			h := &hookMechanism{
+				phase: testCase.phase,
+				runHookFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					hook kargoapi.PromotionHook,
+					_ kargoapi.SimpleFreight,
+				) error {
+					ran = append(ran, hook.Name)
+					return testCase.runHookErr
+				},
+			}
+			newFreight := kargoapi.SimpleFreight{ID: "fake-freight"}
+			newFreightOut, err := h.Promote(
+				context.Background(),
+				testCase.stage,
+				newFreight,
+			)
+			require.Equal(t, newFreight, newFreightOut)
+			testCase.assertions(ran, err)
+		})
+	}
+}
+
+func TestHookPromoteCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var ran []string
+	_, err := (&hookMechanism{
+		phase: hookPhasePre,
+		runHookFn: func(
+			_ context.Context,
+			_ *kargoapi.Stage,
+			hook kargoapi.PromotionHook,
+			_ kargoapi.SimpleFreight,
+		) error {
+			ran = append(ran, hook.Name)
+			cancel()
+			return nil
+		},
+	}).Promote(
+		ctx,
+		&kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					PreHooks: []kargoapi.PromotionHook{
+						{Name: "pre-1"},
+						{Name: "pre-2"},
+					},
+				},
+			},
+		},
+		kargoapi.SimpleFreight{},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Contains(t, err.Error(), `interrupted before running hook "pre-2"`)
+	require.Equal(t, []string{"pre-1"}, ran)
+}
+
+func TestHookRunHook(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	hook := kargoapi.PromotionHook{
+		Name: "migrate",
+		Template: batchv1.JobTemplateSpec{
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "migrate"}},
+					},
+				},
+			},
+		},
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		},
+		Status: kargoapi.PromotionStatus{
+			Attempts: 1,
+		},
+	}
+	promoJobName := "fake-stage-migrate-" + hookJobNameSuffix(promo, hook)
+	// setJobCondition returns a function suitable for use as a getJobFn that
+	// reports the provided condition once the Job has been polled the provided
+	// number of times.
+	setJobCondition := func(
+		c client.Client,
+		polls int,
+		cond batchv1.JobConditionType,
+	) func(context.Context, client.ObjectKey, client.Object) error {
+		var count int
+		return func(
+			ctx context.Context,
+			key client.ObjectKey,
+			obj client.Object,
+		) error {
+			if err := c.Get(ctx, key, obj); err != nil {
+				return err
+			}
+			if count++; count >= polls {
+				job := obj.(*batchv1.Job) // nolint: forcetypeassert
+				job.Status.Conditions = []batchv1.JobCondition{{
+					Type:    cond,
+					Status:  corev1.ConditionTrue,
+					Reason:  "FakeReason",
+					Message: "fake message",
+				}}
+			}
+			return nil
+		}
+	}
+	// interruptedStep returns the result of an attempt at running the hook that
+	// was interrupted after creating the named Job.
+	interruptedStep := func(job string) []kargoapi.PromotionStepResult {
+		return []kargoapi.PromotionStepResult{{
+			Mechanism: "pre-promotion hooks",
+			Target:    "hook migrate",
+			Outcome:   kargoapi.PromotionStepOutcomeRunning,
+			Job:       job,
+		}}
+	}
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		promo      *kargoapi.Promotion
+		previous   []kargoapi.PromotionStepResult
+		getJobFn   func(client.Client) func(context.Context, client.ObjectKey, client.Object) error
+		ctx        func() (context.Context, context.CancelFunc)
+		assertions func(c client.Client, results []kargoapi.PromotionStepResult, err error)
+	}{
+		{
+			name: "Job completes",
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 2, batchv1.JobComplete)
+			},
+			assertions: func(c client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.NoError(t, err)
+				jobs := batchv1.JobList{}
+				require.NoError(t, c.List(context.Background(), &jobs))
+				require.Len(t, jobs.Items, 1)
+				job := jobs.Items[0]
+				require.Equal(t, "fake-namespace", job.Namespace)
+				require.Equal(t, "fake-stage-migrate-", job.GenerateName)
+				require.Len(t, results, 1)
+				require.Equal(t, job.Name, results[0].Job)
+				require.Equal(t, "hook migrate", results[0].Target)
+				require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, results[0].Outcome)
+			},
+		},
+		{
+			name: "previously completed",
+			previous: []kargoapi.PromotionStepResult{{
+				Mechanism: "pre-promotion hooks",
+				Target:    "hook migrate",
+				Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+				Job:       "fake-job",
+			}},
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 1, batchv1.JobFailed)
+			},
+			assertions: func(c client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.NoError(t, err)
+				jobs := batchv1.JobList{}
+				require.NoError(t, c.List(context.Background(), &jobs))
+				require.Empty(t, jobs.Items)
+				require.Len(t, results, 1)
+				require.Equal(t, "fake-job", results[0].Job)
+			},
+		},
+		{
+			name: "resumes waiting for previous Job",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-job",
+					},
+				},
+			},
+			previous: interruptedStep("fake-job"),
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 2, batchv1.JobComplete)
+			},
+			assertions: func(c client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.NoError(t, err)
+				jobs := batchv1.JobList{}
+				require.NoError(t, c.List(context.Background(), &jobs))
+				require.Len(t, jobs.Items, 1)
+				require.Len(t, results, 1)
+				require.Equal(t, "fake-job", results[0].Job)
+				require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, results[0].Outcome)
+			},
+		},
+		{
+			name:     "previous Job no longer exists",
+			previous: interruptedStep("fake-job"),
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 1, batchv1.JobComplete)
+			},
+			assertions: func(c client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.NoError(t, err)
+				jobs := batchv1.JobList{}
+				require.NoError(t, c.List(context.Background(), &jobs))
+				require.Len(t, jobs.Items, 1)
+				require.Equal(t, "fake-stage-migrate-", jobs.Items[0].GenerateName)
+				require.Len(t, results, 1)
+				require.Equal(t, jobs.Items[0].Name, results[0].Job)
+			},
+		},
+		{
+			name:  "Job created for Promotion",
+			promo: promo,
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 1, batchv1.JobComplete)
+			},
+			assertions: func(c client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.NoError(t, err)
+				jobs := batchv1.JobList{}
+				require.NoError(t, c.List(context.Background(), &jobs))
+				require.Len(t, jobs.Items, 1)
+				require.Equal(t, promoJobName, jobs.Items[0].Name)
+				require.Equal(
+					t,
+					"fake-promotion",
+					jobs.Items[0].Annotations[kargoapi.AnnotationKeyPromotion],
+				)
+				require.Len(t, results, 1)
+				require.Equal(t, promoJobName, results[0].Job)
+			},
+		},
+		{
+			name: "resumes waiting for unrecorded Job",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      promoJobName,
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyPromotion: "fake-promotion",
+						},
+					},
+				},
+			},
+			promo: promo,
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 2, batchv1.JobComplete)
+			},
+			assertions: func(c client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.NoError(t, err)
+				jobs := batchv1.JobList{}
+				require.NoError(t, c.List(context.Background(), &jobs))
+				require.Len(t, jobs.Items, 1)
+				require.Len(t, results, 1)
+				require.Equal(t, promoJobName, results[0].Job)
+				require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, results[0].Outcome)
+			},
+		},
+		{
+			name: "Job with same name created for something else",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      promoJobName,
+					},
+				},
+			},
+			promo: promo,
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 1, batchv1.JobComplete)
+			},
+			assertions: func(_ client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "already exists and was not created for")
+				require.Len(t, results, 1)
+				require.Equal(t, kargoapi.PromotionStepOutcomeErrored, results[0].Outcome)
+			},
+		},
+		{
+			name: "Job fails",
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 1, batchv1.JobFailed)
+			},
+			assertions: func(_ client.Client, results []kargoapi.PromotionStepResult, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed: FakeReason: fake message")
+				require.Len(t, results, 1)
+				require.NotEmpty(t, results[0].Job)
+				require.Equal(t, kargoapi.PromotionStepOutcomeErrored, results[0].Outcome)
+			},
+		},
+		{
+			name: "error getting Job",
+			getJobFn: func(client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return func(context.Context, client.ObjectKey, client.Object) error {
+					return errors.New("something went wrong")
+				}
+			},
+			assertions: func(_ client.Client, _ []kargoapi.PromotionStepResult, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting Job")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "context canceled while waiting",
+			getJobFn: func(c client.Client) func(context.Context, client.ObjectKey, client.Object) error {
+				return setJobCondition(c, 1000, batchv1.JobComplete)
+			},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+			assertions: func(_ client.Client, _ []kargoapi.PromotionStepResult, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "stopped waiting for Job")
+				require.True(t, errors.Is(err, context.DeadlineExceeded))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, batchv1.AddToScheme(scheme))
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			h := &hookMechanism{
+				phase:        hookPhasePre,
+				pollInterval: time.Millisecond,
+				createJobFn:  c.Create,
+				getJobFn:     testCase.getJobFn(c),
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if testCase.ctx != nil {
+				ctx, cancel = testCase.ctx()
+			}
+			defer cancel()
+			if testCase.promo != nil {
+				ctx = ContextWithPromotion(ctx, testCase.promo)
+			}
+			recorder := NewStepRecorder(testCase.previous, nil)
+			err := h.runHook(
+				ContextWithStepRecorder(ctx, recorder),
+				stage,
+				hook,
+				kargoapi.SimpleFreight{ID: "fake-freight"},
+			)
+			testCase.assertions(c, recorder.Results(), err)
+		})
+	}
+}
+
+func TestBuildHookJob(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "a-very-long-stage-name",
+			UID:       "fake-uid",
+		},
+	}
+	hook := kargoapi.PromotionHook{
+		Name: "migrate",
+		Template: batchv1.JobTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "ignored",
+				Labels: map[string]string{"app": "migrations"},
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						InitContainers: []corev1.Container{{Name: "init"}},
+						Containers: []corev1.Container{{
+							Name: "migrate",
+							Env: []corev1.EnvVar{
+								{Name: "KARGO_STAGE", Value: "overridden"},
+							},
+						}},
+					},
+				},
+			},
+		},
+	}
+	job := buildHookJob(
+		stage,
+		hook,
+		kargoapi.SimpleFreight{
+			ID:     "fake-freight",
+			Images: []kargoapi.Image{{RepoURL: "fake-image", Tag: "v1.0.0"}},
+		},
+		nil,
+	)
+	require.Empty(t, job.Name)
+	require.Equal(t, "a-very-long-stag-migrate-", job.GenerateName)
+	require.Equal(t, "fake-namespace", job.Namespace)
+	require.Equal(t, map[string]string{"app": "migrations"}, job.Labels)
+	require.Equal(
+		t,
+		[]metav1.OwnerReference{{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Stage",
+			Name:       "a-very-long-stage-name",
+			UID:        "fake-uid",
+		}},
+		job.OwnerReferences,
+	)
+	podSpec := job.Spec.Template.Spec
+	require.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+	expectedEnv := []corev1.EnvVar{
+		{Name: "KARGO_PROJECT", Value: "fake-namespace"},
+		{Name: "KARGO_STAGE", Value: "a-very-long-stage-name"},
+		{Name: "KARGO_FREIGHT", Value: "fake-freight"},
+		{Name: "KARGO_IMAGE_0_REPO_URL", Value: "fake-image"},
+		{Name: "KARGO_IMAGE_0_TAG", Value: "v1.0.0"},
+	}
+	require.Equal(t, expectedEnv, podSpec.InitContainers[0].Env)
+	// The template's own variables come last so that they take precedence
+	require.Equal(
+		t,
+		append(expectedEnv, corev1.EnvVar{Name: "KARGO_STAGE", Value: "overridden"}),
+		podSpec.Containers[0].Env,
+	)
+	// The hook's template is left unmodified
+	require.Empty(t, hook.Template.Spec.Template.Spec.RestartPolicy)
+	require.Len(t, hook.Template.Spec.Template.Spec.Containers[0].Env, 1)
+}
+
+func TestBuildHookJobForPromotion(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	hook := kargoapi.PromotionHook{Name: "migrate"}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promotion",
+		},
+		Status: kargoapi.PromotionStatus{
+			Attempts: 1,
+		},
+	}
+	job := buildHookJob(stage, hook, kargoapi.SimpleFreight{}, promo)
+	require.Empty(t, job.GenerateName)
+	require.Regexp(t, "^fake-stage-migrate-[a-z2-7]{5}$", job.Name)
+	require.Equal(
+		t,
+		"fake-promotion",
+		job.Annotations[kargoapi.AnnotationKeyPromotion],
+	)
+	// The name is the same every time the same attempt runs the hook
+	require.Equal(
+		t,
+		job.Name,
+		buildHookJob(stage, hook, kargoapi.SimpleFreight{}, promo).Name,
+	)
+	// Another attempt runs the hook in a new Job
+	promo.Status.Attempts++
+	require.NotEqual(
+		t,
+		job.Name,
+		buildHookJob(stage, hook, kargoapi.SimpleFreight{}, promo).Name,
+	)
+	// The hook's template is left unmodified
+	require.Empty(t, hook.Template.Annotations)
+}
+
+func TestGetHookEnv(t *testing.T) {
+	env := getHookEnv(
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+		},
+		kargoapi.SimpleFreight{
+			ID: "fake-freight",
+			Commits: []kargoapi.GitCommit{{
+				RepoURL: "fake-repo",
+				ID:      "fake-commit",
+				Branch:  "main",
+			}},
+			Images: []kargoapi.Image{
+				{RepoURL: "fake-image-1", Tag: "v1.0.0"},
+				{RepoURL: "fake-image-2", Tag: "v2.0.0"},
+			},
+			Charts: []kargoapi.Chart{{
+				RegistryURL: "fake-registry",
+				Name:        "fake-chart",
+				Version:     "1.2.3",
+			}},
+		},
+	)
+	require.Equal(
+		t,
+		[]corev1.EnvVar{
+			{Name: "KARGO_PROJECT", Value: "fake-namespace"},
+			{Name: "KARGO_STAGE", Value: "fake-stage"},
+			{Name: "KARGO_FREIGHT", Value: "fake-freight"},
+			{Name: "KARGO_COMMIT_0_REPO_URL", Value: "fake-repo"},
+			{Name: "KARGO_COMMIT_0_ID", Value: "fake-commit"},
+			{Name: "KARGO_COMMIT_0_BRANCH", Value: "main"},
+			{Name: "KARGO_IMAGE_0_REPO_URL", Value: "fake-image-1"},
+			{Name: "KARGO_IMAGE_0_TAG", Value: "v1.0.0"},
+			{Name: "KARGO_IMAGE_1_REPO_URL", Value: "fake-image-2"},
+			{Name: "KARGO_IMAGE_1_TAG", Value: "v2.0.0"},
+			{Name: "KARGO_CHART_0_REGISTRY_URL", Value: "fake-registry"},
+			{Name: "KARGO_CHART_0_NAME", Value: "fake-chart"},
+			{Name: "KARGO_CHART_0_VERSION", Value: "1.2.3"},
+		},
+		env,
+	)
+}
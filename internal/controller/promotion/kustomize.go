@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -14,10 +15,12 @@ import (
 // newKustomizeMechanism returns a gitMechanism that only only selects and
 // performs updates that involve Kustomize.
 func newKustomizeMechanism(
+	kargoClient client.Client,
 	credentialsDB credentials.Database,
 ) Mechanism {
 	return newGitMechanism(
 		"Kustomize promotion mechanism",
+		kargoClient,
 		credentialsDB,
 		selectKustomizeUpdates,
 		(&kustomizer{
@@ -5,13 +5,17 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
 func TestNewKustomizeMechanism(t *testing.T) {
-	pm := newKustomizeMechanism(&credentials.FakeDB{})
+	pm := newKustomizeMechanism(
+		fake.NewClientBuilder().Build(),
+		&credentials.FakeDB{},
+	)
 	kpm, ok := pm.(*gitMechanism)
 	require.True(t, ok)
 	require.NotNil(t, kpm.selectUpdatesFn)
@@ -0,0 +1,59 @@
+package promotion
+
+import (
+	"context"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// Previewer is implemented by promotion mechanisms that are able to describe
+// the changes they would make when promoting a Stage to a piece of Freight,
+// without actually making them.
+type Previewer interface {
+	// Preview consults rules in the provided Stage to determine what changes
+	// transitioning into the specified Freight would entail and records them in
+	// the provided Preview.
+	Preview(
+		context.Context,
+		*kargoapi.Stage,
+		kargoapi.SimpleFreight,
+		*Preview,
+	) error
+}
+
+// Preview describes the changes a promotion would make.
+type Preview struct {
+	// GitRepoDiffs describes the changes that would be committed to Git
+	// repositories.
+	GitRepoDiffs []GitRepoDiff
+	// ArgoCDAppPatches describes the changes that would be made to the sources
+	// of Argo CD Applications.
+	ArgoCDAppPatches []ArgoCDAppPatch
+}
+
+// GitRepoDiff describes the changes a promotion mechanism would commit to a
+// single Git repository.
+type GitRepoDiff struct {
+	// Mechanism is the name of the promotion mechanism that would make the
+	// changes.
+	Mechanism string
+	// RepoURL is the URL of the repository.
+	RepoURL string
+	// Branch is the branch the changes would be committed to. If empty, changes
+	// would be committed to the branch they were read from.
+	Branch string
+	// Diff is a unified diff of the changes. It is empty if there would be no
+	// changes.
+	Diff string
+}
+
+// ArgoCDAppPatch describes the changes a promotion would make to the sources of
+// a single Argo CD Application.
+type ArgoCDAppPatch struct {
+	// Namespace is the namespace of the Application.
+	Namespace string
+	// Name is the name of the Application.
+	Name string
+	// Patch is a JSON merge patch of the Application's spec.
+	Patch string
+}
@@ -3,6 +3,8 @@ package promotion
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/pkg/errors"
 
@@ -35,6 +37,19 @@ type kargoRenderMechanism struct {
 		repo string,
 	) (credentials.Credentials, bool, error)
 	renderManifestsFn func(render.Request) (render.Response, error)
+	hasCommitFn       func(
+		ctx context.Context,
+		update kargoapi.GitRepoUpdate,
+		creds git.RepoCredentials,
+		commitID string,
+	) (bool, error)
+	previewSingleUpdateFn func(
+		ctx context.Context,
+		namespace string,
+		update kargoapi.GitRepoUpdate,
+		newFreight kargoapi.SimpleFreight,
+		images []string,
+	) (string, error)
 }
 
 // newKargoRenderMechanism returns an implementation of the Mechanism interface
@@ -48,6 +63,8 @@ func newKargoRenderMechanism(
 	b.getCredentialsFn = credentialsDB.Get
 	// TODO: KR: Refactor this
 	b.renderManifestsFn = render.RenderManifests
+	b.hasCommitFn = hasWriteBranchCommit
+	b.previewSingleUpdateFn = b.previewSingleUpdate
 	return b
 }
 
@@ -62,12 +79,9 @@ func (b *kargoRenderMechanism) Promote(
 	stage *kargoapi.Stage,
 	newFreight kargoapi.SimpleFreight,
 ) (kargoapi.SimpleFreight, error) {
-	updates := make([]kargoapi.GitRepoUpdate, 0, len(stage.Spec.PromotionMechanisms.GitRepoUpdates))
-	for _, update := range stage.Spec.PromotionMechanisms.GitRepoUpdates {
-		if update.Render != nil {
-			updates = append(updates, update)
-		}
-	}
+	updates := selectKargoRenderUpdates(
+		stage.Spec.PromotionMechanisms.GitRepoUpdates,
+	)
 
 	if len(updates) == 0 {
 		return newFreight, nil
@@ -78,12 +92,18 @@ func (b *kargoRenderMechanism) Promote(
 	logger := logging.LoggerFromContext(ctx)
 	logger.Debug("executing Kargo Render-based promotion mechanisms")
 
-	images := make([]string, len(newFreight.Images))
-	for i, image := range newFreight.Images {
-		images[i] = fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
-	}
+	images := kargoRenderImages(newFreight)
 
 	for _, update := range updates {
+		// Stop between updates if the promotion has been aborted.
+		if err := ctx.Err(); err != nil {
+			return newFreight, errors.Wrapf(
+				err,
+				"%s interrupted before updating %s",
+				b.GetName(),
+				gitUpdateTarget(update),
+			)
+		}
 		var err error
 		if newFreight, err = b.doSingleUpdateFn(
 			ctx,
@@ -101,6 +121,65 @@ func (b *kargoRenderMechanism) Promote(
 	return newFreight, nil
 }
 
+// Preview implements the Previewer interface.
+func (b *kargoRenderMechanism) Preview(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	newFreight kargoapi.SimpleFreight,
+	preview *Preview,
+) error {
+	updates := selectKargoRenderUpdates(
+		stage.Spec.PromotionMechanisms.GitRepoUpdates,
+	)
+	images := kargoRenderImages(newFreight)
+	for _, update := range updates {
+		diff, err := b.previewSingleUpdateFn(
+			ctx,
+			stage.Namespace,
+			update,
+			newFreight,
+			images,
+		)
+		if err != nil {
+			return err
+		}
+		preview.GitRepoDiffs = append(
+			preview.GitRepoDiffs,
+			GitRepoDiff{
+				Mechanism: b.GetName(),
+				RepoURL:   update.RepoURL,
+				Branch:    update.WriteBranch,
+				Diff:      diff,
+			},
+		)
+	}
+	return nil
+}
+
+// selectKargoRenderUpdates returns the subset of the given updates that are to
+// be carried out using Kargo Render.
+func selectKargoRenderUpdates(
+	updates []kargoapi.GitRepoUpdate,
+) []kargoapi.GitRepoUpdate {
+	selectedUpdates := make([]kargoapi.GitRepoUpdate, 0, len(updates))
+	for _, update := range updates {
+		if update.Render != nil {
+			selectedUpdates = append(selectedUpdates, update)
+		}
+	}
+	return selectedUpdates
+}
+
+// kargoRenderImages returns the images referenced by the provided Freight in
+// the format expected by Kargo Render.
+func kargoRenderImages(freight kargoapi.SimpleFreight) []string {
+	images := make([]string, len(freight.Images))
+	for i, image := range freight.Images {
+		images[i] = fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
+	}
+	return images
+}
+
 // doSingleUpdateFn updates configuration in a single Git repository using
 // Kargo Render.
 func (b *kargoRenderMechanism) doSingleUpdate(
@@ -109,35 +188,52 @@ func (b *kargoRenderMechanism) doSingleUpdate(
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.SimpleFreight,
 	images []string,
-) (kargoapi.SimpleFreight, error) {
+) (_ kargoapi.SimpleFreight, err error) {
 	logger := logging.LoggerFromContext(ctx).WithField("repo", update.RepoURL)
+	step := startStep(ctx, b.GetName(), gitUpdateTarget(update))
 
 	readRef, commitIndex, err := b.getReadRefFn(update, newFreight.Commits)
 	if err != nil {
+		step.conclude("", err)
 		return newFreight, err
 	}
 
-	creds, ok, err := b.getCredentialsFn(
-		ctx,
-		namespace,
-		credentials.TypeGit,
-		update.RepoURL,
-	)
+	if previous, ok := step.completed(); ok {
+		if commitIndex > -1 {
+			newFreight.Commits[commitIndex].HealthCheckCommit = previous.Commit
+		}
+		return newFreight, nil
+	}
+
+	var commitID string
+	defer func() {
+		step.conclude(commitID, err)
+	}()
+
+	repoCreds, err := b.getRepoCredentials(ctx, namespace, update.RepoURL)
 	if err != nil {
-		return newFreight, errors.Wrapf(
-			err,
-			"error obtaining credentials for git repo %q",
-			update.RepoURL,
-		)
+		return newFreight, err
 	}
-	repoCreds := git.RepoCredentials{}
-	if ok {
-		repoCreds.Username = creds.Username
-		repoCreds.Password = creds.Password
-		repoCreds.SSHPrivateKey = creds.SSHPrivateKey
-		logger.Debug("obtained credentials for git repo")
-	} else {
-		logger.Debug("found no credentials for git repo")
+
+	if previousCommit := step.previousCommit(); previousCommit != "" {
+		pushed, err := b.hasCommitFn(ctx, update, repoCreds, previousCommit)
+		if err != nil {
+			return newFreight, errors.Wrapf(
+				err,
+				"error checking for commit %q in git repo %q",
+				previousCommit,
+				update.RepoURL,
+			)
+		}
+		if pushed {
+			logger.WithField("commit", previousCommit).
+				Debug("commit pushed by a previous attempt found in repo")
+			commitID = previousCommit
+			if commitIndex > -1 {
+				newFreight.Commits[commitIndex].HealthCheckCommit = commitID
+			}
+			return newFreight, nil
+		}
 	}
 
 	req := render.Request{
@@ -156,21 +252,213 @@ func (b *kargoRenderMechanism) doSingleUpdate(
 			update.RepoURL,
 		)
 	}
+	if res.CommitID != "" {
+		// Record the commit as soon as it is known so that, if this attempt is
+		// interrupted before the step concludes, a subsequent attempt can find
+		// it in the repository instead of rendering the manifests again.
+		step.setCommit(res.CommitID)
+	}
 	switch res.ActionTaken {
 	case render.ActionTakenPushedDirectly:
 		logger.WithField("commit", res.CommitID).
 			Debug("pushed new commit to repo via Kargo Render")
+		commitID = res.CommitID
 		if commitIndex > -1 {
 			newFreight.Commits[commitIndex].HealthCheckCommit = res.CommitID
 		}
 	case render.ActionTakenNone:
 		logger.Debug("Kargo Render made no changes to repo")
+		commitID = res.CommitID
 		if commitIndex > -1 {
 			newFreight.Commits[commitIndex].HealthCheckCommit = res.CommitID
 		}
-	default:
-		// TODO: Not sure yet how to handle PRs.
+	case render.ActionTakenOpenedPR, render.ActionTakenUpdatedPR:
+		// Changes that await review have not landed in the write branch, so
+		// there is no commit for health checks to wait on. The pull request is
+		// recorded instead so users can find it.
+		logger.WithField("pullRequest", res.PullRequestURL).
+			Debug("Kargo Render opened or updated a pull request")
+		step.setPullRequest(res.PullRequestURL)
 	}
 
 	return newFreight, nil
 }
+
+// hasWriteBranchCommit clones the Git repository referenced by the provided
+// update and returns a bool indicating whether the specified commit is
+// reachable from the head of its write branch.
+func hasWriteBranchCommit(
+	ctx context.Context,
+	update kargoapi.GitRepoUpdate,
+	creds git.RepoCredentials,
+	commitID string,
+) (bool, error) {
+	repo, err := git.Clone(ctx, update.RepoURL, creds, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "error cloning git repo %q", update.RepoURL)
+	}
+	defer repo.Close()
+	branchExists, err := repo.RemoteBranchExists(update.WriteBranch)
+	if err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error checking for existence of branch %q in remote repo %q",
+			update.WriteBranch,
+			update.RepoURL,
+		)
+	}
+	if !branchExists {
+		return false, nil
+	}
+	if err = repo.Checkout(update.WriteBranch); err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error checking out branch %q from git repo %q",
+			update.WriteBranch,
+			update.RepoURL,
+		)
+	}
+	return repo.HasCommit(commitID)
+}
+
+// previewSingleUpdate uses Kargo Render to render manifests for a single Git
+// repository into a local directory, then returns a unified diff between those
+// manifests and the current contents of the target branch.
+func (b *kargoRenderMechanism) previewSingleUpdate(
+	ctx context.Context,
+	namespace string,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	images []string,
+) (string, error) {
+	readRef, _, err := b.getReadRefFn(update, newFreight.Commits)
+	if err != nil {
+		return "", err
+	}
+
+	repoCreds, err := b.getRepoCredentials(ctx, namespace, update.RepoURL)
+	if err != nil {
+		return "", err
+	}
+
+	tempDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", errors.Wrap(err, "error creating temp directory for manifests")
+	}
+	defer os.RemoveAll(tempDir)
+	outDir := filepath.Join(tempDir, "out")
+
+	if _, err = b.renderManifestsFn(render.Request{
+		RepoURL:      update.RepoURL,
+		RepoCreds:    repoCreds,
+		Ref:          readRef,
+		Images:       images,
+		TargetBranch: update.WriteBranch,
+		LocalOutPath: outDir,
+	}); err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error rendering manifests for git repo %q via Kargo Render",
+			update.RepoURL,
+		)
+	}
+
+	repo, err := git.Clone(ctx, update.RepoURL, repoCreds, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "error cloning git repo %q", update.RepoURL)
+	}
+	defer repo.Close()
+
+	branchExists, err := repo.RemoteBranchExists(update.WriteBranch)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error checking for existence of branch %q in remote repo %q",
+			update.WriteBranch,
+			update.RepoURL,
+		)
+	}
+	if branchExists {
+		err = repo.Checkout(update.WriteBranch)
+	} else {
+		err = repo.CreateOrphanedBranch(update.WriteBranch)
+	}
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error switching to branch %q in git repo %q",
+			update.WriteBranch,
+			update.RepoURL,
+		)
+	}
+
+	if err = deleteRepoContents(repo.WorkingDir()); err != nil {
+		return "",
+			errors.Wrap(err, "error clearing contents from repository working tree")
+	}
+	if err = moveRepoContents(outDir, repo.WorkingDir()); err != nil {
+		return "", errors.Wrap(
+			err,
+			"error moving rendered manifests into repository working tree",
+		)
+	}
+	if err = repo.AddAll(); err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error staging rendered manifests in git repo %q",
+			update.RepoURL,
+		)
+	}
+	diff, err := repo.Diff()
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error diffing rendered manifests in git repo %q",
+			update.RepoURL,
+		)
+	}
+	return diff, nil
+}
+
+// getRepoCredentials obtains credentials for the specified Git repository. If
+// none are found, empty credentials are returned.
+func (b *kargoRenderMechanism) getRepoCredentials(
+	ctx context.Context,
+	namespace string,
+	repoURL string,
+) (git.RepoCredentials, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", repoURL)
+	creds, ok, err := b.getCredentialsFn(
+		ctx,
+		namespace,
+		credentials.TypeGit,
+		repoURL,
+	)
+	if err != nil {
+		return git.RepoCredentials{}, errors.Wrapf(
+			err,
+			"error obtaining credentials for git repo %q",
+			repoURL,
+		)
+	}
+	repoCreds := git.RepoCredentials{}
+	if ok {
+		if creds.SigningKey != "" {
+			// Kargo Render makes its own commits and has no means of signing
+			// them. Failing here is preferable to pushing unsigned commits where
+			// signed ones were expected.
+			return git.RepoCredentials{}, errors.Errorf(
+				"credentials for git repo %q include a signing key, but commits "+
+					"made by Kargo Render cannot be signed",
+				repoURL,
+			)
+		}
+		repoCreds.Username = creds.Username
+		repoCreds.Password = creds.Password
+		repoCreds.SSHPrivateKey = creds.SSHPrivateKey
+		logger.Debug("obtained credentials for git repo")
+	} else {
+		logger.Debug("found no credentials for git repo")
+	}
+	return repoCreds, nil
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
 	render "github.com/akuity/kargo/internal/kargo-render"
 )
@@ -20,12 +22,113 @@ func TestNewKargoRenderMechanism(t *testing.T) {
 	require.NotNil(t, krpm.getReadRefFn)
 	require.NotNil(t, krpm.getCredentialsFn)
 	require.NotNil(t, krpm.renderManifestsFn)
+	require.NotNil(t, krpm.hasCommitFn)
+	require.NotNil(t, krpm.previewSingleUpdateFn)
 }
 
 func TestKargoRenderGetName(t *testing.T) {
 	require.NotEmpty(t, (&kargoRenderMechanism{}).GetName())
 }
 
+func TestKargoRenderPreview(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{
+						RepoURL: "fake-generic-url",
+					},
+					{
+						RepoURL:     "fake-url",
+						WriteBranch: "fake-branch",
+						Render:      &kargoapi.KargoRenderPromotionMechanism{},
+					},
+				},
+			},
+		},
+	}
+	testFreight := kargoapi.SimpleFreight{
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-image",
+				Tag:     "fake-tag",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *kargoRenderMechanism
+		assertions func(preview Preview, err error)
+	}{
+		{
+			name: "error previewing update",
+			promoMech: &kargoRenderMechanism{
+				previewSingleUpdateFn: func(
+					context.Context,
+					string,
+					kargoapi.GitRepoUpdate,
+					kargoapi.SimpleFreight,
+					[]string,
+				) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+				require.Empty(t, preview.GitRepoDiffs)
+			},
+		},
+		{
+			name: "success",
+			promoMech: &kargoRenderMechanism{
+				previewSingleUpdateFn: func(
+					_ context.Context,
+					namespace string,
+					update kargoapi.GitRepoUpdate,
+					_ kargoapi.SimpleFreight,
+					images []string,
+				) (string, error) {
+					require.Equal(t, "fake-namespace", namespace)
+					require.Equal(t, "fake-url", update.RepoURL)
+					require.Equal(t, []string{"fake-image:fake-tag"}, images)
+					return "fake-diff", nil
+				},
+			},
+			assertions: func(preview Preview, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]GitRepoDiff{
+						{
+							Mechanism: "Kargo Render promotion mechanisms",
+							RepoURL:   "fake-url",
+							Branch:    "fake-branch",
+							Diff:      "fake-diff",
+						},
+					},
+					preview.GitRepoDiffs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			preview := Preview{}
+			err := testCase.promoMech.Preview(
+				context.Background(),
+				testStage,
+				testFreight,
+				&preview,
+			)
+			testCase.assertions(preview, err)
+		})
+	}
+}
+
 func TestKargoRenderPromote(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -153,6 +256,51 @@ func TestKargoRenderPromote(t *testing.T) {
 	}
 }
 
+func TestKargoRenderPromoteCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var updated []string
+	_, err := (&kargoRenderMechanism{
+		doSingleUpdateFn: func(
+			_ context.Context,
+			_ string,
+			update kargoapi.GitRepoUpdate,
+			newFreight kargoapi.SimpleFreight,
+			_ []string,
+		) (kargoapi.SimpleFreight, error) {
+			updated = append(updated, update.RepoURL)
+			cancel()
+			return newFreight, nil
+		},
+	}).Promote(
+		ctx,
+		&kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					GitRepoUpdates: []kargoapi.GitRepoUpdate{
+						{
+							RepoURL: "https://github.com/akuity/first",
+							Render:  &kargoapi.KargoRenderPromotionMechanism{},
+						},
+						{
+							RepoURL: "https://github.com/akuity/second",
+							Render:  &kargoapi.KargoRenderPromotionMechanism{},
+						},
+					},
+				},
+			},
+		},
+		kargoapi.SimpleFreight{},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Contains(
+		t,
+		err.Error(),
+		"interrupted before updating https://github.com/akuity/second",
+	)
+	require.Equal(t, []string{"https://github.com/akuity/first"}, updated)
+}
+
 func TestKargoRenderDoSingleUpdate(t *testing.T) {
 	const testRef = "fake-ref"
 	testCases := []struct {
@@ -220,6 +368,48 @@ func TestKargoRenderDoSingleUpdate(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "credentials include a signing key",
+			promoMech: &kargoRenderMechanism{
+				getReadRefFn: func(
+					kargoapi.GitRepoUpdate,
+					[]kargoapi.GitCommit,
+				) (string, int, error) {
+					return testRef, 0, nil
+				},
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{
+						Username:   "fake-username",
+						Password:   "fake-personal-access-token",
+						SigningKey: "fake-signing-key",
+					}, true, nil
+				},
+				renderManifestsFn: func(render.Request) (render.Response, error) {
+					require.Fail(t, "manifests should not be rendered")
+					return render.Response{}, nil
+				},
+			},
+			assertions: func(
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "include a signing key")
+				require.Contains(
+					t,
+					err.Error(),
+					"commits made by Kargo Render cannot be signed",
+				)
+				require.False(t, IsTransientError(err))
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name: "error rendering manifests",
 			promoMech: &kargoRenderMechanism{
@@ -362,3 +552,301 @@ func TestKargoRenderDoSingleUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestKargoRenderDoSingleUpdateRecordsStep(t *testing.T) {
+	testCases := []struct {
+		name       string
+		res        render.Response
+		renderErr  error
+		assertions func(kargoapi.PromotionStepResult)
+	}{
+		{
+			name:      "error rendering manifests",
+			renderErr: errors.New("something went wrong"),
+			assertions: func(result kargoapi.PromotionStepResult) {
+				require.Equal(t, kargoapi.PromotionStepOutcomeErrored, result.Outcome)
+				require.Contains(t, result.Error, "something went wrong")
+				require.Empty(t, result.Commit)
+			},
+		},
+		{
+			name: "pushed directly",
+			res: render.Response{
+				ActionTaken: render.ActionTakenPushedDirectly,
+				CommitID:    "fake-commit-id",
+			},
+			assertions: func(result kargoapi.PromotionStepResult) {
+				require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, result.Outcome)
+				require.Equal(t, "fake-commit-id", result.Commit)
+				require.Empty(t, result.PullRequestURL)
+			},
+		},
+		{
+			name: "opened pull request",
+			res: render.Response{
+				ActionTaken:    render.ActionTakenOpenedPR,
+				PullRequestURL: "https://github.com/akuity/kargo-demo/pull/1",
+			},
+			assertions: func(result kargoapi.PromotionStepResult) {
+				require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, result.Outcome)
+				require.Empty(t, result.Commit)
+				require.Equal(
+					t,
+					"https://github.com/akuity/kargo-demo/pull/1",
+					result.PullRequestURL,
+				)
+			},
+		},
+		{
+			name: "updated pull request",
+			res: render.Response{
+				ActionTaken:    render.ActionTakenUpdatedPR,
+				PullRequestURL: "https://github.com/akuity/kargo-demo/pull/1",
+			},
+			assertions: func(result kargoapi.PromotionStepResult) {
+				require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, result.Outcome)
+				require.Empty(t, result.Commit)
+				require.Equal(
+					t,
+					"https://github.com/akuity/kargo-demo/pull/1",
+					result.PullRequestURL,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := NewStepRecorder(nil, nil)
+			promoMech := &kargoRenderMechanism{
+				getReadRefFn: func(
+					kargoapi.GitRepoUpdate,
+					[]kargoapi.GitCommit,
+				) (string, int, error) {
+					return "fake-ref", 0, nil
+				},
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+				renderManifestsFn: func(render.Request) (render.Response, error) {
+					return testCase.res, testCase.renderErr
+				},
+			}
+			newFreightOut, _ := promoMech.doSingleUpdate(
+				ContextWithStepRecorder(context.Background(), recorder),
+				"fake-namespace",
+				kargoapi.GitRepoUpdate{
+					RepoURL:     "https://github.com/akuity/kargo-demo.git",
+					WriteBranch: "stage/test",
+				},
+				kargoapi.SimpleFreight{
+					Commits: []kargoapi.GitCommit{{}},
+				},
+				nil, // Images
+			)
+			require.Equal(
+				t,
+				testCase.res.CommitID,
+				newFreightOut.Commits[0].HealthCheckCommit,
+			)
+			results := recorder.Results()
+			require.Len(t, results, 1)
+			require.Equal(t, promoMech.GetName(), results[0].Mechanism)
+			require.Equal(
+				t,
+				"https://github.com/akuity/kargo-demo.git (branch stage/test)",
+				results[0].Target,
+			)
+			testCase.assertions(results[0])
+		})
+	}
+}
+
+func TestKargoRenderDoSingleUpdateResume(t *testing.T) {
+	const testRepoURL = "https://github.com/akuity/kargo-demo.git"
+	testCases := []struct {
+		name       string
+		previous   kargoapi.PromotionStepResult
+		hasCommit  bool
+		hasErr     error
+		assertions func(
+			newFreightOut kargoapi.SimpleFreight,
+			results []kargoapi.PromotionStepResult,
+			rendered bool,
+			err error,
+		)
+	}{
+		{
+			name: "step already succeeded",
+			previous: kargoapi.PromotionStepResult{
+				Outcome: kargoapi.PromotionStepOutcomeSucceeded,
+				Commit:  "previous-commit-id",
+			},
+			assertions: func(
+				newFreightOut kargoapi.SimpleFreight,
+				results []kargoapi.PromotionStepResult,
+				rendered bool,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, rendered)
+				require.Equal(
+					t,
+					"previous-commit-id",
+					newFreightOut.Commits[0].HealthCheckCommit,
+				)
+				require.Len(t, results, 1)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepOutcomeSucceeded,
+					results[0].Outcome,
+				)
+			},
+		},
+		{
+			name: "error checking for previous commit",
+			previous: kargoapi.PromotionStepResult{
+				Outcome: kargoapi.PromotionStepOutcomeRunning,
+				Commit:  "previous-commit-id",
+			},
+			hasErr: errors.New("something went wrong"),
+			assertions: func(
+				_ kargoapi.SimpleFreight,
+				results []kargoapi.PromotionStepResult,
+				rendered bool,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error checking for commit")
+				require.Contains(t, err.Error(), "something went wrong")
+				require.False(t, rendered)
+				require.Len(t, results, 1)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepOutcomeErrored,
+					results[0].Outcome,
+				)
+			},
+		},
+		{
+			name: "previous commit was pushed",
+			previous: kargoapi.PromotionStepResult{
+				Outcome: kargoapi.PromotionStepOutcomeRunning,
+				Commit:  "previous-commit-id",
+			},
+			hasCommit: true,
+			assertions: func(
+				newFreightOut kargoapi.SimpleFreight,
+				results []kargoapi.PromotionStepResult,
+				rendered bool,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, rendered)
+				require.Equal(
+					t,
+					"previous-commit-id",
+					newFreightOut.Commits[0].HealthCheckCommit,
+				)
+				require.Len(t, results, 1)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepOutcomeSucceeded,
+					results[0].Outcome,
+				)
+				require.Equal(t, "previous-commit-id", results[0].Commit)
+			},
+		},
+		{
+			name: "previous commit was not pushed",
+			previous: kargoapi.PromotionStepResult{
+				Outcome: kargoapi.PromotionStepOutcomeRunning,
+				Commit:  "previous-commit-id",
+			},
+			assertions: func(
+				newFreightOut kargoapi.SimpleFreight,
+				results []kargoapi.PromotionStepResult,
+				rendered bool,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, rendered)
+				require.Equal(
+					t,
+					"fake-commit-id",
+					newFreightOut.Commits[0].HealthCheckCommit,
+				)
+				require.Len(t, results, 1)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepOutcomeSucceeded,
+					results[0].Outcome,
+				)
+				require.Equal(t, "fake-commit-id", results[0].Commit)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			update := kargoapi.GitRepoUpdate{
+				RepoURL:     testRepoURL,
+				WriteBranch: "stage/test",
+			}
+			promoMech := &kargoRenderMechanism{
+				getReadRefFn: func(
+					kargoapi.GitRepoUpdate,
+					[]kargoapi.GitCommit,
+				) (string, int, error) {
+					return "fake-ref", 0, nil
+				},
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+				hasCommitFn: func(
+					_ context.Context,
+					_ kargoapi.GitRepoUpdate,
+					_ git.RepoCredentials,
+					commitID string,
+				) (bool, error) {
+					require.Equal(t, "previous-commit-id", commitID)
+					return testCase.hasCommit, testCase.hasErr
+				},
+			}
+			var rendered bool
+			promoMech.renderManifestsFn =
+				func(render.Request) (render.Response, error) {
+					rendered = true
+					return render.Response{
+						ActionTaken: render.ActionTakenPushedDirectly,
+						CommitID:    "fake-commit-id",
+					}, nil
+				}
+			previous := testCase.previous
+			previous.Mechanism = promoMech.GetName()
+			previous.Target = gitUpdateTarget(update)
+			recorder := NewStepRecorder(
+				[]kargoapi.PromotionStepResult{previous},
+				nil,
+			)
+			newFreightOut, err := promoMech.doSingleUpdate(
+				ContextWithStepRecorder(context.Background(), recorder),
+				"fake-namespace",
+				update,
+				kargoapi.SimpleFreight{
+					Commits: []kargoapi.GitCommit{{}},
+				},
+				nil, // Images
+			)
+			testCase.assertions(newFreightOut, recorder.Results(), rendered, err)
+		})
+	}
+}
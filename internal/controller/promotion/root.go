@@ -26,18 +26,45 @@ type Mechanism interface {
 // NewMechanisms returns the entrypoint to a hierarchical tree of promotion
 // mechanisms.
 func NewMechanisms(
+	kargoClient client.Client,
 	argoClient client.Client,
 	credentialsDB credentials.Database,
 ) Mechanism {
 	return newCompositeMechanism(
 		"promotion mechanisms",
-		newCompositeMechanism(
-			"Git-based promotion mechanisms",
-			newGenericGitMechanism(credentialsDB),
-			newKargoRenderMechanism(credentialsDB),
-			newKustomizeMechanism(credentialsDB),
-			newHelmMechanism(credentialsDB),
-		),
-		newArgoCDMechanism(argoClient),
+		newHookMechanism(kargoClient, hookPhasePre),
+		newGitMechanisms(kargoClient, credentialsDB),
+		NewArgoCDMechanism(argoClient),
+		newHookMechanism(kargoClient, hookPhasePost),
+	)
+}
+
+// NewPreviewer returns the entrypoint to a hierarchical tree of the promotion
+// mechanisms returned by NewMechanisms that are capable of previewing
+// promotions without carrying them out. Pre- and post-promotion hooks cannot
+// be previewed and are omitted.
+func NewPreviewer(
+	argoClient client.Client,
+	credentialsDB credentials.Database,
+) Previewer {
+	return newCompositeMechanism(
+		"promotion mechanisms",
+		// Previews make no commits, so the Git-based mechanisms never need to
+		// look up a project's commit settings
+		newGitMechanisms(nil, credentialsDB),
+		NewArgoCDMechanism(argoClient),
+	).(Previewer) // nolint: forcetypeassert
+}
+
+func newGitMechanisms(
+	kargoClient client.Client,
+	credentialsDB credentials.Database,
+) Mechanism {
+	return newCompositeMechanism(
+		"Git-based promotion mechanisms",
+		newGenericGitMechanism(kargoClient, credentialsDB),
+		newKargoRenderMechanism(credentialsDB),
+		newKustomizeMechanism(kargoClient, credentialsDB),
+		newHelmMechanism(kargoClient, credentialsDB),
 	)
 }
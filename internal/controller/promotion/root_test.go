@@ -13,12 +13,21 @@ import (
 
 func TestNewMechanisms(t *testing.T) {
 	promoMechs := NewMechanisms(
+		fake.NewClientBuilder().Build(),
 		fake.NewClientBuilder().Build(),
 		credentials.NewKubernetesDatabase("", nil, nil),
 	)
 	require.IsType(t, &compositeMechanism{}, promoMechs)
 }
 
+func TestNewPreviewer(t *testing.T) {
+	previewer := NewPreviewer(
+		fake.NewClientBuilder().Build(),
+		credentials.NewKubernetesDatabase("", nil, nil),
+	)
+	require.IsType(t, &compositeMechanism{}, previewer)
+}
+
 // FakeMechanism is a fake implementation of the Mechanism interface used for
 // testing.
 type FakeMechanism struct {
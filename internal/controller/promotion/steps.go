@@ -0,0 +1,242 @@
+package promotion
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+type stepRecorderContextKey struct{}
+
+// StepRecorder records the results of the individual steps executed by
+// promotion mechanisms. A StepRecorder is made available to promotion
+// mechanisms using ContextWithStepRecorder.
+type StepRecorder struct {
+	mu sync.Mutex
+	// previous holds the results recorded by a previous, incomplete attempt at
+	// the same Promotion. Steps are executed in a deterministic order, so the
+	// nth step executed by any attempt corresponds to the nth previous result.
+	previous []kargoapi.PromotionStepResult
+	results  []kargoapi.PromotionStepResult
+	onUpdate func([]kargoapi.PromotionStepResult)
+	nowFn    func() time.Time
+}
+
+// NewStepRecorder returns a StepRecorder. The provided results, if any, are
+// those recorded by a previous attempt at the same Promotion that was
+// interrupted or that failed. Steps that succeeded in that attempt are not
+// executed again. If the provided function is non-nil, it is invoked with all
+// results recorded so far each time a step starts, makes progress, or
+// concludes.
+func NewStepRecorder(
+	previous []kargoapi.PromotionStepResult,
+	onUpdate func([]kargoapi.PromotionStepResult),
+) *StepRecorder {
+	return &StepRecorder{
+		previous: previous,
+		onUpdate: onUpdate,
+		nowFn:    time.Now,
+	}
+}
+
+// ContextWithStepRecorder returns a copy of the provided context that carries
+// the provided StepRecorder.
+func ContextWithStepRecorder(
+	ctx context.Context,
+	recorder *StepRecorder,
+) context.Context {
+	return context.WithValue(ctx, stepRecorderContextKey{}, recorder)
+}
+
+// Results returns a copy of all step results recorded so far.
+func (s *StepRecorder) Results() []kargoapi.PromotionStepResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.copyResults()
+}
+
+func (s *StepRecorder) copyResults() []kargoapi.PromotionStepResult {
+	if len(s.results) == 0 {
+		return nil
+	}
+	results := make([]kargoapi.PromotionStepResult, len(s.results))
+	for i, result := range s.results {
+		results[i] = *result.DeepCopy()
+	}
+	return results
+}
+
+// start records the start of a step. If the corresponding step of a previous
+// attempt concerned the same mechanism and target, its result is also
+// returned. If that result is a success, it is recorded again as is and the
+// step must not be executed.
+func (s *StepRecorder) start(
+	mechanism string,
+	target string,
+) (int, *kargoapi.PromotionStepResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := len(s.results)
+	var previous *kargoapi.PromotionStepResult
+	if i < len(s.previous) && s.previous[i].Mechanism == mechanism &&
+		s.previous[i].Target == target {
+		previous = s.previous[i].DeepCopy()
+	} else {
+		// Once steps diverge from those of the previous attempt, no subsequent
+		// step can be matched to a previous result.
+		s.previous = nil
+	}
+	if previous != nil &&
+		previous.Outcome == kargoapi.PromotionStepOutcomeSucceeded {
+		s.results = append(s.results, *previous)
+		s.notify()
+		return i, previous
+	}
+	s.results = append(s.results, kargoapi.PromotionStepResult{
+		Mechanism: mechanism,
+		Target:    target,
+		StartTime: &metav1.Time{Time: s.nowFn()},
+		Outcome:   kargoapi.PromotionStepOutcomeRunning,
+	})
+	s.notify()
+	return i, previous
+}
+
+func (s *StepRecorder) conclude(i int, commit string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := &s.results[i]
+	result.EndTime = &metav1.Time{Time: s.nowFn()}
+	result.Commit = commit
+	if err != nil {
+		result.Outcome = kargoapi.PromotionStepOutcomeErrored
+		result.Error = err.Error()
+	} else {
+		result.Outcome = kargoapi.PromotionStepOutcomeSucceeded
+	}
+	s.notify()
+}
+
+func (s *StepRecorder) setCommit(i int, commit string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[i].Commit = commit
+	s.notify()
+}
+
+func (s *StepRecorder) setPullRequest(i int, url string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[i].PullRequestURL = url
+	s.notify()
+}
+
+func (s *StepRecorder) setJob(i int, job string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[i].Job = job
+	s.notify()
+}
+
+func (s *StepRecorder) notify() {
+	if s.onUpdate != nil {
+		s.onUpdate(s.copyResults())
+	}
+}
+
+// step is a handle to a single step executed by a promotion mechanism, used
+// to record its progress and conclusion. All of its methods are safe to invoke
+// when no StepRecorder is in use.
+type step struct {
+	recorder *StepRecorder
+	index    int
+	previous *kargoapi.PromotionStepResult
+}
+
+// startStep records the start of a step executed by the named promotion
+// mechanism against the described target using the StepRecorder carried by
+// the provided context, if any. Before executing the step, the caller must
+// use the returned step's completed method to determine whether the step
+// already succeeded in a previous attempt at the Promotion.
+func startStep(ctx context.Context, mechanism string, target string) *step {
+	recorder, ok := ctx.Value(stepRecorderContextKey{}).(*StepRecorder)
+	if !ok {
+		return &step{}
+	}
+	i, previous := recorder.start(mechanism, target)
+	return &step{
+		recorder: recorder,
+		index:    i,
+		previous: previous,
+	}
+}
+
+// completed returns the result of the step recorded by a previous attempt at
+// the Promotion, along with true, if the step succeeded in that attempt. In
+// that case, the step must not be executed again and must not be concluded.
+func (s *step) completed() (kargoapi.PromotionStepResult, bool) {
+	if s.previous == nil ||
+		s.previous.Outcome != kargoapi.PromotionStepOutcomeSucceeded {
+		return kargoapi.PromotionStepResult{}, false
+	}
+	return *s.previous, true
+}
+
+// previousCommit returns the ID of any commit recorded by a previous attempt
+// at the step that was interrupted before it concluded. Such a commit may or
+// may not have been pushed before that attempt was interrupted.
+func (s *step) previousCommit() string {
+	if s.previous == nil ||
+		s.previous.Outcome != kargoapi.PromotionStepOutcomeRunning {
+		return ""
+	}
+	return s.previous.Commit
+}
+
+// previousJob returns the name of any Kubernetes Job recorded by a previous
+// attempt at the step that was interrupted before it concluded. Such a Job may
+// still be running.
+func (s *step) previousJob() string {
+	if s.previous == nil ||
+		s.previous.Outcome != kargoapi.PromotionStepOutcomeRunning {
+		return ""
+	}
+	return s.previous.Job
+}
+
+// setCommit records the ID of a commit made by the step before the step
+// concludes, so that, if the step is interrupted, a subsequent attempt can
+// determine whether the commit was pushed.
+func (s *step) setCommit(commit string) {
+	if s.recorder != nil {
+		s.recorder.setCommit(s.index, commit)
+	}
+}
+
+// setPullRequest records the URL of a pull request opened or updated by the
+// step in lieu of making a commit.
+func (s *step) setPullRequest(url string) {
+	if s.recorder != nil {
+		s.recorder.setPullRequest(s.index, url)
+	}
+}
+
+// setJob records the name of the Kubernetes Job run by the step as soon as it
+// is known.
+func (s *step) setJob(job string) {
+	if s.recorder != nil {
+		s.recorder.setJob(s.index, job)
+	}
+}
+
+// conclude records the conclusion of the step, along with the ID of any
+// commit it produced.
+func (s *step) conclude(commit string, err error) {
+	if s.recorder != nil {
+		s.recorder.conclude(s.index, commit, err)
+	}
+}
@@ -0,0 +1,258 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+)
+
+func TestStepRecorder(t *testing.T) {
+	now := time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC)
+	var updates [][]kargoapi.PromotionStepResult
+	recorder := NewStepRecorder(nil, func(results []kargoapi.PromotionStepResult) {
+		updates = append(updates, results)
+	})
+	recorder.nowFn = func() time.Time {
+		return now
+	}
+	ctx := ContextWithStepRecorder(context.Background(), recorder)
+
+	first := startStep(ctx, "fake mechanism", "fake-target-1")
+	first.setCommit("fake-commit-id")
+	first.conclude("fake-commit-id", nil)
+	startStep(ctx, "fake mechanism", "fake-target-2").
+		conclude("", errors.New("something went wrong"))
+	third := startStep(ctx, "fake mechanism", "fake-target-3")
+	third.setJob("fake-job")
+	third.conclude("", nil)
+
+	expected := []kargoapi.PromotionStepResult{
+		{
+			Mechanism: "fake mechanism",
+			Target:    "fake-target-1",
+			StartTime: &metav1.Time{Time: now},
+			EndTime:   &metav1.Time{Time: now},
+			Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+			Commit:    "fake-commit-id",
+		},
+		{
+			Mechanism: "fake mechanism",
+			Target:    "fake-target-2",
+			StartTime: &metav1.Time{Time: now},
+			EndTime:   &metav1.Time{Time: now},
+			Outcome:   kargoapi.PromotionStepOutcomeErrored,
+			Error:     "something went wrong",
+		},
+		{
+			Mechanism: "fake mechanism",
+			Target:    "fake-target-3",
+			StartTime: &metav1.Time{Time: now},
+			EndTime:   &metav1.Time{Time: now},
+			Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+			Job:       "fake-job",
+		},
+	}
+	require.Equal(t, expected, recorder.Results())
+
+	// Every start and conclusion of a step, every commit, and every Job is
+	// reported
+	require.Len(t, updates, 8)
+	require.Equal(
+		t,
+		kargoapi.PromotionStepOutcomeRunning,
+		updates[0][0].Outcome,
+	)
+	require.Equal(t, expected, updates[7])
+}
+
+func TestStartStepWithoutRecorder(t *testing.T) {
+	require.NotPanics(t, func() {
+		step := startStep(context.Background(), "fake mechanism", "fake-target")
+		_, completed := step.completed()
+		require.False(t, completed)
+		require.Empty(t, step.previousCommit())
+		require.Empty(t, step.previousJob())
+		step.setCommit("fake-commit-id")
+		step.setJob("fake-job")
+		step.conclude("", nil)
+	})
+}
+
+func TestStepRecorderResume(t *testing.T) {
+	now := time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC)
+	previous := []kargoapi.PromotionStepResult{
+		{
+			Mechanism: "fake mechanism",
+			Target:    "fake-target-1",
+			Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+			Commit:    "fake-commit-id-1",
+		},
+		{
+			Mechanism: "fake mechanism",
+			Target:    "fake-target-2",
+			Outcome:   kargoapi.PromotionStepOutcomeRunning,
+			Commit:    "fake-commit-id-2",
+		},
+		{
+			Mechanism: "fake mechanism",
+			Target:    "fake-target-3",
+			Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+		},
+		{
+			Mechanism: "fake mechanism",
+			Target:    "fake-target-4",
+			Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+		},
+	}
+	recorder := NewStepRecorder(previous, nil)
+	recorder.nowFn = func() time.Time {
+		return now
+	}
+	ctx := ContextWithStepRecorder(context.Background(), recorder)
+
+	// A step that succeeded previously is recorded as is and not executed again
+	first := startStep(ctx, "fake mechanism", "fake-target-1")
+	result, completed := first.completed()
+	require.True(t, completed)
+	require.Equal(t, previous[0], result)
+
+	// A step that was interrupted exposes what it had done so far
+	second := startStep(ctx, "fake mechanism", "fake-target-2")
+	_, completed = second.completed()
+	require.False(t, completed)
+	require.Equal(t, "fake-commit-id-2", second.previousCommit())
+	second.conclude("fake-commit-id-2", nil)
+
+	// Once steps diverge from those previously recorded, no subsequent step is
+	// considered to have been completed
+	different := startStep(ctx, "fake mechanism", "fake-target-5")
+	_, completed = different.completed()
+	require.False(t, completed)
+	different.conclude("", nil)
+	fourth := startStep(ctx, "fake mechanism", "fake-target-4")
+	_, completed = fourth.completed()
+	require.False(t, completed)
+	fourth.conclude("", nil)
+
+	results := recorder.Results()
+	require.Len(t, results, 4)
+	require.Equal(t, previous[0], results[0])
+	require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, results[1].Outcome)
+	require.Equal(t, "fake-target-5", results[2].Target)
+	require.Equal(t, &metav1.Time{Time: now}, results[3].StartTime)
+}
+
+func TestGitDoSingleUpdateRecordsStep(t *testing.T) {
+	recorder := NewStepRecorder(nil, nil)
+	g := &gitMechanism{
+		name: "fake mechanism",
+		getReadRefFn: func(
+			kargoapi.GitRepoUpdate,
+			[]kargoapi.GitCommit,
+		) (string, int, error) {
+			return "", -1, nil
+		},
+		getCredentialsFn: func(
+			context.Context,
+			string,
+			string,
+		) (*git.RepoCredentials, error) {
+			return nil, nil
+		},
+		getProjectFn: func(context.Context, string) (*corev1.Namespace, error) {
+			return nil, nil
+		},
+		gitCommitFn: func(
+			context.Context,
+			kargoapi.GitRepoUpdate,
+			kargoapi.SimpleFreight,
+			string,
+			string,
+			*git.RepoCredentials,
+			gitCommitOptions,
+			*step,
+		) (string, error) {
+			return "fake-commit-id", nil
+		},
+	}
+	_, err := g.doSingleUpdate(
+		ContextWithStepRecorder(context.Background(), recorder),
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace"},
+		},
+		kargoapi.GitRepoUpdate{
+			RepoURL:     "https://github.com/akuity/kargo-demo.git",
+			WriteBranch: "stage/test",
+		},
+		kargoapi.SimpleFreight{},
+	)
+	require.NoError(t, err)
+	results := recorder.Results()
+	require.Len(t, results, 1)
+	require.Equal(t, "fake mechanism", results[0].Mechanism)
+	require.Equal(
+		t,
+		"https://github.com/akuity/kargo-demo.git (branch stage/test)",
+		results[0].Target,
+	)
+	require.Equal(t, kargoapi.PromotionStepOutcomeSucceeded, results[0].Outcome)
+	require.Equal(t, "fake-commit-id", results[0].Commit)
+}
+
+func TestGitDoSingleUpdateSkipsCompletedStep(t *testing.T) {
+	const testRepoURL = "https://github.com/akuity/kargo-demo.git"
+	recorder := NewStepRecorder(
+		[]kargoapi.PromotionStepResult{
+			{
+				Mechanism: "fake mechanism",
+				Target:    testRepoURL,
+				Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+				Commit:    "fake-commit-id",
+			},
+		},
+		nil,
+	)
+	g := &gitMechanism{
+		name: "fake mechanism",
+		getReadRefFn: func(
+			kargoapi.GitRepoUpdate,
+			[]kargoapi.GitCommit,
+		) (string, int, error) {
+			return "fake-ref", 0, nil
+		},
+		gitCommitFn: func(
+			context.Context,
+			kargoapi.GitRepoUpdate,
+			kargoapi.SimpleFreight,
+			string,
+			string,
+			*git.RepoCredentials,
+			gitCommitOptions,
+			*step,
+		) (string, error) {
+			require.Fail(t, "completed step should not be executed again")
+			return "", nil
+		},
+	}
+	newFreight, err := g.doSingleUpdate(
+		ContextWithStepRecorder(context.Background(), recorder),
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace"},
+		},
+		kargoapi.GitRepoUpdate{RepoURL: testRepoURL},
+		kargoapi.SimpleFreight{
+			Commits: []kargoapi.GitCommit{{}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-commit-id", newFreight.Commits[0].HealthCheckCommit)
+	require.Len(t, recorder.Results(), 1)
+}
@@ -0,0 +1,75 @@
+package promotions
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// setConditions updates the Ready, Reconciling, and Stalled conditions within
+// the provided PromotionStatus to reflect its phase. It should be called
+// whenever the phase (or, for a Running Promotion, the error) changes.
+func setConditions(status *kargoapi.PromotionStatus, generation int64) {
+	reconciling := metav1.Condition{
+		Type:               kargoapi.ConditionTypeReconciling,
+		Status:             metav1.ConditionFalse,
+		Reason:             string(status.Phase),
+		Message:            fmt.Sprintf("Promotion is %s", status.Phase),
+		ObservedGeneration: generation,
+	}
+	stalled := metav1.Condition{
+		Type:               kargoapi.ConditionTypeStalled,
+		Status:             metav1.ConditionFalse,
+		Reason:             string(status.Phase),
+		Message:            reconciling.Message,
+		ObservedGeneration: generation,
+	}
+	ready := metav1.Condition{
+		Type:               kargoapi.ConditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             string(status.Phase),
+		Message:            reconciling.Message,
+		ObservedGeneration: generation,
+	}
+	if status.Error != "" {
+		ready.Message = status.Error
+	}
+	switch status.Phase {
+	case kargoapi.PromotionPhasePending, "":
+		reconciling.Status = metav1.ConditionTrue
+		reconciling.Reason = string(kargoapi.PromotionPhasePending)
+		reconciling.Message = "Promotion is waiting to be executed"
+		stalled.Reason = reconciling.Reason
+		stalled.Message = reconciling.Message
+		ready.Reason = reconciling.Reason
+		ready.Message = reconciling.Message
+	case kargoapi.PromotionPhaseRunning:
+		reconciling.Status = metav1.ConditionTrue
+		reconciling.Message = fmt.Sprintf(
+			"Promotion is Running (attempt %d)",
+			status.Attempts,
+		)
+		if status.Error != "" {
+			// A previous attempt failed and the Promotion will be retried
+			reconciling.Reason = "Retrying"
+			reconciling.Message = fmt.Sprintf(
+				"A previous attempt failed and the Promotion is being retried: %s",
+				status.Error,
+			)
+		}
+		stalled.Message = reconciling.Message
+		ready.Reason = reconciling.Reason
+		ready.Message = reconciling.Message
+	case kargoapi.PromotionPhaseSucceeded:
+		ready.Status = metav1.ConditionTrue
+	case kargoapi.PromotionPhaseErrored:
+		stalled.Status = metav1.ConditionTrue
+		stalled.Message = ready.Message
+	}
+	meta.SetStatusCondition(&status.Conditions, reconciling)
+	meta.SetStatusCondition(&status.Conditions, stalled)
+	meta.SetStatusCondition(&status.Conditions, ready)
+}
@@ -0,0 +1,113 @@
+package promotions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSetConditions(t *testing.T) {
+	testCases := []struct {
+		name                string
+		status              kargoapi.PromotionStatus
+		expectedReady       metav1.ConditionStatus
+		expectedReconciling metav1.ConditionStatus
+		expectedStalled     metav1.ConditionStatus
+		expectedReason      string
+	}{
+		{
+			name:                "pending",
+			status:              kargoapi.PromotionStatus{},
+			expectedReady:       metav1.ConditionFalse,
+			expectedReconciling: metav1.ConditionTrue,
+			expectedStalled:     metav1.ConditionFalse,
+			expectedReason:      "Pending",
+		},
+		{
+			name: "running",
+			status: kargoapi.PromotionStatus{
+				Phase:    kargoapi.PromotionPhaseRunning,
+				Attempts: 1,
+			},
+			expectedReady:       metav1.ConditionFalse,
+			expectedReconciling: metav1.ConditionTrue,
+			expectedStalled:     metav1.ConditionFalse,
+			expectedReason:      "Running",
+		},
+		{
+			name: "retrying",
+			status: kargoapi.PromotionStatus{
+				Phase:    kargoapi.PromotionPhaseRunning,
+				Error:    "something went wrong",
+				Attempts: 2,
+			},
+			expectedReady:       metav1.ConditionFalse,
+			expectedReconciling: metav1.ConditionTrue,
+			expectedStalled:     metav1.ConditionFalse,
+			expectedReason:      "Retrying",
+		},
+		{
+			name: "succeeded",
+			status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseSucceeded,
+			},
+			expectedReady:       metav1.ConditionTrue,
+			expectedReconciling: metav1.ConditionFalse,
+			expectedStalled:     metav1.ConditionFalse,
+			expectedReason:      "Succeeded",
+		},
+		{
+			name: "errored",
+			status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseErrored,
+				Error: "something went wrong",
+			},
+			expectedReady:       metav1.ConditionFalse,
+			expectedReconciling: metav1.ConditionFalse,
+			expectedStalled:     metav1.ConditionTrue,
+			expectedReason:      "Errored",
+		},
+		{
+			name: "aborted",
+			status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseAborted,
+				Error: "promotion aborted",
+			},
+			expectedReady:       metav1.ConditionFalse,
+			expectedReconciling: metav1.ConditionFalse,
+			expectedStalled:     metav1.ConditionFalse,
+			expectedReason:      "Aborted",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := testCase.status
+			setConditions(&status, 42)
+			ready :=
+				meta.FindStatusCondition(status.Conditions, kargoapi.ConditionTypeReady)
+			require.NotNil(t, ready)
+			require.Equal(t, testCase.expectedReady, ready.Status)
+			require.Equal(t, testCase.expectedReason, ready.Reason)
+			require.Equal(t, int64(42), ready.ObservedGeneration)
+			reconciling := meta.FindStatusCondition(
+				status.Conditions,
+				kargoapi.ConditionTypeReconciling,
+			)
+			require.NotNil(t, reconciling)
+			require.Equal(t, testCase.expectedReconciling, reconciling.Status)
+			stalled := meta.FindStatusCondition(
+				status.Conditions,
+				kargoapi.ConditionTypeStalled,
+			)
+			require.NotNil(t, stalled)
+			require.Equal(t, testCase.expectedStalled, stalled.Status)
+			if testCase.status.Error != "" {
+				require.Contains(t, ready.Message, testCase.status.Error)
+			}
+		})
+	}
+}
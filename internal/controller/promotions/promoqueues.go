@@ -21,6 +21,10 @@ type promoQueues struct {
 	// pendingPromoQueuesByStage holds a priority queue of promotions, per Stage. We allow one
 	// promotion to run at a time, ordered by creationTimestamp.
 	pendingPromoQueuesByStage map[types.NamespacedName]runtime.PriorityQueue
+	// abandonedPromoByStage holds, for a given stage, a promotion that has been
+	// concluded (because it timed out) but whose execution has yet to return. No
+	// other promotion may begin until it does.
+	abandonedPromoByStage map[types.NamespacedName]string
 	// promoQueuesByStageMu protects access to the above maps
 	promoQueuesByStageMu sync.RWMutex
 }
@@ -29,18 +33,22 @@ func newPriorityQueue() runtime.PriorityQueue {
 	// We can safely ignore errors here because the only error that can happen
 	// involves initializing the queue with a nil priority function, which we
 	// know we aren't doing.
-	pq, _ := runtime.NewPriorityQueue(func(left, right client.Object) bool {
-		if left.GetCreationTimestamp().Time.Equal(
-			right.GetCreationTimestamp().Time,
-		) {
-			return left.GetName() < right.GetName()
-		}
-		return left.GetCreationTimestamp().Time.
-			Before(right.GetCreationTimestamp().Time)
-	})
+	pq, _ := runtime.NewPriorityQueue(queuedBefore)
 	return pq
 }
 
+// queuedBefore returns true if the left promotion is ahead of the right one in
+// a Stage's queue. Promotions are ordered by creationTimestamp, then by name.
+func queuedBefore(left, right client.Object) bool {
+	if left.GetCreationTimestamp().Time.Equal(
+		right.GetCreationTimestamp().Time,
+	) {
+		return left.GetName() < right.GetName()
+	}
+	return left.GetCreationTimestamp().Time.
+		Before(right.GetCreationTimestamp().Time)
+}
+
 // initializeQueues adds the promotion list to relevant priority queues.
 // This is intended to be invoked ONCE and the caller MUST ensure that.
 func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.PromotionList) {
@@ -120,7 +128,7 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 	if pq.Push(promo) {
 		logger.Debug("promo added to priority queue")
 	}
-	if activePromoName == "" {
+	if activePromoName == "" && pqs.abandonedPromoByStage[stageKey] == "" {
 		// If we get here, the Stage does not have any active Promotions Running against it.
 		// Now check if it this promo is the one that should run next.
 		// NOTE: first will never be empty because of the push call above
@@ -150,3 +158,34 @@ func (pqs *promoQueues) conclude(ctx context.Context, stageKey types.NamespacedN
 		logger.Debug("conclude promo")
 	}
 }
+
+// abandon records that the execution of the given promotion for the given
+// stage key is still in progress even though the promotion itself is about to
+// be concluded. Until release is called for the same promotion, no other
+// promotion for the stage will begin.
+func (pqs *promoQueues) abandon(ctx context.Context, stageKey types.NamespacedName, promoName string) {
+	pqs.promoQueuesByStageMu.Lock()
+	defer pqs.promoQueuesByStageMu.Unlock()
+	pqs.abandonedPromoByStage[stageKey] = promoName
+	logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"namespace": stageKey.Namespace,
+		"promotion": promoName,
+	}).Debug("abandon promo")
+}
+
+// release records that the execution of the given abandoned promotion for the
+// given stage key has returned. It returns true if the promotion had been
+// abandoned, in which case the next promotion for the stage may now begin.
+func (pqs *promoQueues) release(ctx context.Context, stageKey types.NamespacedName, promoName string) bool {
+	pqs.promoQueuesByStageMu.Lock()
+	defer pqs.promoQueuesByStageMu.Unlock()
+	if pqs.abandonedPromoByStage[stageKey] != promoName {
+		return false
+	}
+	delete(pqs.abandonedPromoByStage, stageKey)
+	logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"namespace": stageKey.Namespace,
+		"promotion": promoName,
+	}).Debug("release promo")
+	return true
+}
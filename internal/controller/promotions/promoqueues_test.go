@@ -189,3 +189,33 @@ func TestConclude(t *testing.T) {
 	pqs.conclude(ctx, fooStageKey, "a")
 	require.Equal(t, "", pqs.activePromoByStage[fooStageKey])
 }
+
+func TestAbandonAndRelease(t *testing.T) {
+	pqs := promoQueues{
+		activePromoByStage:        map[types.NamespacedName]string{},
+		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+		abandonedPromoByStage:     map[types.NamespacedName]string{},
+	}
+	pqs.initializeQueues(context.Background(), testPromos)
+
+	ctx := context.TODO()
+
+	// Test setup
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now)))
+
+	// 1. Abandon and conclude the active one. The next one cannot begin yet
+	pqs.abandon(ctx, fooStageKey, "a")
+	pqs.conclude(ctx, fooStageKey, "a")
+	require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, "b", "foo", "", now)))
+
+	// 2. Release something not even abandoned. it should be a no-op
+	require.False(t, pqs.release(ctx, fooStageKey, "not-abandoned"))
+	require.False(t, pqs.tryBegin(ctx, newPromo(testNamespace, "b", "foo", "", now)))
+
+	// 3. Release the abandoned one. The next one can now begin
+	require.True(t, pqs.release(ctx, fooStageKey, "a"))
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "b", "foo", "", now)))
+
+	// 4. Release the same key, should be a noop
+	require.False(t, pqs.release(ctx, fooStageKey, "a"))
+}
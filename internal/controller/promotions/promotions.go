@@ -4,12 +4,22 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -24,17 +34,55 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+const (
+	// defaultPromotionTimeout is the maximum length of time for which a single
+	// attempt at executing a Promotion may run if the Stage does not specify
+	// otherwise.
+	defaultPromotionTimeout = 30 * time.Minute
+	// defaultPromotionRetryBackoff is the length of time to wait before
+	// retrying a failed Promotion for the first time if the Stage does not
+	// specify otherwise.
+	defaultPromotionRetryBackoff = 10 * time.Second
+	// defaultPromotionRetryMaxBackoff is the longest length of time to wait
+	// between retries of a failed Promotion if the Stage does not specify
+	// otherwise.
+	defaultPromotionRetryMaxBackoff = 5 * time.Minute
+)
+
+var (
+	// errPromotionAborted is the cause with which the context of a Running
+	// Promotion is canceled when a user requests that it be aborted.
+	errPromotionAborted = errors.New("promotion aborted")
+	// errPromotionTimedOut is the cause with which the context of a Running
+	// Promotion is canceled when it exceeds its Stage's promotion timeout.
+	errPromotionTimedOut = errors.New("promotion timed out")
+)
+
 // reconciler reconciles Promotion resources.
 type reconciler struct {
 	kargoClient     client.Client
 	promoMechanisms promotion.Mechanism
+	recorder        record.EventRecorder
 
 	pqs            *promoQueues
 	initializeOnce sync.Once
 
+	// abortFns holds functions for canceling the contexts of Promotions that
+	// are currently being executed, indexed by Promotion.
+	abortFns   map[types.NamespacedName]context.CancelCauseFunc
+	abortFnsMu sync.Mutex
+
+	// releasedPromos receives Promotions that timed out once their execution has
+	// returned, so the next Promotion for the same Stage can be enqueued.
+	releasedPromos chan event.GenericEvent
+
 	// The following behaviors are overridable for testing purposes:
 
 	promoteFn func(context.Context, kargoapi.Promotion) error
+
+	clearCurrentPromotionFn func(context.Context, *kargoapi.Promotion) error
+
+	getSupersededReasonFn func(context.Context, *kargoapi.Promotion) (string, error)
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -56,6 +104,7 @@ func SetupReconcilerWithManager(
 		kargoMgr.GetClient(),
 		argoMgr.GetClient(),
 		credentialsDB,
+		kargoMgr.GetEventRecorderFor(controller.EventSource),
 	)
 
 	changePredicate := predicate.Or(
@@ -86,6 +135,30 @@ func SetupReconcilerWithManager(
 		return errors.Wrap(err, "unable to watch Promotions")
 	}
 
+	// Watch for Promotions that timed out and have stopped executing, and enqueue
+	// the next highest promotion key
+	if err := c.Watch(
+		&source.Channel{Source: reconciler.releasedPromos},
+		priorityQueueHandler,
+	); err != nil {
+		return errors.Wrap(err, "unable to watch released Promotions")
+	}
+
+	// Watch for requests to abort Promotions that are currently being executed
+	if err := c.Watch(
+		&source.Kind{Type: &kargoapi.Promotion{}},
+		handler.Funcs{
+			UpdateFunc: func(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+				if promo, ok := e.ObjectNew.(*kargoapi.Promotion); ok && promo.IsAbortRequested() {
+					reconciler.abort(client.ObjectKeyFromObject(promo))
+				}
+			},
+		},
+		shardPredicate,
+	); err != nil {
+		return errors.Wrap(err, "unable to watch Promotions")
+	}
+
 	return nil
 }
 
@@ -93,20 +166,30 @@ func newReconciler(
 	kargoClient client.Client,
 	argoClient client.Client,
 	credentialsDB credentials.Database,
+	recorder record.EventRecorder,
 ) *reconciler {
 	pqs := promoQueues{
 		activePromoByStage:        map[types.NamespacedName]string{},
 		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+		abandonedPromoByStage:     map[types.NamespacedName]string{},
 	}
 	r := &reconciler{
 		kargoClient: kargoClient,
 		pqs:         &pqs,
 		promoMechanisms: promotion.NewMechanisms(
+			kargoClient,
 			argoClient,
 			credentialsDB,
 		),
+		recorder: recorder,
+		abortFns: map[types.NamespacedName]context.CancelCauseFunc{},
+		// Buffered so that Promotions are not held up waiting for the watch
+		// to receive them
+		releasedPromos: make(chan event.GenericEvent, 100),
 	}
 	r.promoteFn = r.promote
+	r.clearCurrentPromotionFn = r.clearCurrentPromotion
+	r.getSupersededReasonFn = r.getSupersededReason
 	return r
 }
 
@@ -162,18 +245,77 @@ func (r *reconciler) Reconcile(
 		return result, nil
 	}
 
-	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
-		// anything we've already marked Running, we allow it to continue to reconcile
-	} else if promo.Status.Phase.IsTerminal() {
+	if promo.Status.Phase.IsTerminal() {
 		// if promo is already finished, nothing to do
 		return result, nil
+	}
+
+	if promo.IsAbortRequested() {
+		// The promo was aborted before (or while not) being executed. Move it
+		// straight to the Aborted phase. Once it is terminal, the next promo in
+		// the Stage's queue, if any, will be enqueued.
+		logger.Debug("aborting Promotion")
+		if err = r.clearCurrentPromotionFn(ctx, promo); err != nil {
+			return result, err
+		}
+		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+			status.Phase = kargoapi.PromotionPhaseAborted
+			status.Error = errPromotionAborted.Error()
+			setConditions(status, promo.Generation)
+		}); err != nil {
+			return result, err
+		}
+		stage, err := kargoapi.GetStage(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+		)
+		if err != nil {
+			logger.Errorf("error getting Stage: %s", err)
+		}
+		r.recordPromotionEvent(
+			stage,
+			promo,
+			"",
+			corev1.EventTypeNormal,
+			kargoapi.EventReasonPromotionAborted,
+			"Promotion %s of Freight %s was aborted",
+			promo.Name,
+			promo.Spec.Freight,
+		)
+		return result, nil
+	}
+
+	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
+		// anything we've already marked Running, we allow it to continue to reconcile
 	} else {
-		// promo is Pending. Try to begin it.
+		// promo is Pending. If a newer promo of the same Stage to more recently
+		// created Freight has been queued in the meantime, skip this one. Once it
+		// is terminal, the next promo in the Stage's queue, if any, will be
+		// enqueued.
+		reason, err := r.getSupersededReasonFn(ctx, promo)
+		if err != nil {
+			return result, err
+		}
+		if reason != "" {
+			logger.Debug(reason)
+			err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+				status.Phase = kargoapi.PromotionPhaseSuperseded
+				status.Error = reason
+				setConditions(status, promo.Generation)
+			})
+			return result, err
+		}
+		// Try to begin it.
 		if !r.pqs.tryBegin(ctx, promo) {
 			// It wasn't our turn. Mark this promo as Pending (if it wasn't already)
 			if promo.Status.Phase != kargoapi.PromotionPhasePending {
 				err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 					status.Phase = kargoapi.PromotionPhasePending
+					setConditions(status, promo.Generation)
 				})
 				return result, err
 			}
@@ -185,60 +327,547 @@ func (r *reconciler) Reconcile(
 		"stage":   promo.Spec.Stage,
 		"freight": promo.Spec.Freight,
 	})
-	logger.Debug("executing Promotion")
+
+	// The Stage determines how long the promo may run and whether it is retried
+	// if it fails. If the Stage cannot be found, the defaults apply and the
+	// promo will fail anyway.
+	stage, err := kargoapi.GetStage(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return result, err
+	}
+	timeout, retry := getPromotionTimeoutAndRetry(stage)
+
+	attempt := promo.Status.Attempts + 1
+	attemptStartTime := time.Now()
+	startedMsg := "Started Promotion %s of Freight %s"
+	if promo.Status.Phase == kargoapi.PromotionPhaseRunning &&
+		promo.Status.AttemptStartTime != nil {
+		// An attempt at this promo was already in progress when it was
+		// interrupted; most likely by a restart of the controller. Resume that
+		// attempt from its last completed step unless it has already run for
+		// longer than it is permitted to.
+		attempt = promo.Status.Attempts
+		attemptStartTime = promo.Status.AttemptStartTime.Time
+		logger = logger.WithField("attempt", attempt)
+		if time.Since(attemptStartTime) >= timeout {
+			return result, r.timeOutInterruptedPromotion(ctx, stage, promo, timeout)
+		}
+		logger.Debug("resuming interrupted Promotion")
+		startedMsg = "Resumed Promotion %s of Freight %s"
+	} else {
+		logger = logger.WithField("attempt", attempt)
+		logger.Debug("executing Promotion")
+	}
 
 	// Update promo status as Running to give visibility in UI. Also, a promo which
 	// has already entered Running status will be allowed to continue to reconcile.
-	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
-		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
-			status.Phase = kargoapi.PromotionPhaseRunning
-		}); err != nil {
-			return result, err
-		}
+	if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+		status.Phase = kargoapi.PromotionPhaseRunning
+		status.Attempts = attempt
+		status.AttemptStartTime = &metav1.Time{Time: attemptStartTime}
+		setConditions(status, promo.Generation)
+	}); err != nil {
+		return result, err
+	}
+	if attempt > 1 {
+		startedMsg = fmt.Sprintf("%s (attempt %d)", startedMsg, attempt)
 	}
+	r.recordPromotionEvent(
+		stage,
+		promo,
+		"",
+		corev1.EventTypeNormal,
+		kargoapi.EventReasonPromotionStarted,
+		startedMsg,
+		promo.Name,
+		promo.Spec.Freight,
+	)
 
-	promoCtx := logging.ContextWithLogger(ctx, logger)
+	// The promo's context is canceled if a user requests that it be aborted or
+	// if it times out
+	promoCtx, cancel :=
+		context.WithCancelCause(logging.ContextWithLogger(ctx, logger))
+	r.setAbortFn(req.NamespacedName, cancel)
+	defer func() {
+		r.setAbortFn(req.NamespacedName, nil)
+		cancel(nil)
+	}()
 
-	phase := kargoapi.PromotionPhaseSucceeded
-	phaseError := ""
+	// Record the results of individual steps as they are executed, keeping the
+	// promo's status up to date for the benefit of anyone watching it and so
+	// that an interrupted attempt can be resumed. Steps that succeeded in a
+	// previous attempt are not executed again. Updates are made using a copy of
+	// the promo because, if the promo times out, they may continue after this
+	// function has returned.
+	stepsPromo := promo.DeepCopy()
+	steps := promotion.NewStepRecorder(promo.Status.Steps, func(results []kargoapi.PromotionStepResult) {
+		if promoCtx.Err() != nil {
+			return
+		}
+		if err := kubeclient.PatchStatus(ctx, r.kargoClient, stepsPromo, func(status *kargoapi.PromotionStatus) {
+			status.Steps = results
+		}); err != nil {
+			logger.Errorf("error updating Promotion step results: %s", err)
+		}
+	})
+	promoCtx = promotion.ContextWithStepRecorder(promoCtx, steps)
+	// Promotion mechanisms describe the promo in the commits they make
+	promoCtx = promotion.ContextWithPromotion(promoCtx, promo.DeepCopy())
 
-	// Wrap the promoteFn() call in an anonymous function to recover() any panics, so
-	// we can update the promo's phase with Error if it does. This breaks an infinite
-	// cycle of a bad promo continuously failing to reconcile, and surfaces the error.
-	func() {
+	// Execute the promo in its own goroutine so that we can stop waiting for it
+	// if it exceeds the timeout. Canceling the promo's context stops promotion
+	// mechanisms between steps and kills any git command in progress, but a
+	// single step may still take some time to return. Until it does, the promo
+	// is abandoned rather than concluded, so no other promo for the Stage can
+	// begin and run concurrently with it.
+	type promoteResult struct {
+		err      error
+		panicked bool
+	}
+	stageKey := types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.Stage,
+	}
+	execPromo := promo.DeepCopy()
+	resultCh := make(chan promoteResult, 1)
+	go func() {
+		defer func() {
+			if r.pqs.release(ctx, stageKey, execPromo.Name) {
+				r.releasedPromos <- event.GenericEvent{Object: execPromo}
+			}
+		}()
+		// Recover any panics, so we can update the promo's phase with Error if it
+		// does. This breaks an infinite cycle of a bad promo continuously failing
+		// to reconcile, and surfaces the error.
 		defer func() {
 			if err := recover(); err != nil {
 				logger.Errorf("Promotion panic: %v", err)
-				phase = kargoapi.PromotionPhaseErrored
-				phaseError = fmt.Sprintf("%v", err)
+				resultCh <- promoteResult{
+					err:      errors.Errorf("%v", err),
+					panicked: true,
+				}
 			}
 		}()
-		if err = r.promoteFn(
-			promoCtx,
-			*promo,
-		); err != nil {
+		resultCh <- promoteResult{err: r.promoteFn(promoCtx, *execPromo)}
+	}()
+	var res promoteResult
+	// A resumed attempt is only permitted to run for whatever remains of the
+	// timeout.
+	timer := time.NewTimer(time.Until(attemptStartTime.Add(timeout)))
+	defer timer.Stop()
+	select {
+	case res = <-resultCh:
+	case <-timer.C:
+		r.pqs.abandon(ctx, stageKey, promo.Name)
+		cancel(errPromotionTimedOut)
+		// The promo may have returned just as it timed out, in which case it is
+		// released right away
+		select {
+		case res = <-resultCh:
+			r.pqs.release(ctx, stageKey, promo.Name)
+		default:
+			res = promoteResult{err: errPromotionTimedOut}
+		}
+	}
+
+	phase := kargoapi.PromotionPhaseSucceeded
+	phaseError := ""
+	if res.err != nil {
+		switch cause := context.Cause(promoCtx); {
+		case errors.Is(cause, errPromotionAborted):
+			phase = kargoapi.PromotionPhaseAborted
+			phaseError = errPromotionAborted.Error()
+		case errors.Is(cause, errPromotionTimedOut):
 			phase = kargoapi.PromotionPhaseErrored
-			phaseError = err.Error()
-			logger.Errorf("error executing Promotion: %s", err)
+			phaseError = fmt.Sprintf("%s after %s", errPromotionTimedOut, timeout)
+			logger.Error(phaseError)
+		default:
+			phase = kargoapi.PromotionPhaseErrored
+			phaseError = res.err.Error()
+			logger.Errorf("error executing Promotion: %s", res.err)
 		}
-	}()
+	}
+
+	if phase == kargoapi.PromotionPhaseErrored && !res.panicked &&
+		promotion.IsTransientError(res.err) && attempt <= retry.Limit {
+		// The failure looks transient and the promo has retries left. Leave it
+		// Running so no other promo for the Stage begins, and try again later.
+		backoff := getPromotionRetryBackoff(retry, attempt)
+		logger.Debugf("retrying Promotion in %s", backoff)
+		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+			status.Error = phaseError
+			status.AttemptStartTime = nil
+			status.Steps = steps.Results()
+			setConditions(status, promo.Generation)
+		}); err != nil {
+			logger.Errorf("error updating Promotion status: %s", err)
+			return result, err
+		}
+		return ctrl.Result{RequeueAfter: backoff}, nil
+	}
+
+	// Neither an aborted promo nor one that timed out will have cleared itself
+	// from the Stage's status.
+	if phase == kargoapi.PromotionPhaseAborted ||
+		(phase == kargoapi.PromotionPhaseErrored &&
+			errors.Is(context.Cause(promoCtx), errPromotionTimedOut)) {
+		if err = r.clearCurrentPromotionFn(ctx, promo); err != nil {
+			return result, err
+		}
+	}
 
 	if phase.IsTerminal() {
 		logger.Debugf("promotion %s", phase)
 	}
 
+	stepResults := steps.Results()
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 		status.Phase = phase
 		status.Error = phaseError
+		status.AttemptStartTime = nil
+		status.Steps = stepResults
+		setConditions(status, promo.Generation)
 	})
 	if err != nil {
 		logger.Errorf("error updating Promotion status: %s", err)
 	}
 
+	switch phase {
+	case kargoapi.PromotionPhaseSucceeded:
+		r.recordPromotionEvent(
+			stage,
+			promo,
+			"",
+			corev1.EventTypeNormal,
+			kargoapi.EventReasonPromotionSucceeded,
+			"Promotion %s of Freight %s succeeded",
+			promo.Name,
+			promo.Spec.Freight,
+		)
+	case kargoapi.PromotionPhaseAborted:
+		r.recordPromotionEvent(
+			stage,
+			promo,
+			"",
+			corev1.EventTypeNormal,
+			kargoapi.EventReasonPromotionAborted,
+			"Promotion %s of Freight %s was aborted",
+			promo.Name,
+			promo.Spec.Freight,
+		)
+	case kargoapi.PromotionPhaseErrored:
+		if mechanism := getFailedMechanism(stepResults); mechanism != "" {
+			r.recordPromotionEvent(
+				stage,
+				promo,
+				mechanism,
+				corev1.EventTypeWarning,
+				kargoapi.EventReasonPromotionErrored,
+				"Promotion %s of Freight %s failed in %s: %s",
+				promo.Name,
+				promo.Spec.Freight,
+				mechanism,
+				phaseError,
+			)
+		} else {
+			r.recordPromotionEvent(
+				stage,
+				promo,
+				"",
+				corev1.EventTypeWarning,
+				kargoapi.EventReasonPromotionErrored,
+				"Promotion %s of Freight %s failed: %s",
+				promo.Name,
+				promo.Spec.Freight,
+				phaseError,
+			)
+		}
+	}
+
 	// Controller runtime automatically gives us a progressive backoff if err is not nil
 	return result, err
 }
 
+// timeOutInterruptedPromotion fails the provided Promotion, an attempt at
+// which was interrupted and has since exceeded the provided timeout. Results
+// of steps recorded by the interrupted attempt are retained.
+func (r *reconciler) timeOutInterruptedPromotion(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	timeout time.Duration,
+) error {
+	phaseError := fmt.Sprintf("%s after %s", errPromotionTimedOut, timeout)
+	logging.LoggerFromContext(ctx).Errorf(
+		"error resuming interrupted Promotion: %s",
+		phaseError,
+	)
+	if err := r.clearCurrentPromotionFn(ctx, promo); err != nil {
+		return err
+	}
+	if err := kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+		status.Phase = kargoapi.PromotionPhaseErrored
+		status.Error = phaseError
+		status.AttemptStartTime = nil
+		setConditions(status, promo.Generation)
+	}); err != nil {
+		return err
+	}
+	r.recordPromotionEvent(
+		stage,
+		promo,
+		"",
+		corev1.EventTypeWarning,
+		kargoapi.EventReasonPromotionErrored,
+		"Promotion %s of Freight %s failed: %s",
+		promo.Name,
+		promo.Spec.Freight,
+		phaseError,
+	)
+	return nil
+}
+
+// getPromotionTimeoutAndRetry returns the timeout and retry policy that apply
+// to Promotions to the provided Stage, which may be nil. Defaults are filled in
+// for anything the Stage leaves unspecified. A Stage that does not specify a
+// retry policy yields a policy that permits no retries.
+func getPromotionTimeoutAndRetry(
+	stage *kargoapi.Stage,
+) (time.Duration, kargoapi.PromotionRetry) {
+	timeout := defaultPromotionTimeout
+	retry := kargoapi.PromotionRetry{}
+	if stage == nil || stage.Spec == nil || stage.Spec.PromotionMechanisms == nil {
+		return timeout, retry
+	}
+	mechs := stage.Spec.PromotionMechanisms
+	if mechs.Timeout != nil && mechs.Timeout.Duration > 0 {
+		timeout = mechs.Timeout.Duration
+	}
+	if mechs.Retry != nil {
+		retry = *mechs.Retry
+	}
+	return timeout, retry
+}
+
+// getPromotionRetryBackoff returns the length of time to wait before retrying
+// a Promotion whose specified attempt has failed. The wait doubles with each
+// attempt, up to the maximum specified by the provided retry policy.
+func getPromotionRetryBackoff(
+	retry kargoapi.PromotionRetry,
+	attempt int32,
+) time.Duration {
+	backoff := defaultPromotionRetryBackoff
+	if retry.Backoff != nil && retry.Backoff.Duration > 0 {
+		backoff = retry.Backoff.Duration
+	}
+	maxBackoff := defaultPromotionRetryMaxBackoff
+	if retry.MaxBackoff != nil && retry.MaxBackoff.Duration > 0 {
+		maxBackoff = retry.MaxBackoff.Duration
+	}
+	for i := int32(1); i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// getFailedMechanism returns the name of the promotion mechanism that executed
+// the last failed step among the provided step results, if any step failed.
+func getFailedMechanism(results []kargoapi.PromotionStepResult) string {
+	for i := len(results) - 1; i >= 0; i-- {
+		if results[i].Outcome == kargoapi.PromotionStepOutcomeErrored {
+			return results[i].Mechanism
+		}
+	}
+	return ""
+}
+
+// recordPromotionEvent records a Kubernetes Event concerning the provided
+// Promotion. The Event is recorded against the Promotion's Stage, if the Stage
+// is provided, so that it is visible when the Stage is described. Otherwise,
+// it is recorded against the Promotion itself. Either way, the Event is
+// annotated with the names of the Stage, Freight, and Promotion, along with
+// the name of the failed promotion mechanism, if one is provided.
+func (r *reconciler) recordPromotionEvent(
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	mechanism string,
+	eventType string,
+	reason string,
+	messageFmt string,
+	args ...any,
+) {
+	annotations := map[string]string{
+		kargoapi.AnnotationKeyEventStage:     promo.Spec.Stage,
+		kargoapi.AnnotationKeyEventFreight:   promo.Spec.Freight,
+		kargoapi.AnnotationKeyEventPromotion: promo.Name,
+	}
+	if mechanism != "" {
+		annotations[kargoapi.AnnotationKeyEventMechanism] = mechanism
+	}
+	var obj k8sruntime.Object = promo
+	if stage != nil {
+		obj = stage
+	}
+	r.recorder.AnnotatedEventf(obj, annotations, eventType, reason, messageFmt, args...)
+}
+
+// setAbortFn records the function that cancels the context of the specified
+// Promotion while it is being executed. A nil function removes the record.
+func (r *reconciler) setAbortFn(
+	promoKey types.NamespacedName,
+	fn context.CancelCauseFunc,
+) {
+	r.abortFnsMu.Lock()
+	defer r.abortFnsMu.Unlock()
+	if fn == nil {
+		delete(r.abortFns, promoKey)
+		return
+	}
+	r.abortFns[promoKey] = fn
+}
+
+// abort cancels the context of the specified Promotion if it is currently
+// being executed. Promotion mechanisms stop between steps once the context has
+// been canceled.
+func (r *reconciler) abort(promoKey types.NamespacedName) {
+	r.abortFnsMu.Lock()
+	defer r.abortFnsMu.Unlock()
+	if fn, ok := r.abortFns[promoKey]; ok {
+		fn(errPromotionAborted)
+	}
+}
+
+// clearCurrentPromotion clears the provided Promotion from the status of its
+// Stage, if it is recorded there as the Stage's current Promotion.
+func (r *reconciler) clearCurrentPromotion(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	stage, err := kargoapi.GetStage(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if stage == nil || stage.Status.CurrentPromotion == nil ||
+		stage.Status.CurrentPromotion.Name != promo.Name {
+		return nil
+	}
+	return errors.Wrapf(
+		kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
+			status.CurrentPromotion = nil
+		}),
+		"error clearing current Promotion of Stage %q in namespace %q",
+		stage.Name,
+		stage.Namespace,
+	)
+}
+
+// getSupersededReason returns a non-empty explanation if the provided Pending
+// Promotion is supersedable and another non-terminal Promotion of the same
+// Stage, queued after it, is to Freight that was created more recently than
+// its own. If several such Promotions exist, the explanation names the one to
+// the most recently created Freight.
+func (r *reconciler) getSupersededReason(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (string, error) {
+	if !promo.Spec.Supersedable {
+		return "", nil
+	}
+	freight, err := kargoapi.GetFreight(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Freight,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	if freight == nil {
+		// The promo will fail when it is executed
+		return "", nil
+	}
+	promos := kargoapi.PromotionList{}
+	if err = r.kargoClient.List(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: promo.Namespace,
+			FieldSelector: fields.Set(map[string]string{
+				kubeclient.NonTerminalPromotionsByStageIndexField: promo.Spec.Stage,
+			}).AsSelector(),
+		},
+	); err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error listing Promotions in non-terminal phases for Stage %q in "+
+				"namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
+	}
+	var newerPromo *kargoapi.Promotion
+	var newerFreight *kargoapi.Freight
+	for i := range promos.Items {
+		p := &promos.Items[i]
+		if p.Name == promo.Name || p.Spec == nil ||
+			p.Spec.Stage != promo.Spec.Stage || p.Status.Phase.IsTerminal() ||
+			!queuedBefore(promo, p) {
+			continue
+		}
+		f, err := kargoapi.GetFreight(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: p.Namespace,
+				Name:      p.Spec.Freight,
+			},
+		)
+		if err != nil {
+			return "", err
+		}
+		if f == nil ||
+			!f.CreationTimestamp.After(freight.CreationTimestamp.Time) {
+			continue
+		}
+		if newerFreight == nil ||
+			f.CreationTimestamp.After(newerFreight.CreationTimestamp.Time) {
+			newerPromo = p
+			newerFreight = f
+		}
+	}
+	if newerPromo == nil {
+		return "", nil
+	}
+	return fmt.Sprintf(
+		"superseded by Promotion %q to Freight %q, which was created at %s, "+
+			"after Freight %q was created at %s",
+		newerPromo.Name,
+		newerFreight.Name,
+		newerFreight.CreationTimestamp.UTC().Format(time.RFC3339),
+		freight.Name,
+		freight.CreationTimestamp.UTC().Format(time.RFC3339),
+	), nil
+}
+
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
@@ -290,6 +919,7 @@ func (r *reconciler) promote(
 			Name:      promo.Spec.Freight,
 		},
 		upstreamStages,
+		stage.Spec.Subscriptions.QualificationRule,
 	)
 	if err != nil {
 		return err
@@ -309,6 +939,44 @@ func (r *reconciler) promote(
 		Charts:  targetFreight.Charts,
 	}
 
+	// If only some of the Freight's artifacts are to be promoted, the Stage
+	// instead transitions into Freight derived from those artifacts and the
+	// remaining artifacts of its current Freight
+	if promo.Spec.Artifacts != nil {
+		if simpleTargetFreight, err = kargo.ComposeFreight(
+			simpleTargetFreight,
+			stage.Status.CurrentFreight,
+			*promo.Spec.Artifacts,
+		); err != nil {
+			return errors.Wrapf(
+				err,
+				"error composing Freight from selected artifacts of Freight %q",
+				freightName,
+			)
+		}
+		if simpleTargetFreight.ID != targetFreight.ID {
+			if err = r.createDerivedFreight(
+				ctx,
+				targetFreight,
+				simpleTargetFreight,
+			); err != nil {
+				return err
+			}
+			if err = kubeclient.PatchStatus(ctx, r.kargoClient, &promo, func(status *kargoapi.PromotionStatus) {
+				status.DerivedFreight = simpleTargetFreight.ID
+			}); err != nil {
+				return err
+			}
+			logger = logger.WithField("derivedFreight", simpleTargetFreight.ID)
+			logger.Debug("composed derived Freight from selected artifacts")
+		}
+		if stage.Status.CurrentFreight != nil &&
+			stage.Status.CurrentFreight.ID == simpleTargetFreight.ID {
+			logger.Debug("Stage already has the desired Freight")
+			return nil
+		}
+	}
+
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
 		status.CurrentPromotion = &kargoapi.PromotionInfo{
 			Name:    promo.Name,
@@ -324,6 +992,12 @@ func (r *reconciler) promote(
 		return err
 	}
 
+	// If the Promotion was aborted or timed out while the promotion mechanisms
+	// were executing, its outcome must not be recorded.
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+
 	// The assumption is that controller does not process multiple promotions in one stage
 	// so we are safe from race conditions and can just update the status
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
@@ -335,6 +1009,7 @@ func (r *reconciler) promote(
 		if stage.Spec.PromotionMechanisms != nil {
 			status.CurrentFreight = &nextFreight
 			status.History.Push(nextFreight)
+			status.LastPromotionTime = &metav1.Time{Time: time.Now()}
 		}
 	})
 
@@ -345,3 +1020,42 @@ func (r *reconciler) promote(
 		stageNamespace,
 	)
 }
+
+// createDerivedFreight creates Freight referencing the artifacts of the
+// provided derived Freight, which was composed from some of the artifacts of
+// the provided source Freight by a partial promotion, unless such Freight
+// already exists. The derived Freight is labeled as such and, since no
+// Warehouse produced it and no Stage has yet verified it, it has neither an
+// owner nor any qualifications. This keeps it from ever being auto-promoted.
+func (r *reconciler) createDerivedFreight(
+	ctx context.Context,
+	source *kargoapi.Freight,
+	derived kargoapi.SimpleFreight,
+) error {
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: source.Namespace,
+			Name:      derived.ID,
+			Labels: map[string]string{
+				kargoapi.LabelKeyDerivedFreight: kargoapi.LabelTrueValue,
+			},
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyDerivedFrom: source.Name,
+			},
+		},
+		ID:      derived.ID,
+		Commits: derived.Commits,
+		Images:  derived.Images,
+		Charts:  derived.Charts,
+	}
+	if err := r.kargoClient.Create(ctx, freight); err != nil &&
+		!apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(
+			err,
+			"error creating derived Freight %q in namespace %q",
+			freight.Name,
+			freight.Namespace,
+		)
+	}
+	return nil
+}
@@ -3,11 +3,15 @@ package promotions
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -15,6 +19,7 @@ import (
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/kargo"
 )
 
 func TestNewPromotionReconciler(t *testing.T) {
@@ -23,10 +28,15 @@ func TestNewPromotionReconciler(t *testing.T) {
 		kubeClient,
 		kubeClient,
 		&credentials.FakeDB{},
+		&record.FakeRecorder{},
 	)
 	require.NotNil(t, r.kargoClient)
+	require.NotNil(t, r.recorder)
 	require.NotNil(t, r.pqs.pendingPromoQueuesByStage)
+	require.NotNil(t, r.abortFns)
 	require.NotNil(t, r.promoteFn)
+	require.NotNil(t, r.clearCurrentPromotionFn)
+	require.NotNil(t, r.getSupersededReasonFn)
 }
 
 func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
@@ -38,6 +48,7 @@ func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
 		kargoClient,
 		kubeClient,
 		&credentials.FakeDB{},
+		record.NewFakeRecorder(10),
 	)
 }
 
@@ -49,11 +60,16 @@ func TestReconcile(t *testing.T) {
 		promoToReconcile      *types.NamespacedName // if nil, uses the first of the promos
 		expectPromoteFnCalled bool
 		expectedPhase         kargoapi.PromotionPhase
+		expectedEventReasons  []string
 	}{
 		{
 			name:                  "normal reconcile",
 			expectPromoteFnCalled: true,
 			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionStarted,
+				kargoapi.EventReasonPromotionSucceeded,
+			},
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
 			},
@@ -120,6 +136,10 @@ func TestReconcile(t *testing.T) {
 			promoteFn: func(ctx context.Context, p v1alpha1.Promotion) error {
 				return errors.New("expected error")
 			},
+			expectedEventReasons: []string{
+				kargoapi.EventReasonPromotionStarted,
+				kargoapi.EventReasonPromotionErrored,
+			},
 		},
 	}
 	for _, tc := range testCases {
@@ -159,6 +179,14 @@ func TestReconcile(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
 			}
+
+			if tc.expectedEventReasons != nil {
+				events := r.recorder.(*record.FakeRecorder).Events // nolint: forcetypeassert
+				require.Len(t, events, len(tc.expectedEventReasons))
+				for _, reason := range tc.expectedEventReasons {
+					require.Equal(t, reason, strings.Fields(<-events)[1])
+				}
+			}
 		})
 	}
 }
@@ -182,3 +210,775 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+func TestReconcileAbort(t *testing.T) {
+	abortedPromo := func(phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		promo := newPromo("fake-namespace", "fake-promo", "fake-stage", phase, now)
+		promo.Annotations = map[string]string{
+			kargoapi.AnnotationKeyAbort: kargoapi.LabelTrueValue,
+		}
+		return promo
+	}
+	testCases := []struct {
+		name                  string
+		promo                 *kargoapi.Promotion
+		promoteFn             func(*reconciler) func(context.Context, v1alpha1.Promotion) error
+		expectPromoteFnCalled bool
+		expectedPhase         kargoapi.PromotionPhase
+	}{
+		{
+			name:          "pending promo aborted",
+			promo:         abortedPromo(kargoapi.PromotionPhasePending),
+			expectedPhase: kargoapi.PromotionPhaseAborted,
+		},
+		{
+			name:          "running promo aborted while not executing",
+			promo:         abortedPromo(kargoapi.PromotionPhaseRunning),
+			expectedPhase: kargoapi.PromotionPhaseAborted,
+		},
+		{
+			name:  "already terminal promo not aborted",
+			promo: abortedPromo(kargoapi.PromotionPhaseSucceeded),
+			// The phase is left unchanged
+			expectedPhase: kargoapi.PromotionPhaseSucceeded,
+		},
+		{
+			name:  "running promo aborted while executing",
+			promo: newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now),
+			promoteFn: func(r *reconciler) func(context.Context, v1alpha1.Promotion) error {
+				return func(ctx context.Context, p v1alpha1.Promotion) error {
+					r.abort(client.ObjectKeyFromObject(&p))
+					<-ctx.Done()
+					return ctx.Err()
+				}
+			},
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseAborted,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(t, tc.promo)
+			promoteWasCalled := false
+			r.promoteFn = func(ctx context.Context, p v1alpha1.Promotion) error {
+				promoteWasCalled = true
+				if tc.promoteFn != nil {
+					return tc.promoteFn(r)(ctx, p)
+				}
+				return nil
+			}
+			var clearWasCalled bool
+			r.clearCurrentPromotionFn = func(context.Context, *kargoapi.Promotion) error {
+				clearWasCalled = true
+				return nil
+			}
+			req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tc.promo)}
+			_, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPromoteFnCalled, promoteWasCalled)
+			require.Equal(
+				t,
+				tc.expectedPhase == kargoapi.PromotionPhaseAborted,
+				clearWasCalled,
+			)
+			require.Empty(t, r.abortFns)
+
+			var updatedPromo kargoapi.Promotion
+			require.NoError(t, r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo))
+			require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+		})
+	}
+}
+
+func TestReconcileTimeoutAndRetry(t *testing.T) {
+	newStage := func(mechs *kargoapi.PromotionMechanisms) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: mechs,
+			},
+		}
+	}
+	transientErr := errors.New("dial tcp: connection refused")
+	testCases := []struct {
+		name              string
+		stage             *kargoapi.Stage
+		attempts          int32
+		attemptStartTime  *metav1.Time
+		promoteFn         func(context.Context, v1alpha1.Promotion) error
+		expectedPhase     kargoapi.PromotionPhase
+		expectedAttempts  int32
+		expectedError     string
+		expectedRequeue   time.Duration
+		expectClearCalled bool
+	}{
+		{
+			name: "promo times out",
+			stage: newStage(&kargoapi.PromotionMechanisms{
+				Timeout: &metav1.Duration{Duration: 10 * time.Millisecond},
+				Retry:   &kargoapi.PromotionRetry{Limit: 3},
+			}),
+			promoteFn: func(ctx context.Context, _ v1alpha1.Promotion) error {
+				// Simulate a step that hangs until well after the timeout
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Minute):
+				}
+				return ctx.Err()
+			},
+			expectedPhase:     kargoapi.PromotionPhaseErrored,
+			expectedAttempts:  1,
+			expectedError:     "promotion timed out after 10ms",
+			expectClearCalled: true,
+		},
+		{
+			name: "transient error with retries left",
+			stage: newStage(&kargoapi.PromotionMechanisms{
+				Retry: &kargoapi.PromotionRetry{
+					Limit:   2,
+					Backoff: &metav1.Duration{Duration: time.Second},
+				},
+			}),
+			attempts: 1,
+			promoteFn: func(context.Context, v1alpha1.Promotion) error {
+				return transientErr
+			},
+			expectedPhase:    kargoapi.PromotionPhaseRunning,
+			expectedAttempts: 2,
+			expectedError:    transientErr.Error(),
+			expectedRequeue:  2 * time.Second,
+		},
+		{
+			name: "transient error with no retries left",
+			stage: newStage(&kargoapi.PromotionMechanisms{
+				Retry: &kargoapi.PromotionRetry{Limit: 2},
+			}),
+			attempts: 2,
+			promoteFn: func(context.Context, v1alpha1.Promotion) error {
+				return transientErr
+			},
+			expectedPhase:    kargoapi.PromotionPhaseErrored,
+			expectedAttempts: 3,
+			expectedError:    transientErr.Error(),
+		},
+		{
+			name:  "transient error with no retry policy",
+			stage: newStage(&kargoapi.PromotionMechanisms{}),
+			promoteFn: func(context.Context, v1alpha1.Promotion) error {
+				return transientErr
+			},
+			expectedPhase:    kargoapi.PromotionPhaseErrored,
+			expectedAttempts: 1,
+			expectedError:    transientErr.Error(),
+		},
+		{
+			name: "non-transient error",
+			stage: newStage(&kargoapi.PromotionMechanisms{
+				Retry: &kargoapi.PromotionRetry{Limit: 2},
+			}),
+			promoteFn: func(context.Context, v1alpha1.Promotion) error {
+				return errors.New("something went wrong")
+			},
+			expectedPhase:    kargoapi.PromotionPhaseErrored,
+			expectedAttempts: 1,
+			expectedError:    "something went wrong",
+		},
+		{
+			name: "interrupted promo resumed",
+			stage: newStage(&kargoapi.PromotionMechanisms{
+				Timeout: &metav1.Duration{Duration: time.Hour},
+			}),
+			attempts:         1,
+			attemptStartTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			promoteFn: func(context.Context, v1alpha1.Promotion) error {
+				return nil
+			},
+			expectedPhase:    kargoapi.PromotionPhaseSucceeded,
+			expectedAttempts: 1,
+		},
+		{
+			name: "orphaned promo times out",
+			stage: newStage(&kargoapi.PromotionMechanisms{
+				Timeout: &metav1.Duration{Duration: time.Minute},
+				Retry:   &kargoapi.PromotionRetry{Limit: 3},
+			}),
+			attempts:         1,
+			attemptStartTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			promoteFn: func(context.Context, v1alpha1.Promotion) error {
+				require.Fail(t, "orphaned promo should not be resumed")
+				return nil
+			},
+			expectedPhase:     kargoapi.PromotionPhaseErrored,
+			expectedAttempts:  1,
+			expectedError:     "promotion timed out after 1m0s",
+			expectClearCalled: true,
+		},
+		{
+			name: "success after retry",
+			stage: newStage(&kargoapi.PromotionMechanisms{
+				Retry: &kargoapi.PromotionRetry{Limit: 2},
+			}),
+			attempts: 1,
+			promoteFn: func(context.Context, v1alpha1.Promotion) error {
+				return nil
+			},
+			expectedPhase:    kargoapi.PromotionPhaseSucceeded,
+			expectedAttempts: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			promo := newPromo(
+				"fake-namespace",
+				"fake-promo",
+				"fake-stage",
+				kargoapi.PromotionPhaseRunning,
+				now,
+			)
+			promo.Status.Attempts = tc.attempts
+			promo.Status.AttemptStartTime = tc.attemptStartTime
+			r := newFakeReconciler(t, promo, tc.stage)
+			r.promoteFn = tc.promoteFn
+			var clearWasCalled bool
+			r.clearCurrentPromotionFn = func(context.Context, *kargoapi.Promotion) error {
+				clearWasCalled = true
+				return nil
+			}
+			req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(promo)}
+			res, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRequeue, res.RequeueAfter)
+			require.Equal(t, tc.expectClearCalled, clearWasCalled)
+
+			var updatedPromo kargoapi.Promotion
+			require.NoError(t, r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo))
+			require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+			require.Equal(t, tc.expectedAttempts, updatedPromo.Status.Attempts)
+			require.Equal(t, tc.expectedError, updatedPromo.Status.Error)
+			// No attempt remains in progress
+			require.Nil(t, updatedPromo.Status.AttemptStartTime)
+		})
+	}
+}
+
+func TestReconcileTimedOutPromoHoldsQueue(t *testing.T) {
+	ctx := context.TODO()
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Timeout: &metav1.Duration{Duration: 10 * time.Millisecond},
+			},
+		},
+	}
+	promo := newPromo(
+		"fake-namespace",
+		"fake-promo",
+		"fake-stage",
+		kargoapi.PromotionPhasePending,
+		now,
+	)
+	nextPromo := newPromo(
+		"fake-namespace",
+		"next-fake-promo",
+		"fake-stage",
+		kargoapi.PromotionPhasePending,
+		metav1.NewTime(now.Add(time.Second)),
+	)
+	r := newFakeReconciler(t, promo, nextPromo, stage)
+	r.getSupersededReasonFn = func(context.Context, *kargoapi.Promotion) (string, error) {
+		return "", nil
+	}
+	r.clearCurrentPromotionFn = func(context.Context, *kargoapi.Promotion) error {
+		return nil
+	}
+	// Simulate a step that does not stop when the promo's context is canceled
+	unblockCh := make(chan struct{})
+	r.promoteFn = func(context.Context, v1alpha1.Promotion) error {
+		<-unblockCh
+		return nil
+	}
+
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(promo)}
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	var updatedPromo kargoapi.Promotion
+	require.NoError(t, r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo))
+	require.Equal(t, kargoapi.PromotionPhaseErrored, updatedPromo.Status.Phase)
+
+	// The promo is concluded once it is terminal, but the next one cannot begin
+	// while the first one is still executing
+	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
+	r.pqs.conclude(ctx, stageKey, promo.Name)
+	require.False(t, r.pqs.tryBegin(ctx, nextPromo))
+
+	// Once the first promo returns, it is released and the next one can begin
+	close(unblockCh)
+	select {
+	case evt := <-r.releasedPromos:
+		require.Equal(t, promo.Name, evt.Object.GetName())
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out promo was never released")
+	}
+	require.True(t, r.pqs.tryBegin(ctx, nextPromo))
+}
+
+func TestGetPromotionRetryBackoff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		retry    kargoapi.PromotionRetry
+		attempt  int32
+		expected time.Duration
+	}{
+		{
+			name:     "defaults",
+			attempt:  1,
+			expected: defaultPromotionRetryBackoff,
+		},
+		{
+			name: "doubles with each attempt",
+			retry: kargoapi.PromotionRetry{
+				Backoff: &metav1.Duration{Duration: time.Second},
+			},
+			attempt:  4,
+			expected: 8 * time.Second,
+		},
+		{
+			name: "capped at max backoff",
+			retry: kargoapi.PromotionRetry{
+				Backoff:    &metav1.Duration{Duration: time.Second},
+				MaxBackoff: &metav1.Duration{Duration: 5 * time.Second},
+			},
+			attempt:  10,
+			expected: 5 * time.Second,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expected,
+				getPromotionRetryBackoff(tc.retry, tc.attempt),
+			)
+		})
+	}
+}
+
+func TestReconcileSuperseded(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		promo                 *kargoapi.Promotion
+		expectPromoteFnCalled bool
+		expectedPhase         kargoapi.PromotionPhase
+	}{
+		{
+			name:          "pending promo superseded",
+			promo:         newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+			expectedPhase: kargoapi.PromotionPhaseSuperseded,
+		},
+		{
+			name:                  "running promo not superseded",
+			promo:                 newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now),
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(t, tc.promo)
+			promoteWasCalled := false
+			r.promoteFn = func(context.Context, v1alpha1.Promotion) error {
+				promoteWasCalled = true
+				return nil
+			}
+			r.getSupersededReasonFn = func(context.Context, *kargoapi.Promotion) (string, error) {
+				return "superseded", nil
+			}
+			req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tc.promo)}
+			_, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPromoteFnCalled, promoteWasCalled)
+
+			var updatedPromo kargoapi.Promotion
+			require.NoError(t, r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo))
+			require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+			if tc.expectedPhase == kargoapi.PromotionPhaseSuperseded {
+				require.Equal(t, "superseded", updatedPromo.Status.Error)
+				// The promo never became active
+				require.Empty(t, r.pqs.activePromoByStage)
+			}
+		})
+	}
+}
+
+func TestGetSupersededReason(t *testing.T) {
+	newFreight := func(name string, created metav1.Time) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-namespace",
+				Name:              name,
+				CreationTimestamp: created,
+			},
+		}
+	}
+	supersedablePromo := func(
+		name string,
+		phase kargoapi.PromotionPhase,
+		created metav1.Time,
+		freight string,
+	) *kargoapi.Promotion {
+		promo := newPromo("fake-namespace", name, "fake-stage", phase, created)
+		promo.Spec.Freight = freight
+		promo.Spec.Supersedable = true
+		return promo
+	}
+	later := metav1.Time{Time: now.Add(time.Second)}
+	testCases := []struct {
+		name       string
+		promo      *kargoapi.Promotion
+		objects    []client.Object
+		assertions func(string, error)
+	}{
+		{
+			name: "promo is not supersedable",
+			promo: func() *kargoapi.Promotion {
+				promo := supersedablePromo("fake-promo", kargoapi.PromotionPhasePending, before, "old-freight")
+				promo.Spec.Supersedable = false
+				return promo
+			}(),
+			objects: []client.Object{
+				newFreight("old-freight", before),
+				newFreight("new-freight", now),
+				supersedablePromo("newer-promo", kargoapi.PromotionPhasePending, now, "new-freight"),
+			},
+			assertions: func(reason string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reason)
+			},
+		},
+		{
+			name:  "no newer promos",
+			promo: supersedablePromo("fake-promo", kargoapi.PromotionPhasePending, now, "new-freight"),
+			objects: []client.Object{
+				newFreight("old-freight", before),
+				newFreight("new-freight", now),
+				supersedablePromo("older-promo", kargoapi.PromotionPhasePending, before, "old-freight"),
+			},
+			assertions: func(reason string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reason)
+			},
+		},
+		{
+			name:  "newer promo is to older Freight",
+			promo: supersedablePromo("fake-promo", kargoapi.PromotionPhasePending, before, "new-freight"),
+			objects: []client.Object{
+				newFreight("old-freight", before),
+				newFreight("new-freight", now),
+				supersedablePromo("newer-promo", kargoapi.PromotionPhasePending, now, "old-freight"),
+			},
+			assertions: func(reason string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reason)
+			},
+		},
+		{
+			name:  "newer promo is terminal or for another Stage",
+			promo: supersedablePromo("fake-promo", kargoapi.PromotionPhasePending, before, "old-freight"),
+			objects: []client.Object{
+				newFreight("old-freight", before),
+				newFreight("new-freight", now),
+				supersedablePromo("newer-promo", kargoapi.PromotionPhaseErrored, now, "new-freight"),
+				func() *kargoapi.Promotion {
+					promo := supersedablePromo("other-promo", kargoapi.PromotionPhasePending, now, "new-freight")
+					promo.Spec.Stage = "other-stage"
+					return promo
+				}(),
+			},
+			assertions: func(reason string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reason)
+			},
+		},
+		{
+			name:  "superseded by promo to most recently created Freight",
+			promo: supersedablePromo("fake-promo", kargoapi.PromotionPhasePending, before, "old-freight"),
+			objects: []client.Object{
+				newFreight("old-freight", before),
+				newFreight("new-freight", now),
+				newFreight("newest-freight", later),
+				supersedablePromo("newer-promo", kargoapi.PromotionPhasePending, now, "new-freight"),
+				supersedablePromo("newest-promo", "", later, "newest-freight"),
+			},
+			assertions: func(reason string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, reason, `superseded by Promotion "newest-promo"`)
+				require.Contains(t, reason, `Freight "newest-freight"`)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newFakeReconciler(t, append(tc.objects, tc.promo)...)
+			tc.assertions(r.getSupersededReason(context.TODO(), tc.promo))
+		})
+	}
+}
+
+func TestCreateDerivedFreight(t *testing.T) {
+	source := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-source",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: kargoapi.GroupVersion.String(),
+				Kind:       "Warehouse",
+				Name:       "fake-warehouse",
+			}},
+		},
+		Status: kargoapi.FreightStatus{
+			Qualifications: map[string]kargoapi.Qualification{
+				"upstream-stage": {},
+			},
+		},
+	}
+	derived := kargoapi.SimpleFreight{
+		Images: []kargoapi.Image{{RepoURL: "fake-image", Tag: "fake-tag"}},
+	}
+	derived.UpdateID()
+	testCases := []struct {
+		name                   string
+		objects                []client.Object
+		expectedQualifications map[string]kargoapi.Qualification
+	}{
+		{
+			name: "derived Freight created",
+		},
+		{
+			name: "derived Freight already exists",
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      derived.ID,
+					},
+					ID:     derived.ID,
+					Images: derived.Images,
+					Status: kargoapi.FreightStatus{
+						Qualifications: map[string]kargoapi.Qualification{
+							"other-stage": {},
+						},
+					},
+				},
+			},
+			expectedQualifications: map[string]kargoapi.Qualification{
+				"other-stage": {},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(t, tc.objects...)
+			require.NoError(t, r.createDerivedFreight(ctx, source, derived))
+			freight := &kargoapi.Freight{}
+			require.NoError(t, r.kargoClient.Get(
+				ctx,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      derived.ID,
+				},
+				freight,
+			))
+			require.Equal(t, derived.ID, freight.ID)
+			require.Equal(t, derived.Images, freight.Images)
+			// Qualifications are never inherited from the source Freight
+			require.Equal(
+				t,
+				tc.expectedQualifications,
+				freight.Status.Qualifications,
+			)
+			if tc.objects == nil {
+				// Derived Freight is not owned by the source Freight's Warehouse
+				require.Empty(t, freight.OwnerReferences)
+				require.True(t, kargo.IsDerivedFreight(*freight))
+				require.Equal(
+					t,
+					source.Name,
+					freight.Annotations[kargoapi.AnnotationKeyDerivedFrom],
+				)
+			}
+		})
+	}
+}
+
+func TestClearCurrentPromotion(t *testing.T) {
+	newStage := func(currentPromo string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+		}
+		if currentPromo != "" {
+			stage.Status.CurrentPromotion = &kargoapi.PromotionInfo{Name: currentPromo}
+		}
+		return stage
+	}
+	testCases := []struct {
+		name                 string
+		stage                *kargoapi.Stage
+		expectedCurrentPromo *kargoapi.PromotionInfo
+	}{
+		{
+			name:  "Stage's current Promotion is cleared",
+			stage: newStage("fake-promo"),
+		},
+		{
+			name:                 "other Promotion is left alone",
+			stage:                newStage("other-promo"),
+			expectedCurrentPromo: &kargoapi.PromotionInfo{Name: "other-promo"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(t, tc.stage)
+			require.NoError(
+				t,
+				r.clearCurrentPromotion(
+					ctx,
+					newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now),
+				),
+			)
+			var stage kargoapi.Stage
+			require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(tc.stage), &stage))
+			require.Equal(t, tc.expectedCurrentPromo, stage.Status.CurrentPromotion)
+		})
+	}
+}
+
+type fakeAnnotatingRecorder struct {
+	record.FakeRecorder
+	object      k8sruntime.Object
+	annotations map[string]string
+}
+
+func (f *fakeAnnotatingRecorder) AnnotatedEventf(
+	object k8sruntime.Object,
+	annotations map[string]string,
+	eventtype string,
+	reason string,
+	messageFmt string,
+	args ...any,
+) {
+	f.object = object
+	f.annotations = annotations
+	f.FakeRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func TestRecordPromotionEvent(t *testing.T) {
+	promo := newPromo(
+		"fake-namespace",
+		"fake-promo",
+		"fake-stage",
+		kargoapi.PromotionPhaseErrored,
+		now,
+	)
+	promo.Spec.Freight = "fake-freight"
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testCases := []struct {
+		name           string
+		stage          *kargoapi.Stage
+		mechanism      string
+		expectedObject k8sruntime.Object
+		expectedAnns   map[string]string
+	}{
+		{
+			name:           "Stage unknown",
+			expectedObject: promo,
+			expectedAnns: map[string]string{
+				kargoapi.AnnotationKeyEventStage:     "fake-stage",
+				kargoapi.AnnotationKeyEventFreight:   "fake-freight",
+				kargoapi.AnnotationKeyEventPromotion: "fake-promo",
+			},
+		},
+		{
+			name:           "Stage known with failed mechanism",
+			stage:          stage,
+			mechanism:      "Argo CD promotion mechanism",
+			expectedObject: stage,
+			expectedAnns: map[string]string{
+				kargoapi.AnnotationKeyEventStage:     "fake-stage",
+				kargoapi.AnnotationKeyEventFreight:   "fake-freight",
+				kargoapi.AnnotationKeyEventPromotion: "fake-promo",
+				kargoapi.AnnotationKeyEventMechanism: "Argo CD promotion mechanism",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := &fakeAnnotatingRecorder{
+				FakeRecorder: *record.NewFakeRecorder(1),
+			}
+			r := &reconciler{recorder: recorder}
+			r.recordPromotionEvent(
+				testCase.stage,
+				promo,
+				testCase.mechanism,
+				"Warning",
+				kargoapi.EventReasonPromotionErrored,
+				"Promotion %s failed",
+				promo.Name,
+			)
+			require.Same(t, testCase.expectedObject, recorder.object)
+			require.Equal(t, testCase.expectedAnns, recorder.annotations)
+			require.Equal(
+				t,
+				"Warning PromotionErrored Promotion fake-promo failed",
+				<-recorder.Events,
+			)
+		})
+	}
+}
+
+func TestGetFailedMechanism(t *testing.T) {
+	require.Empty(t, getFailedMechanism(nil))
+	require.Empty(
+		t,
+		getFailedMechanism([]kargoapi.PromotionStepResult{
+			{
+				Mechanism: "Git promotion mechanisms",
+				Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+			},
+		}),
+	)
+	require.Equal(
+		t,
+		"Argo CD promotion mechanism",
+		getFailedMechanism([]kargoapi.PromotionStepResult{
+			{
+				Mechanism: "Git promotion mechanisms",
+				Outcome:   kargoapi.PromotionStepOutcomeSucceeded,
+			},
+			{
+				Mechanism: "Argo CD promotion mechanism",
+				Outcome:   kargoapi.PromotionStepOutcomeErrored,
+			},
+		}),
+	)
+}
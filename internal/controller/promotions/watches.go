@@ -46,12 +46,22 @@ func (e *EnqueueHighestPriorityPromotionHandler) Delete(
 	}
 }
 
-// Generic implements EventHandler.
+// Generic implements EventHandler. Generic events are received for abandoned
+// promotions whose execution has finally returned, in which case the next one
+// for the same Stage may begin.
 func (e *EnqueueHighestPriorityPromotionHandler) Generic(
-	event.GenericEvent,
-	workqueue.RateLimitingInterface,
+	evt event.GenericEvent,
+	wq workqueue.RateLimitingInterface,
 ) {
-	// No-op
+	if promo, ok := evt.Object.(*kargoapi.Promotion); ok && promo.Spec != nil {
+		e.enqueueNext(
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+			wq,
+		)
+	}
 }
 
 // Update implements EventHandler. This should only be called with
@@ -93,6 +103,11 @@ func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
 		// there's already an active promotion. don't need to enqueue the next one
 		return
 	}
+	if e.pqs.abandonedPromoByStage[stageKey] != "" {
+		// an abandoned promotion is still executing. the next one will be enqueued
+		// once it returns
+		return
+	}
 	pq, ok := e.pqs.pendingPromoQueuesByStage[stageKey]
 	if !ok {
 		return
@@ -0,0 +1,178 @@
+package stages
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// setConditions updates the Ready, Reconciling, Stalled, and Healthy
+// conditions within the provided StageStatus to reflect the outcome of a
+// reconciliation, which failed if the provided error is non-nil.
+func setConditions(
+	status *kargoapi.StageStatus,
+	generation int64,
+	err error,
+) {
+	setHealthyCondition(status, generation)
+
+	reconciling := metav1.Condition{
+		Type:               kargoapi.ConditionTypeReconciling,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Idle",
+		Message:            "No Promotion is in progress",
+		ObservedGeneration: generation,
+	}
+	if status.CurrentPromotion != nil {
+		reconciling.Status = metav1.ConditionTrue
+		reconciling.Reason = "Promoting"
+		reconciling.Message = fmt.Sprintf(
+			"Promotion %s of Freight %s is in progress",
+			status.CurrentPromotion.Name,
+			status.CurrentPromotion.Freight.ID,
+		)
+	}
+	meta.SetStatusCondition(&status.Conditions, reconciling)
+
+	stalled := metav1.Condition{
+		Type:               kargoapi.ConditionTypeStalled,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Synced",
+		Message:            "Stage was synced successfully",
+		ObservedGeneration: generation,
+	}
+	if err != nil {
+		stalled.Status = metav1.ConditionTrue
+		stalled.Reason = "SyncFailed"
+		stalled.Message = err.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, stalled)
+
+	ready := metav1.Condition{
+		Type:               kargoapi.ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Ready",
+		Message:            "Stage is ready",
+		ObservedGeneration: generation,
+	}
+	switch {
+	case err != nil:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = stalled.Reason
+		ready.Message = stalled.Message
+	case status.CurrentPromotion != nil:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = reconciling.Reason
+		ready.Message = reconciling.Message
+	case status.Health != nil &&
+		status.Health.Status != kargoapi.HealthStateHealthy:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = string(status.Health.Status)
+		ready.Message = fmt.Sprintf("Stage is %s", status.Health.Status)
+	}
+	meta.SetStatusCondition(&status.Conditions, ready)
+}
+
+// setHealthyCondition updates the Healthy condition within the provided
+// StageStatus to reflect the Stage's last observed health. The condition is
+// removed if health is not applicable to the Stage.
+func setHealthyCondition(status *kargoapi.StageStatus, generation int64) {
+	if status.Health == nil {
+		meta.RemoveStatusCondition(
+			&status.Conditions,
+			kargoapi.StageConditionTypeHealthy,
+		)
+		return
+	}
+	condition := metav1.Condition{
+		Type:               kargoapi.StageConditionTypeHealthy,
+		Status:             metav1.ConditionFalse,
+		Reason:             string(status.Health.Status),
+		Message:            strings.Join(status.Health.Issues, "; "),
+		ObservedGeneration: generation,
+	}
+	switch status.Health.Status {
+	case kargoapi.HealthStateHealthy:
+		condition.Status = metav1.ConditionTrue
+	case kargoapi.HealthStateUnknown, "":
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = string(kargoapi.HealthStateUnknown)
+	}
+	if condition.Message == "" {
+		condition.Message = fmt.Sprintf("Stage is %s", condition.Reason)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setVerifiedCondition updates the Verified condition within the provided
+// StageStatus to reflect whether the Stage's current Freight has satisfied the
+// Stage's health and minimum soak duration requirements. The condition is
+// removed if the Stage has no current Freight.
+func setVerifiedCondition(status *kargoapi.StageStatus, generation int64) {
+	if status.CurrentFreight == nil {
+		meta.RemoveStatusCondition(
+			&status.Conditions,
+			kargoapi.StageConditionTypeVerified,
+		)
+		return
+	}
+	condition := metav1.Condition{
+		Type:   kargoapi.StageConditionTypeVerified,
+		Status: metav1.ConditionTrue,
+		Reason: "Verified",
+		Message: fmt.Sprintf(
+			"Freight %s has been verified",
+			status.CurrentFreight.ID,
+		),
+		ObservedGeneration: generation,
+	}
+	switch {
+	case status.Health != nil &&
+		status.Health.Status != kargoapi.HealthStateHealthy:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NotHealthy"
+		condition.Message = fmt.Sprintf(
+			"Freight %s cannot be verified while the Stage is %s",
+			status.CurrentFreight.ID,
+			status.Health.Status,
+		)
+	case status.Soak != nil && status.Soak.Remaining != nil &&
+		status.Soak.Remaining.Duration > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Soaking"
+		condition.Message = fmt.Sprintf(
+			"Freight %s must soak for another %s before it is verified",
+			status.CurrentFreight.ID,
+			status.Soak.Remaining.Duration,
+		)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setAutoPromotionBlockedCondition updates the AutoPromotionBlocked condition
+// within the provided StageStatus. An empty reason indicates that
+// auto-promotion is not blocked.
+func setAutoPromotionBlockedCondition(
+	status *kargoapi.StageStatus,
+	generation int64,
+	reason string,
+	message string,
+) {
+	condition := metav1.Condition{
+		Type:               kargoapi.StageConditionTypeAutoPromotionBlocked,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+	if reason == "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NotBlocked"
+		condition.Message = "Auto-promotion is not blocked"
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
@@ -0,0 +1,261 @@
+package stages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSetConditions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		status     kargoapi.StageStatus
+		err        error
+		assertions func(kargoapi.StageStatus)
+	}{
+		{
+			name: "error",
+			err:  errors.New("something went wrong"),
+			assertions: func(status kargoapi.StageStatus) {
+				stalled := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ConditionTypeStalled,
+				)
+				require.NotNil(t, stalled)
+				require.Equal(t, metav1.ConditionTrue, stalled.Status)
+				require.Equal(t, "SyncFailed", stalled.Reason)
+				require.Equal(t, "something went wrong", stalled.Message)
+				ready := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ConditionTypeReady,
+				)
+				require.NotNil(t, ready)
+				require.Equal(t, metav1.ConditionFalse, ready.Status)
+				require.Equal(t, "SyncFailed", ready.Reason)
+			},
+		},
+		{
+			name: "promotion in progress",
+			status: kargoapi.StageStatus{
+				CurrentPromotion: &kargoapi.PromotionInfo{
+					Name: "fake-promo",
+					Freight: kargoapi.SimpleFreight{
+						ID: "fake-freight",
+					},
+				},
+			},
+			assertions: func(status kargoapi.StageStatus) {
+				reconciling := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ConditionTypeReconciling,
+				)
+				require.NotNil(t, reconciling)
+				require.Equal(t, metav1.ConditionTrue, reconciling.Status)
+				require.Equal(t, "Promoting", reconciling.Reason)
+				require.Contains(t, reconciling.Message, "fake-promo")
+				ready := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ConditionTypeReady,
+				)
+				require.NotNil(t, ready)
+				require.Equal(t, metav1.ConditionFalse, ready.Status)
+				require.Equal(t, "Promoting", ready.Reason)
+			},
+		},
+		{
+			name: "unhealthy",
+			status: kargoapi.StageStatus{
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateUnhealthy,
+					Issues: []string{"foo", "bar"},
+				},
+			},
+			assertions: func(status kargoapi.StageStatus) {
+				healthy := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.StageConditionTypeHealthy,
+				)
+				require.NotNil(t, healthy)
+				require.Equal(t, metav1.ConditionFalse, healthy.Status)
+				require.Equal(t, "Unhealthy", healthy.Reason)
+				require.Equal(t, "foo; bar", healthy.Message)
+				ready := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ConditionTypeReady,
+				)
+				require.NotNil(t, ready)
+				require.Equal(t, metav1.ConditionFalse, ready.Status)
+				require.Equal(t, "Unhealthy", ready.Reason)
+			},
+		},
+		{
+			name: "ready",
+			status: kargoapi.StageStatus{
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateHealthy,
+				},
+			},
+			assertions: func(status kargoapi.StageStatus) {
+				require.True(
+					t,
+					meta.IsStatusConditionTrue(
+						status.Conditions,
+						kargoapi.StageConditionTypeHealthy,
+					),
+				)
+				require.True(
+					t,
+					meta.IsStatusConditionFalse(
+						status.Conditions,
+						kargoapi.ConditionTypeReconciling,
+					),
+				)
+				require.True(
+					t,
+					meta.IsStatusConditionFalse(
+						status.Conditions,
+						kargoapi.ConditionTypeStalled,
+					),
+				)
+				ready := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.ConditionTypeReady,
+				)
+				require.NotNil(t, ready)
+				require.Equal(t, metav1.ConditionTrue, ready.Status)
+				require.Equal(t, int64(42), ready.ObservedGeneration)
+			},
+		},
+		{
+			name: "health not applicable",
+			status: kargoapi.StageStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   kargoapi.StageConditionTypeHealthy,
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+			assertions: func(status kargoapi.StageStatus) {
+				require.Nil(
+					t,
+					meta.FindStatusCondition(
+						status.Conditions,
+						kargoapi.StageConditionTypeHealthy,
+					),
+				)
+				require.True(
+					t,
+					meta.IsStatusConditionTrue(
+						status.Conditions,
+						kargoapi.ConditionTypeReady,
+					),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := testCase.status
+			setConditions(&status, 42, testCase.err)
+			testCase.assertions(status)
+		})
+	}
+}
+
+func TestSetVerifiedCondition(t *testing.T) {
+	testCases := []struct {
+		name           string
+		status         kargoapi.StageStatus
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name: "unhealthy",
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateProgressing,
+				},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "NotHealthy",
+		},
+		{
+			name: "soaking",
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{},
+				Soak: &kargoapi.SoakStatus{
+					Remaining: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "Soaking",
+		},
+		{
+			name: "verified",
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{},
+				Soak: &kargoapi.SoakStatus{
+					Remaining: &metav1.Duration{},
+				},
+			},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: "Verified",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := testCase.status
+			setVerifiedCondition(&status, 42)
+			condition := meta.FindStatusCondition(
+				status.Conditions,
+				kargoapi.StageConditionTypeVerified,
+			)
+			require.NotNil(t, condition)
+			require.Equal(t, testCase.expectedStatus, condition.Status)
+			require.Equal(t, testCase.expectedReason, condition.Reason)
+		})
+	}
+
+	t.Run("no current Freight", func(t *testing.T) {
+		status := kargoapi.StageStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:   kargoapi.StageConditionTypeVerified,
+					Status: metav1.ConditionTrue,
+				},
+			},
+		}
+		setVerifiedCondition(&status, 42)
+		require.Empty(t, status.Conditions)
+	})
+}
+
+func TestSetAutoPromotionBlockedCondition(t *testing.T) {
+	status := kargoapi.StageStatus{}
+	setAutoPromotionBlockedCondition(&status, 42, "Locked", "fake-message")
+	condition := meta.FindStatusCondition(
+		status.Conditions,
+		kargoapi.StageConditionTypeAutoPromotionBlocked,
+	)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.Equal(t, "Locked", condition.Reason)
+	require.Equal(t, "fake-message", condition.Message)
+
+	setAutoPromotionBlockedCondition(&status, 42, "", "")
+	condition = meta.FindStatusCondition(
+		status.Conditions,
+		kargoapi.StageConditionTypeAutoPromotionBlocked,
+	)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, "NotBlocked", condition.Reason)
+}
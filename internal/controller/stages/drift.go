@@ -0,0 +1,68 @@
+package stages
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// checkDrift compares the live sources of each Argo CD Application updated by
+// the provided ArgoCDAppUpdates to what those updates would set them to for
+// the provided Freight. It returns a description of each discrepancy found.
+// Applications that cannot be found are ignored here, since that is already
+// reflected in the Stage's health.
+func (r *reconciler) checkDrift(
+	ctx context.Context,
+	currentFreight kargoapi.SimpleFreight,
+	argoCDAppUpdates []kargoapi.ArgoCDAppUpdate,
+) []string {
+	logger := logging.LoggerFromContext(ctx)
+	var drift []string
+	for _, update := range argoCDAppUpdates {
+		app, err := r.getArgoCDAppFn(
+			ctx,
+			r.argoClient,
+			update.AppNamespaceOrDefault(),
+			update.AppName,
+		)
+		if err != nil || app == nil {
+			continue
+		}
+		appDrift, err := promotion.GetArgoCDAppDrift(app, update, currentFreight)
+		if err != nil {
+			logger.WithField("app", update.AppName).
+				Errorf("error assessing drift of Argo CD Application: %s", err)
+			continue
+		}
+		drift = append(drift, appDrift...)
+	}
+	return drift
+}
+
+// setDriftedCondition updates the Drifted condition within the provided
+// StageStatus to reflect the provided description of drift.
+func setDriftedCondition(
+	status *kargoapi.StageStatus,
+	generation int64,
+	drift []string,
+) {
+	condition := metav1.Condition{
+		Type:               kargoapi.StageConditionTypeDrifted,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NoDrift",
+		Message:            "Argo CD Applications match the current Freight",
+		ObservedGeneration: generation,
+	}
+	if len(drift) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Drifted"
+		condition.Message = strings.Join(drift, "; ")
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
@@ -0,0 +1,183 @@
+package stages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+)
+
+func TestCheckDrift(t *testing.T) {
+	freight := kargoapi.SimpleFreight{
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "fake-url",
+				ID:      "fake-commit",
+			},
+		},
+	}
+	argoCDAppUpdates := []kargoapi.ArgoCDAppUpdate{
+		{
+			AppName:      "fake-app",
+			AppNamespace: "fake-namespace",
+			SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+				{
+					RepoURL:              "fake-url",
+					UpdateTargetRevision: true,
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name           string
+		getArgoCDAppFn func(
+			context.Context,
+			client.Client,
+			string,
+			string,
+		) (*argocd.Application, error)
+		assertions func([]string)
+	}{
+		{
+			name: "error finding Application",
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(drift []string) {
+				require.Empty(t, drift)
+			},
+		},
+		{
+			name: "Application not found",
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return nil, nil
+			},
+			assertions: func(drift []string) {
+				require.Empty(t, drift)
+			},
+		},
+		{
+			name: "no drift",
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL:        "fake-url",
+							TargetRevision: "fake-commit",
+						},
+					},
+				}, nil
+			},
+			assertions: func(drift []string) {
+				require.Empty(t, drift)
+			},
+		},
+		{
+			name: "drift",
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-app",
+						Namespace: "fake-namespace",
+					},
+					Spec: argocd.ApplicationSpec{
+						Source: &argocd.ApplicationSource{
+							RepoURL:        "fake-url",
+							TargetRevision: "another-commit",
+						},
+					},
+				}, nil
+			},
+			assertions: func(drift []string) {
+				require.Equal(
+					t,
+					[]string{
+						`Argo CD Application "fake-app" in namespace "fake-namespace" ` +
+							`source has targetRevision "another-commit"; expected ` +
+							`"fake-commit"`,
+					},
+					drift,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reconciler := &reconciler{
+				getArgoCDAppFn: testCase.getArgoCDAppFn,
+			}
+			testCase.assertions(
+				reconciler.checkDrift(
+					context.Background(),
+					freight,
+					argoCDAppUpdates,
+				),
+			)
+		})
+	}
+}
+
+func TestSetDriftedCondition(t *testing.T) {
+	testCases := []struct {
+		name           string
+		drift          []string
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "no drift",
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "NoDrift",
+		},
+		{
+			name:           "drift",
+			drift:          []string{"foo", "bar"},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: "Drifted",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := kargoapi.StageStatus{}
+			setDriftedCondition(&status, 42, testCase.drift)
+			condition := meta.FindStatusCondition(
+				status.Conditions,
+				kargoapi.StageConditionTypeDrifted,
+			)
+			require.NotNil(t, condition)
+			require.Equal(t, testCase.expectedStatus, condition.Status)
+			require.Equal(t, testCase.expectedReason, condition.Reason)
+			require.Equal(t, int64(42), condition.ObservedGeneration)
+			if len(testCase.drift) > 0 {
+				require.Equal(t, "foo; bar", condition.Message)
+			}
+		})
+	}
+}
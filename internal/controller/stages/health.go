@@ -12,8 +12,10 @@ func (r *reconciler) checkHealth(
 	ctx context.Context,
 	currentFreight kargoapi.SimpleFreight,
 	argoCDAppUpdates []kargoapi.ArgoCDAppUpdate,
+	healthChecks *kargoapi.HealthChecks,
 ) *kargoapi.Health {
-	if len(argoCDAppUpdates) == 0 {
+	if len(argoCDAppUpdates) == 0 &&
+		(healthChecks == nil || len(healthChecks.Resources) == 0) {
 		return nil
 	}
 
@@ -86,56 +88,24 @@ func (r *reconciler) checkHealth(
 			Revisions: app.Status.Sync.Revisions,
 		}
 
-		// TODO: We should re-evaluate this soon. It may have been fixed in recent
-		// versions.
-		if len(app.Spec.Sources) > 0 {
-			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
-			h.Issues = append(
-				h.Issues,
-				fmt.Sprintf(
-					"bugs in Argo CD currently prevent a comprehensive assessment of "+
-						"the health of multi-source Application %q in namespace %q",
-					updates.AppName,
-					updates.AppNamespaceOrDefault(),
-				),
-			)
-			continue
-		}
-
 		stageHealth, issue := stageHealthForAppHealth(app)
 		h.Status = h.Status.Merge(stageHealth)
 		if issue != "" {
 			h.Issues = append(h.Issues, issue)
 		}
 
-		var desiredRevision string
-		for _, commit := range currentFreight.Commits {
-			if commit.RepoURL == app.Spec.Source.RepoURL {
-				if commit.HealthCheckCommit != "" {
-					desiredRevision = commit.HealthCheckCommit
-				} else {
-					desiredRevision = commit.ID
-				}
-			}
-			break
-		}
-		if desiredRevision == "" {
-			for _, chart := range currentFreight.Charts {
-				if chart.RegistryURL == app.Spec.Source.RepoURL &&
-					chart.Name == app.Spec.Source.Chart {
-					desiredRevision = chart.Version
-					break
-				}
-			}
-		}
-		if desiredRevision != "" {
-			stageHealth, issue = stageHealthForAppSync(app, desiredRevision)
-			h.Status = h.Status.Merge(stageHealth)
-			if issue != "" {
-				h.Issues = append(h.Issues, issue)
-			}
+		stageHealth, issue = stageHealthForAppSync(
+			app,
+			getDesiredRevisions(app, currentFreight),
+		)
+		h.Status = h.Status.Merge(stageHealth)
+		if issue != "" {
+			h.Issues = append(h.Issues, issue)
 		}
+	}
 
+	if healthChecks != nil && len(healthChecks.Resources) > 0 {
+		r.checkResourcesHealth(ctx, healthChecks, &h)
 	}
 
 	return &h
@@ -165,11 +135,76 @@ func stageHealthForAppHealth(
 	}
 }
 
+// getDesiredRevisions returns the revisions that each of the provided Argo CD
+// Application's sources should be synced to, given the provided Freight. For a
+// single-source Application, the result has exactly one element. For a
+// multi-source Application, the result has one element per source, in the
+// same order as the sources themselves. Any element may be empty if the
+// Freight does not reference the corresponding source. Like Argo CD itself, a
+// single source is disregarded if an Application has multiple sources.
+func getDesiredRevisions(
+	app *argocd.Application,
+	freight kargoapi.SimpleFreight,
+) []string {
+	if len(app.Spec.Sources) > 0 {
+		revisions := make([]string, len(app.Spec.Sources))
+		for i, source := range app.Spec.Sources {
+			revisions[i] = getDesiredRevision(source, freight)
+		}
+		return revisions
+	}
+	if app.Spec.Source != nil {
+		return []string{getDesiredRevision(*app.Spec.Source, freight)}
+	}
+	return nil
+}
+
+// getDesiredRevision returns the revision that the provided Argo CD
+// Application source should be synced to, given the provided Freight. If the
+// Freight does not reference the source, an empty string is returned.
+func getDesiredRevision(
+	source argocd.ApplicationSource,
+	freight kargoapi.SimpleFreight,
+) string {
+	if source.Chart == "" {
+		for _, commit := range freight.Commits {
+			if commit.RepoURL == source.RepoURL {
+				if commit.HealthCheckCommit != "" {
+					return commit.HealthCheckCommit
+				}
+				return commit.ID
+			}
+		}
+	}
+	for _, chart := range freight.Charts {
+		if chart.RegistryURL == source.RepoURL && chart.Name == source.Chart {
+			return chart.Version
+		}
+	}
+	return ""
+}
+
+// stageHealthForAppSync assesses whether the provided Argo CD Application is
+// synced to the provided revisions, which must correspond, one-to-one and in
+// order, to the Application's source(s). Empty revisions are not assessed.
 func stageHealthForAppSync(
 	app *argocd.Application,
-	revision string,
+	revisions []string,
 ) (kargoapi.HealthState, string) {
-	if revision != "" && app.Status.Sync.Revision != revision {
+	multiSource := len(app.Spec.Sources) > 0
+	for i, revision := range revisions {
+		if revision == "" {
+			continue
+		}
+		var syncedRevision string
+		if !multiSource {
+			syncedRevision = app.Status.Sync.Revision
+		} else if i < len(app.Status.Sync.Revisions) {
+			syncedRevision = app.Status.Sync.Revisions[i]
+		}
+		if syncedRevision == revision {
+			continue
+		}
 		if app.Operation != nil && app.Operation.Sync != nil {
 			return kargoapi.HealthStateProgressing,
 				fmt.Sprintf(
@@ -178,6 +213,17 @@ func stageHealthForAppSync(
 					app.Namespace,
 				)
 		}
+		if multiSource {
+			return kargoapi.HealthStateUnhealthy,
+				fmt.Sprintf(
+					"source %d of Argo CD Application %q in namespace %q is not "+
+						"synced to revision %q",
+					i,
+					app.Name,
+					app.Namespace,
+					revision,
+				)
+		}
 		return kargoapi.HealthStateUnhealthy,
 			fmt.Sprintf(
 				"Argo CD Application %q in namespace %q is not synced to revision %q",
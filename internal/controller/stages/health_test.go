@@ -123,10 +123,22 @@ func TestCheckHealth(t *testing.T) {
 		},
 
 		{
-			name: "Argo CD App is multi-source",
-			// This doesn't require there to actually BE multiple sources. Simply
-			// using the sources field instead of the source fields should be enough
-			// to trigger this case.
+			name: "multi-source Argo CD App not synced",
+			freight: kargoapi.SimpleFreight{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL: "fake-git-url",
+						ID:      "fake-commit",
+					},
+				},
+				Charts: []kargoapi.Chart{
+					{
+						RegistryURL: "fake-registry",
+						Name:        "fake-chart",
+						Version:     "1.0.0",
+					},
+				},
+			},
 			argoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
 				{
 					AppName:      "fake-app",
@@ -142,7 +154,14 @@ func TestCheckHealth(t *testing.T) {
 				return &argocd.Application{
 					Spec: argocd.ApplicationSpec{
 						Sources: argocd.ApplicationSources{
-							{},
+							{
+								RepoURL: "fake-registry",
+								Chart:   "fake-chart",
+							},
+							{
+								RepoURL: "fake-git-url",
+								Ref:     "values",
+							},
 						},
 					},
 					Status: argocd.ApplicationStatus{
@@ -151,12 +170,16 @@ func TestCheckHealth(t *testing.T) {
 						},
 						Sync: argocd.SyncStatus{
 							Status: argocd.SyncStatusCodeSynced,
+							Revisions: []string{
+								"1.0.0",
+								"not-the-right-commit",
+							},
 						},
 					},
 				}, nil
 			},
 			assertions: func(health *kargoapi.Health) {
-				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
 				require.Equal(
 					t,
 					[]kargoapi.ArgoCDAppStatus{
@@ -168,6 +191,10 @@ func TestCheckHealth(t *testing.T) {
 							},
 							SyncStatus: kargoapi.ArgoCDAppSyncStatus{
 								Status: kargoapi.ArgoCDAppSyncStateSynced,
+								Revisions: []string{
+									"1.0.0",
+									"not-the-right-commit",
+								},
 							},
 						},
 					},
@@ -177,12 +204,136 @@ func TestCheckHealth(t *testing.T) {
 				require.Contains(
 					t,
 					health.Issues[0],
-					"bugs in Argo CD currently prevent a comprehensive assessment of "+
-						"the health of multi-source Application",
+					`source 1 of Argo CD Application "" in namespace "" is not `+
+						`synced to revision "fake-commit"`,
 				)
 			},
 		},
 
+		{
+			name: "multi-source Argo CD App healthy and synced",
+			freight: kargoapi.SimpleFreight{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL: "fake-git-url",
+						ID:      "fake-commit",
+					},
+				},
+				Charts: []kargoapi.Chart{
+					{
+						RegistryURL: "fake-registry",
+						Name:        "fake-chart",
+						Version:     "1.0.0",
+					},
+				},
+			},
+			argoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+				{
+					AppName:      "fake-app",
+					AppNamespace: "fake-namespace",
+				},
+			},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					Spec: argocd.ApplicationSpec{
+						Sources: argocd.ApplicationSources{
+							{
+								RepoURL: "fake-registry",
+								Chart:   "fake-chart",
+							},
+							{
+								RepoURL: "fake-git-url",
+								Ref:     "values",
+							},
+							{
+								// Not referenced by the Freight
+								RepoURL: "another-git-url",
+							},
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{
+							Status: argocd.HealthStatusHealthy,
+						},
+						Sync: argocd.SyncStatus{
+							Status: argocd.SyncStatusCodeSynced,
+							Revisions: []string{
+								"1.0.0",
+								"fake-commit",
+								"some-other-commit",
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+			},
+		},
+
+		{
+			name: "multi-source Argo CD App with single source also set",
+			freight: kargoapi.SimpleFreight{
+				Commits: []kargoapi.GitCommit{
+					{
+						RepoURL: "fake-git-url",
+						ID:      "fake-commit",
+					},
+				},
+			},
+			argoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+				{
+					AppName:      "fake-app",
+					AppNamespace: "fake-namespace",
+				},
+			},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return &argocd.Application{
+					Spec: argocd.ApplicationSpec{
+						// Disregarded by Argo CD because sources are also set
+						Source: &argocd.ApplicationSource{
+							RepoURL: "fake-git-url",
+						},
+						Sources: argocd.ApplicationSources{
+							{
+								RepoURL: "another-git-url",
+							},
+							{
+								RepoURL: "fake-git-url",
+							},
+						},
+					},
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{
+							Status: argocd.HealthStatusHealthy,
+						},
+						Sync: argocd.SyncStatus{
+							Status: argocd.SyncStatusCodeSynced,
+							Revisions: []string{
+								"some-other-commit",
+								"fake-commit",
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+			},
+		},
+
 		{
 			name: "Argo CD App is not healthy",
 			argoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
@@ -367,6 +518,7 @@ func TestCheckHealth(t *testing.T) {
 					context.Background(),
 					testCase.freight,
 					testCase.argoCDAppUpdates,
+					nil,
 				),
 			)
 		})
@@ -0,0 +1,302 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// checkResourcesHealth assesses the health of each Kubernetes resource
+// referenced by the provided HealthChecks and records its findings in the
+// provided Health.
+func (r *reconciler) checkResourcesHealth(
+	ctx context.Context,
+	healthChecks *kargoapi.HealthChecks,
+	h *kargoapi.Health,
+) {
+	h.Resources = make([]kargoapi.ResourceHealthStatus, len(healthChecks.Resources))
+	for i, check := range healthChecks.Resources {
+		h.Resources[i] = kargoapi.ResourceHealthStatus{
+			APIVersion: check.APIVersion,
+			Kind:       check.Kind,
+			Namespace:  healthChecks.Namespace,
+			Name:       check.Name,
+		}
+
+		obj, err := r.getResourceFn(
+			ctx,
+			r.kargoClient,
+			schema.FromAPIVersionAndKind(check.APIVersion, check.Kind),
+			healthChecks.Namespace,
+			check.Name,
+		)
+		if err != nil {
+			h.Resources[i].Status = kargoapi.HealthStateUnknown
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"error finding %s %q in namespace %q: %s",
+					check.Kind,
+					check.Name,
+					healthChecks.Namespace,
+					err,
+				),
+			)
+			continue
+		}
+
+		if obj == nil {
+			h.Resources[i].Status = kargoapi.HealthStateUnknown
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"unable to find %s %q in namespace %q",
+					check.Kind,
+					check.Name,
+					healthChecks.Namespace,
+				),
+			)
+			continue
+		}
+
+		state, reason := stageHealthForResource(obj)
+		h.Resources[i].Status = state
+		h.Resources[i].Message = reason
+		h.Status = h.Status.Merge(state)
+		if reason != "" {
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"%s %q in namespace %q %s",
+					check.Kind,
+					check.Name,
+					healthChecks.Namespace,
+					reason,
+				),
+			)
+		}
+	}
+}
+
+// getResource returns the Kubernetes resource of the specified kind,
+// namespace, and name. If no such resource is found, nil is returned instead.
+// Any other error is returned as is for the caller to describe.
+func getResource(
+	ctx context.Context,
+	c client.Client,
+	gvk schema.GroupVersionKind,
+	namespace string,
+	name string,
+) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := c.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: namespace,
+			Name:      name,
+		},
+		obj,
+	); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return obj, nil
+}
+
+// stageHealthForResource assesses the health of an arbitrary Kubernetes
+// resource using rules modeled after those of kstatus. It returns the assessed
+// HealthState along with a short explanation whenever that state is anything
+// other than Healthy.
+func stageHealthForResource(
+	obj *unstructured.Unstructured,
+) (kargoapi.HealthState, string) {
+	if obj.GetDeletionTimestamp() != nil {
+		return kargoapi.HealthStateProgressing, "is being deleted"
+	}
+
+	gk := obj.GroupVersionKind().GroupKind()
+	// Argo Rollouts report their own phase and historically used a string for
+	// observedGeneration, so they don't get the generic generation check.
+	if gk == (schema.GroupKind{Group: "argoproj.io", Kind: "Rollout"}) {
+		return stageHealthForRollout(obj)
+	}
+
+	if observedGen, found, err := unstructured.NestedInt64(
+		obj.Object,
+		"status",
+		"observedGeneration",
+	); err == nil && found && observedGen < obj.GetGeneration() {
+		return kargoapi.HealthStateProgressing,
+			"has changes that have not yet been observed by its controller"
+	}
+
+	switch gk {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"}:
+		return stageHealthForDeployment(obj)
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		return stageHealthForStatefulSet(obj)
+	}
+	return stageHealthForConditions(obj)
+}
+
+func stageHealthForDeployment(
+	obj *unstructured.Unstructured,
+) (kargoapi.HealthState, string) {
+	if cond := getCondition(obj, "Progressing"); cond != nil &&
+		cond.reason == "ProgressDeadlineExceeded" {
+		return kargoapi.HealthStateUnhealthy, "has exceeded its progress deadline"
+	}
+	desired := getInt64OrDefault(obj, 1, "spec", "replicas")
+	total := getInt64OrDefault(obj, 0, "status", "replicas")
+	updated := getInt64OrDefault(obj, 0, "status", "updatedReplicas")
+	ready := getInt64OrDefault(obj, 0, "status", "readyReplicas")
+	available := getInt64OrDefault(obj, 0, "status", "availableReplicas")
+	switch {
+	case updated < desired:
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("has %d of %d replicas updated", updated, desired)
+	case total > updated:
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("has %d old replicas pending termination", total-updated)
+	case available < updated:
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("has %d of %d updated replicas available", available, updated)
+	case ready < desired:
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("has %d of %d replicas ready", ready, desired)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func stageHealthForStatefulSet(
+	obj *unstructured.Unstructured,
+) (kargoapi.HealthState, string) {
+	desired := getInt64OrDefault(obj, 1, "spec", "replicas")
+	ready := getInt64OrDefault(obj, 0, "status", "readyReplicas")
+	if ready < desired {
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("has %d of %d replicas ready", ready, desired)
+	}
+	strategy, _, _ :=
+		unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		// The StatefulSet controller doesn't roll out changes on its own in this
+		// case, so there is nothing more to wait for.
+		return kargoapi.HealthStateHealthy, ""
+	}
+	partition := getInt64OrDefault(
+		obj,
+		0,
+		"spec", "updateStrategy", "rollingUpdate", "partition",
+	)
+	updated := getInt64OrDefault(obj, 0, "status", "updatedReplicas")
+	if partition > 0 {
+		if expected := desired - partition; updated < expected {
+			return kargoapi.HealthStateProgressing,
+				fmt.Sprintf("has %d of %d partitioned replicas updated", updated, expected)
+		}
+		return kargoapi.HealthStateHealthy, ""
+	}
+	current, _, _ :=
+		unstructured.NestedString(obj.Object, "status", "currentRevision")
+	update, _, _ :=
+		unstructured.NestedString(obj.Object, "status", "updateRevision")
+	if updated < desired || current != update {
+		return kargoapi.HealthStateProgressing,
+			fmt.Sprintf("has %d of %d replicas updated", updated, desired)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func stageHealthForRollout(
+	obj *unstructured.Unstructured,
+) (kargoapi.HealthState, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+	switch phase {
+	case "Healthy":
+		return kargoapi.HealthStateHealthy, ""
+	case "Degraded":
+		return kargoapi.HealthStateUnhealthy, withMessage("is degraded", message)
+	case "Paused":
+		return kargoapi.HealthStateProgressing, withMessage("is paused", message)
+	default:
+		return kargoapi.HealthStateProgressing, withMessage("is progressing", message)
+	}
+}
+
+// stageHealthForConditions assesses the health of a resource of any kind using
+// only its standard status conditions. A resource that has none of the
+// conditions that are consulted is assumed to be healthy.
+func stageHealthForConditions(
+	obj *unstructured.Unstructured,
+) (kargoapi.HealthState, string) {
+	if cond := getCondition(obj, "Stalled"); cond != nil && cond.status == "True" {
+		return kargoapi.HealthStateUnhealthy, withMessage("is stalled", cond.message)
+	}
+	if cond := getCondition(obj, "Reconciling"); cond != nil && cond.status == "True" {
+		return kargoapi.HealthStateProgressing,
+			withMessage("is reconciling", cond.message)
+	}
+	if cond := getCondition(obj, "Ready"); cond != nil && cond.status != "True" {
+		return kargoapi.HealthStateProgressing,
+			withMessage("is not ready", cond.message)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+type condition struct {
+	status  string
+	reason  string
+	message string
+}
+
+// getCondition returns the status condition of the specified type from the
+// provided resource. If no such condition is found, nil is returned instead.
+func getCondition(obj *unstructured.Unstructured, condType string) *condition {
+	conds, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conds {
+		cond, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(cond, "type"); t != condType {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(cond, "status")
+		reason, _, _ := unstructured.NestedString(cond, "reason")
+		message, _, _ := unstructured.NestedString(cond, "message")
+		return &condition{
+			status:  status,
+			reason:  reason,
+			message: message,
+		}
+	}
+	return nil
+}
+
+func getInt64OrDefault(
+	obj *unstructured.Unstructured,
+	def int64,
+	fields ...string,
+) int64 {
+	if val, found, err :=
+		unstructured.NestedInt64(obj.Object, fields...); err == nil && found {
+		return val
+	}
+	return def
+}
+
+func withMessage(reason, message string) string {
+	if message == "" {
+		return reason
+	}
+	return fmt.Sprintf("%s: %s", reason, message)
+}
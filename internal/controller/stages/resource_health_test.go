@@ -0,0 +1,410 @@
+package stages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCheckResourcesHealth(t *testing.T) {
+	healthChecks := &kargoapi.HealthChecks{
+		Namespace: "fake-namespace",
+		Resources: []kargoapi.ResourceHealthCheck{
+			{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "fake-deployment",
+			},
+		},
+	}
+	testCases := []struct {
+		name          string
+		getResourceFn func(
+			context.Context,
+			client.Client,
+			schema.GroupVersionKind,
+			string,
+			string,
+		) (*unstructured.Unstructured, error)
+		assertions func(*kargoapi.Health)
+	}{
+		{
+			name: "error finding resource",
+			getResourceFn: func(
+				context.Context,
+				client.Client,
+				schema.GroupVersionKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.ResourceHealthStatus{
+						{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Namespace:  "fake-namespace",
+							Name:       "fake-deployment",
+							Status:     kargoapi.HealthStateUnknown,
+						},
+					},
+					health.Resources,
+				)
+				require.Equal(
+					t,
+					[]string{
+						`error finding Deployment "fake-deployment" in namespace ` +
+							`"fake-namespace": something went wrong`,
+					},
+					health.Issues,
+				)
+			},
+		},
+		{
+			name: "resource not found",
+			getResourceFn: func(
+				context.Context,
+				client.Client,
+				schema.GroupVersionKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return nil, nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "unable to find Deployment")
+			},
+		},
+		{
+			name: "resource not healthy",
+			getResourceFn: func(
+				context.Context,
+				client.Client,
+				schema.GroupVersionKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return newDeployment(3, 3, 1, 1, 1), nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.ResourceHealthStatus{
+						{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Namespace:  "fake-namespace",
+							Name:       "fake-deployment",
+							Status:     kargoapi.HealthStateProgressing,
+							Message:    "has 1 of 3 replicas updated",
+						},
+					},
+					health.Resources,
+				)
+				require.Equal(
+					t,
+					[]string{
+						`Deployment "fake-deployment" in namespace "fake-namespace" ` +
+							"has 1 of 3 replicas updated",
+					},
+					health.Issues,
+				)
+			},
+		},
+		{
+			name: "resource healthy",
+			getResourceFn: func(
+				context.Context,
+				client.Client,
+				schema.GroupVersionKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return newDeployment(3, 3, 3, 3, 3), nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Len(t, health.Resources, 1)
+				require.Equal(
+					t,
+					kargoapi.HealthStateHealthy,
+					health.Resources[0].Status,
+				)
+				require.Empty(t, health.Issues)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reconciler := &reconciler{
+				getResourceFn: testCase.getResourceFn,
+			}
+			testCase.assertions(
+				reconciler.checkHealth(
+					context.Background(),
+					kargoapi.SimpleFreight{},
+					nil,
+					healthChecks,
+				),
+			)
+		})
+	}
+}
+
+func TestStageHealthForResource(t *testing.T) {
+	testCases := []struct {
+		name           string
+		obj            *unstructured.Unstructured
+		expectedState  kargoapi.HealthState
+		expectedReason string
+	}{
+		{
+			name: "being deleted",
+			obj: func() *unstructured.Unstructured {
+				obj := newDeployment(1, 1, 1, 1, 1)
+				now := metav1.Now()
+				obj.SetDeletionTimestamp(&now)
+				return obj
+			}(),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "is being deleted",
+		},
+		{
+			name: "generation not yet observed",
+			obj: func() *unstructured.Unstructured {
+				obj := newDeployment(1, 1, 1, 1, 1)
+				obj.SetGeneration(2)
+				return obj
+			}(),
+			expectedState: kargoapi.HealthStateProgressing,
+			expectedReason: "has changes that have not yet been observed by its " +
+				"controller",
+		},
+		{
+			name: "Deployment exceeded progress deadline",
+			obj: func() *unstructured.Unstructured {
+				obj := newDeployment(1, 1, 0, 0, 0)
+				obj.Object["status"].(map[string]any)["conditions"] = []any{ // nolint: forcetypeassert
+					map[string]any{
+						"type":   "Progressing",
+						"status": "False",
+						"reason": "ProgressDeadlineExceeded",
+					},
+				}
+				return obj
+			}(),
+			expectedState:  kargoapi.HealthStateUnhealthy,
+			expectedReason: "has exceeded its progress deadline",
+		},
+		{
+			name:           "Deployment with old replicas",
+			obj:            newDeployment(2, 3, 2, 2, 2),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "has 1 old replicas pending termination",
+		},
+		{
+			name:           "Deployment with unavailable replicas",
+			obj:            newDeployment(2, 2, 2, 1, 2),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "has 1 of 2 updated replicas available",
+		},
+		{
+			name:          "Deployment healthy",
+			obj:           newDeployment(2, 2, 2, 2, 2),
+			expectedState: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "StatefulSet not ready",
+			obj: newObject("apps/v1", "StatefulSet", map[string]any{
+				"spec": map[string]any{"replicas": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(1),
+					"readyReplicas":      int64(2),
+				},
+			}),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "has 2 of 3 replicas ready",
+		},
+		{
+			name: "StatefulSet rolling out",
+			obj: newObject("apps/v1", "StatefulSet", map[string]any{
+				"spec": map[string]any{"replicas": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(1),
+					"readyReplicas":      int64(3),
+					"updatedReplicas":    int64(3),
+					"currentRevision":    "old",
+					"updateRevision":     "new",
+				},
+			}),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "has 3 of 3 replicas updated",
+		},
+		{
+			name: "StatefulSet healthy",
+			obj: newObject("apps/v1", "StatefulSet", map[string]any{
+				"spec": map[string]any{"replicas": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(1),
+					"readyReplicas":      int64(3),
+					"updatedReplicas":    int64(3),
+					"currentRevision":    "new",
+					"updateRevision":     "new",
+				},
+			}),
+			expectedState: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "Rollout degraded",
+			obj: newObject("argoproj.io/v1alpha1", "Rollout", map[string]any{
+				"status": map[string]any{
+					"observedGeneration": "abc123",
+					"phase":              "Degraded",
+					"message":            "ProgressDeadlineExceeded",
+				},
+			}),
+			expectedState:  kargoapi.HealthStateUnhealthy,
+			expectedReason: "is degraded: ProgressDeadlineExceeded",
+		},
+		{
+			name: "Rollout paused",
+			obj: newObject("argoproj.io/v1alpha1", "Rollout", map[string]any{
+				"status": map[string]any{
+					"phase": "Paused",
+				},
+			}),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "is paused",
+		},
+		{
+			name: "Rollout healthy",
+			obj: newObject("argoproj.io/v1alpha1", "Rollout", map[string]any{
+				"status": map[string]any{
+					"phase": "Healthy",
+				},
+			}),
+			expectedState: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "generic resource stalled",
+			obj: newObject("example.com/v1", "Widget", map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{
+							"type":    "Stalled",
+							"status":  "True",
+							"message": "out of widgets",
+						},
+					},
+				},
+			}),
+			expectedState:  kargoapi.HealthStateUnhealthy,
+			expectedReason: "is stalled: out of widgets",
+		},
+		{
+			name: "generic resource reconciling",
+			obj: newObject("example.com/v1", "Widget", map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{
+							"type":   "Reconciling",
+							"status": "True",
+						},
+					},
+				},
+			}),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "is reconciling",
+		},
+		{
+			name: "generic resource not ready",
+			obj: newObject("example.com/v1", "Widget", map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{
+							"type":   "Ready",
+							"status": "False",
+						},
+					},
+				},
+			}),
+			expectedState:  kargoapi.HealthStateProgressing,
+			expectedReason: "is not ready",
+		},
+		{
+			name: "generic resource ready",
+			obj: newObject("example.com/v1", "Widget", map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{
+							"type":   "Ready",
+							"status": "True",
+						},
+					},
+				},
+			}),
+			expectedState: kargoapi.HealthStateHealthy,
+		},
+		{
+			name:          "generic resource without status",
+			obj:           newObject("v1", "ConfigMap", map[string]any{}),
+			expectedState: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state, reason := stageHealthForResource(testCase.obj)
+			require.Equal(t, testCase.expectedState, state)
+			require.Equal(t, testCase.expectedReason, reason)
+		})
+	}
+}
+
+func newObject(
+	apiVersion string,
+	kind string,
+	fields map[string]any,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: fields}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName("fake-name")
+	obj.SetGeneration(1)
+	return obj
+}
+
+func newDeployment(
+	desired int64,
+	total int64,
+	updated int64,
+	available int64,
+	ready int64,
+) *unstructured.Unstructured {
+	return newObject("apps/v1", "Deployment", map[string]any{
+		"spec": map[string]any{
+			"replicas": desired,
+		},
+		"status": map[string]any{
+			"observedGeneration": int64(1),
+			"replicas":           total,
+			"updatedReplicas":    updated,
+			"availableReplicas":  available,
+			"readyReplicas":      ready,
+		},
+	})
+}
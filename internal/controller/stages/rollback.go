@@ -0,0 +1,167 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// autoRollback rolls the provided Stage back to its previous Freight if the
+// Stage is configured for automatic rollback and has become Unhealthy within
+// its auto-rollback window following a promotion. The current Freight is marked
+// as failed for the Stage, a Promotion to the previous Freight is created, and
+// the rollback is recorded in the provided StageStatus. A bool is returned
+// indicating whether a rollback was initiated.
+func (r *reconciler) autoRollback(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	status *kargoapi.StageStatus,
+) (bool, error) {
+	if stage.Spec.AutoRollback == nil ||
+		status.CurrentFreight == nil ||
+		status.Health == nil ||
+		status.Health.Status != kargoapi.HealthStateUnhealthy {
+		return false, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx).
+		WithField("freight", status.CurrentFreight.ID)
+
+	if status.AutoPromotionPaused() {
+		logger.Debug(
+			"Stage's most recent automatic rollback has not been acknowledged; " +
+				"not rolling back again",
+		)
+		return false, nil
+	}
+
+	now := r.nowFn()
+	window := stage.Spec.AutoRollback.Window.Duration
+	if status.LastPromotionTime == nil ||
+		now.Sub(status.LastPromotionTime.Time) > window {
+		logger.Debug("Stage became Unhealthy outside of its auto-rollback window")
+		return false, nil
+	}
+
+	var previousFreight *kargoapi.SimpleFreight
+	for i := range status.History {
+		if status.History[i].ID != status.CurrentFreight.ID {
+			previousFreight = &status.History[i]
+			break
+		}
+	}
+	if previousFreight == nil {
+		logger.Debug("Stage has no previous Freight to roll back to")
+		return false, nil
+	}
+
+	reason := fmt.Sprintf(
+		"Stage became Unhealthy within %s of the promotion of Freight %q",
+		window,
+		status.CurrentFreight.ID,
+	)
+	if len(status.Health.Issues) > 0 {
+		reason = fmt.Sprintf(
+			"%s: %s",
+			reason,
+			strings.Join(status.Health.Issues, "; "),
+		)
+	}
+
+	if err := r.markFreightFailedFn(
+		ctx,
+		stage.Namespace,
+		status.CurrentFreight.ID,
+		stage.Name,
+		reason,
+	); err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error marking Freight %q in namespace %q as failed for Stage %q",
+			status.CurrentFreight.ID,
+			stage.Namespace,
+			stage.Name,
+		)
+	}
+
+	promo := kargo.NewPromotion(*stage, previousFreight.ID)
+	if err :=
+		r.createPromotionFn(ctx, &promo, &client.CreateOptions{}); err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error creating Promotion of Stage %q in namespace %q to Freight %q "+
+				"for automatic rollback",
+			stage.Name,
+			stage.Namespace,
+			previousFreight.ID,
+		)
+	}
+
+	status.RecordRollback(kargoapi.Rollback{
+		Time:        metav1.Time{Time: now},
+		FromFreight: status.CurrentFreight.ID,
+		ToFreight:   previousFreight.ID,
+		Promotion:   promo.Name,
+		Reason:      reason,
+	})
+
+	logger.WithFields(log.Fields{
+		"toFreight": previousFreight.ID,
+		"promotion": promo.Name,
+	}).Info("initiated automatic rollback of Stage")
+
+	return true, nil
+}
+
+// markFreightFailed records the failure of the specified Freight in the
+// specified Stage.
+func (r *reconciler) markFreightFailed(
+	ctx context.Context,
+	namespace string,
+	freightName string,
+	stageName string,
+	reason string,
+) error {
+	freight, err := r.getFreightFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      freightName,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Freight %q in namespace %q",
+			freightName,
+			namespace,
+		)
+	}
+	if freight == nil {
+		return errors.Errorf(
+			"found no Freight %q in namespace %q",
+			freightName,
+			namespace,
+		)
+	}
+	newStatus := *freight.Status.DeepCopy()
+	if newStatus.Failures == nil {
+		newStatus.Failures = map[string]kargoapi.Failure{}
+	}
+	newStatus.Failures[stageName] = kargoapi.Failure{
+		Time:   metav1.Time{Time: r.nowFn()},
+		Reason: reason,
+	}
+	return r.patchFreightStatusFn(ctx, freight, newStatus)
+}
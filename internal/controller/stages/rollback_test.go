@@ -0,0 +1,318 @@
+package stages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestAutoRollback(t *testing.T) {
+	now := time.Now()
+	newStage := func() *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: &kargoapi.StageSpec{
+				AutoRollback: &kargoapi.AutoRollback{
+					Window: metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		}
+	}
+	newStatus := func() *kargoapi.StageStatus {
+		return &kargoapi.StageStatus{
+			CurrentFreight: &kargoapi.SimpleFreight{ID: "new-freight"},
+			History: kargoapi.SimpleFreightStack{
+				{ID: "new-freight"},
+				{ID: "old-freight"},
+			},
+			Health: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Issues: []string{"something is broken"},
+			},
+			LastPromotionTime: &metav1.Time{Time: now.Add(-time.Minute)},
+		}
+	}
+	noopMarkFreightFailedFn := func(
+		context.Context,
+		string,
+		string,
+		string,
+		string,
+	) error {
+		return nil
+	}
+	noopCreatePromotionFn := func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error {
+		return nil
+	}
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		status     *kargoapi.StageStatus
+		reconciler *reconciler
+		assertions func(*kargoapi.StageStatus, bool, error)
+	}{
+		{
+			name: "auto-rollback not configured",
+			stage: func() *kargoapi.Stage {
+				stage := newStage()
+				stage.Spec.AutoRollback = nil
+				return stage
+			}(),
+			status:     newStatus(),
+			reconciler: &reconciler{},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.NoError(t, err)
+				require.False(t, rolledBack)
+				require.Empty(t, status.Rollbacks)
+			},
+		},
+		{
+			name:  "Stage is not Unhealthy",
+			stage: newStage(),
+			status: func() *kargoapi.StageStatus {
+				status := newStatus()
+				status.Health.Status = kargoapi.HealthStateProgressing
+				return status
+			}(),
+			reconciler: &reconciler{},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.NoError(t, err)
+				require.False(t, rolledBack)
+				require.Empty(t, status.Rollbacks)
+			},
+		},
+		{
+			name:  "previous rollback not acknowledged",
+			stage: newStage(),
+			status: func() *kargoapi.StageStatus {
+				status := newStatus()
+				status.Rollbacks = []kargoapi.Rollback{{}}
+				return status
+			}(),
+			reconciler: &reconciler{},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.NoError(t, err)
+				require.False(t, rolledBack)
+				require.Len(t, status.Rollbacks, 1)
+			},
+		},
+		{
+			name:  "outside of window",
+			stage: newStage(),
+			status: func() *kargoapi.StageStatus {
+				status := newStatus()
+				status.LastPromotionTime = &metav1.Time{Time: now.Add(-time.Hour)}
+				return status
+			}(),
+			reconciler: &reconciler{
+				nowFn: func() time.Time { return now },
+			},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.NoError(t, err)
+				require.False(t, rolledBack)
+				require.Empty(t, status.Rollbacks)
+			},
+		},
+		{
+			name:  "no previous Freight",
+			stage: newStage(),
+			status: func() *kargoapi.StageStatus {
+				status := newStatus()
+				status.History = kargoapi.SimpleFreightStack{{ID: "new-freight"}}
+				return status
+			}(),
+			reconciler: &reconciler{
+				nowFn: func() time.Time { return now },
+			},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.NoError(t, err)
+				require.False(t, rolledBack)
+				require.Empty(t, status.Rollbacks)
+			},
+		},
+		{
+			name:   "error marking Freight as failed",
+			stage:  newStage(),
+			status: newStatus(),
+			reconciler: &reconciler{
+				nowFn: func() time.Time { return now },
+				markFreightFailedFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					string,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error marking Freight")
+				require.False(t, rolledBack)
+				require.Empty(t, status.Rollbacks)
+			},
+		},
+		{
+			name:   "error creating Promotion",
+			stage:  newStage(),
+			status: newStatus(),
+			reconciler: &reconciler{
+				nowFn:               func() time.Time { return now },
+				markFreightFailedFn: noopMarkFreightFailedFn,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error creating Promotion")
+				require.False(t, rolledBack)
+				require.Empty(t, status.Rollbacks)
+			},
+		},
+		{
+			name:   "success",
+			stage:  newStage(),
+			status: newStatus(),
+			reconciler: &reconciler{
+				nowFn:               func() time.Time { return now },
+				markFreightFailedFn: noopMarkFreightFailedFn,
+				createPromotionFn:   noopCreatePromotionFn,
+			},
+			assertions: func(status *kargoapi.StageStatus, rolledBack bool, err error) {
+				require.NoError(t, err)
+				require.True(t, rolledBack)
+				require.Len(t, status.Rollbacks, 1)
+				rollback := status.Rollbacks[0]
+				require.Equal(t, now, rollback.Time.Time)
+				require.Equal(t, "new-freight", rollback.FromFreight)
+				require.Equal(t, "old-freight", rollback.ToFreight)
+				require.NotEmpty(t, rollback.Promotion)
+				require.Contains(t, rollback.Reason, "something is broken")
+				require.False(t, rollback.Acknowledged)
+				require.True(t, status.AutoPromotionPaused())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rolledBack, err := testCase.reconciler.autoRollback(
+				context.Background(),
+				testCase.stage,
+				testCase.status,
+			)
+			testCase.assertions(testCase.status, rolledBack, err)
+		})
+	}
+}
+
+func TestMarkFreightFailed(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(error)
+	}{
+		{
+			name: "error getting Freight",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error finding Freight")
+			},
+		},
+		{
+			name: "Freight not found",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no Freight")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				nowFn: func() time.Time { return now },
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					newStatus kargoapi.FreightStatus,
+				) error {
+					require.Equal(
+						t,
+						map[string]kargoapi.Failure{
+							"fake-stage": {
+								Time:   metav1.Time{Time: now},
+								Reason: "fake-reason",
+							},
+						},
+						newStatus.Failures,
+					)
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.markFreightFailed(
+					context.Background(),
+					"fake-namespace",
+					"fake-freight",
+					"fake-stage",
+					"fake-reason",
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package stages
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// soak updates the soak status within the provided StageStatus to reflect the
+// Stage's most recently assessed health and returns a bool indicating whether
+// the Stage's current Freight has satisfied the Stage's minimum soak duration
+// and may therefore be qualified. For a Stage without a minimum soak duration,
+// this is simply a matter of whether the Stage is Healthy (or health is not
+// applicable).
+func (r *reconciler) soak(
+	minSoakDuration *metav1.Duration,
+	status *kargoapi.StageStatus,
+) bool {
+	healthy := status.Health == nil ||
+		status.Health.Status == kargoapi.HealthStateHealthy
+	if minSoakDuration == nil || minSoakDuration.Duration <= 0 {
+		status.Soak = nil
+		return healthy
+	}
+	if status.CurrentFreight == nil {
+		status.Soak = nil
+		return false
+	}
+	if status.Soak == nil || status.Soak.Freight != status.CurrentFreight.ID {
+		// Start over whenever the Freight changes
+		status.Soak = &kargoapi.SoakStatus{
+			Freight: status.CurrentFreight.ID,
+		}
+	}
+	if !healthy {
+		// Reset the clock
+		status.Soak.HealthySince = nil
+		status.Soak.Remaining = nil
+		return false
+	}
+	now := r.nowFn()
+	if status.Soak.HealthySince == nil {
+		status.Soak.HealthySince = &metav1.Time{Time: now}
+	}
+	remaining := minSoakDuration.Duration - now.Sub(status.Soak.HealthySince.Time)
+	if remaining < 0 {
+		remaining = 0
+	}
+	status.Soak.Remaining = &metav1.Duration{
+		Duration: remaining.Round(time.Second),
+	}
+	return remaining == 0
+}
@@ -0,0 +1,193 @@
+package stages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSoak(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name            string
+		minSoakDuration *metav1.Duration
+		status          kargoapi.StageStatus
+		assertions      func(bool, kargoapi.StageStatus)
+	}{
+		{
+			name: "no minimum soak duration; healthy",
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateHealthy,
+				},
+				Soak: &kargoapi.SoakStatus{Freight: "fake-freight"},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.True(t, soaked)
+				require.Nil(t, status.Soak)
+			},
+		},
+		{
+			name: "no minimum soak duration; health not applicable",
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.True(t, soaked)
+				require.Nil(t, status.Soak)
+			},
+		},
+		{
+			name: "no minimum soak duration; unhealthy",
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateUnhealthy,
+				},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.False(t, soaked)
+				require.Nil(t, status.Soak)
+			},
+		},
+		{
+			name:            "no current Freight",
+			minSoakDuration: &metav1.Duration{Duration: time.Hour},
+			status: kargoapi.StageStatus{
+				Soak: &kargoapi.SoakStatus{Freight: "fake-freight"},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.False(t, soaked)
+				require.Nil(t, status.Soak)
+			},
+		},
+		{
+			name:            "just became healthy",
+			minSoakDuration: &metav1.Duration{Duration: time.Hour},
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateHealthy,
+				},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.False(t, soaked)
+				require.Equal(
+					t,
+					&kargoapi.SoakStatus{
+						Freight:      "fake-freight",
+						HealthySince: &metav1.Time{Time: now},
+						Remaining:    &metav1.Duration{Duration: time.Hour},
+					},
+					status.Soak,
+				)
+			},
+		},
+		{
+			name:            "still soaking",
+			minSoakDuration: &metav1.Duration{Duration: time.Hour},
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateHealthy,
+				},
+				Soak: &kargoapi.SoakStatus{
+					Freight: "fake-freight",
+					HealthySince: &metav1.Time{
+						Time: now.Add(-45 * time.Minute),
+					},
+				},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.False(t, soaked)
+				require.Equal(
+					t,
+					&metav1.Duration{Duration: 15 * time.Minute},
+					status.Soak.Remaining,
+				)
+			},
+		},
+		{
+			name:            "done soaking",
+			minSoakDuration: &metav1.Duration{Duration: time.Hour},
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateHealthy,
+				},
+				Soak: &kargoapi.SoakStatus{
+					Freight: "fake-freight",
+					HealthySince: &metav1.Time{
+						Time: now.Add(-2 * time.Hour),
+					},
+				},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.True(t, soaked)
+				require.Equal(t, &metav1.Duration{}, status.Soak.Remaining)
+			},
+		},
+		{
+			name:            "unhealthy resets the clock",
+			minSoakDuration: &metav1.Duration{Duration: time.Hour},
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateProgressing,
+				},
+				Soak: &kargoapi.SoakStatus{
+					Freight: "fake-freight",
+					HealthySince: &metav1.Time{
+						Time: now.Add(-2 * time.Hour),
+					},
+				},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.False(t, soaked)
+				require.Equal(
+					t,
+					&kargoapi.SoakStatus{Freight: "fake-freight"},
+					status.Soak,
+				)
+			},
+		},
+		{
+			name:            "new Freight resets the clock",
+			minSoakDuration: &metav1.Duration{Duration: time.Hour},
+			status: kargoapi.StageStatus{
+				CurrentFreight: &kargoapi.SimpleFreight{ID: "new-freight"},
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateHealthy,
+				},
+				Soak: &kargoapi.SoakStatus{
+					Freight: "old-freight",
+					HealthySince: &metav1.Time{
+						Time: now.Add(-2 * time.Hour),
+					},
+				},
+			},
+			assertions: func(soaked bool, status kargoapi.StageStatus) {
+				require.False(t, soaked)
+				require.Equal(t, "new-freight", status.Soak.Freight)
+				require.Equal(t, &metav1.Time{Time: now}, status.Soak.HealthySince)
+			},
+		},
+	}
+	r := &reconciler{
+		nowFn: func() time.Time {
+			return now
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := testCase.status
+			soaked := r.soak(testCase.minSoakDuration, &status)
+			testCase.assertions(soaked, status)
+		})
+	}
+}
@@ -2,14 +2,22 @@ package stages
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -21,6 +29,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
@@ -30,16 +39,17 @@ import (
 type reconciler struct {
 	kargoClient client.Client
 	argoClient  client.Client
+	recorder    record.EventRecorder
 
 	// The following behaviors are overridable for testing purposes:
 
 	// Loop guard:
 
-	hasNonTerminalPromotionsFn func(
+	getNonTerminalPromotionsFn func(
 		ctx context.Context,
 		stageNamespace string,
 		stageName string,
-	) (bool, error)
+	) ([]kargoapi.Promotion, error)
 
 	listPromosFn func(
 		context.Context,
@@ -53,6 +63,7 @@ type reconciler struct {
 		context.Context,
 		kargoapi.SimpleFreight,
 		[]kargoapi.ArgoCDAppUpdate,
+		*kargoapi.HealthChecks,
 	) *kargoapi.Health
 
 	getArgoCDAppFn func(
@@ -62,8 +73,32 @@ type reconciler struct {
 		name string,
 	) (*argocd.Application, error)
 
+	getResourceFn func(
+		ctx context.Context,
+		client client.Client,
+		gvk schema.GroupVersionKind,
+		namespace string,
+		name string,
+	) (*unstructured.Unstructured, error)
+
+	// Drift detection:
+
+	checkDriftFn func(
+		ctx context.Context,
+		currentFreight kargoapi.SimpleFreight,
+		argoCDAppUpdates []kargoapi.ArgoCDAppUpdate,
+	) []string
+
+	reassertFreightFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freight kargoapi.SimpleFreight,
+	) (kargoapi.SimpleFreight, error)
+
 	// Freight qualification:
 
+	nowFn func() time.Time
+
 	getFreightFn func(
 		context.Context,
 		client.Client,
@@ -72,9 +107,8 @@ type reconciler struct {
 
 	qualifyFreightFn func(
 		ctx context.Context,
-		namespace string,
+		stage *kargoapi.Stage,
 		freightName string,
-		stageName string,
 	) error
 
 	patchFreightStatusFn func(
@@ -83,13 +117,29 @@ type reconciler struct {
 		newStatus kargoapi.FreightStatus,
 	) error
 
+	// Auto-rollback:
+
+	autoRollbackFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		status *kargoapi.StageStatus,
+	) (bool, error)
+
+	markFreightFailedFn func(
+		ctx context.Context,
+		namespace string,
+		freightName string,
+		stageName string,
+		reason string,
+	) error
+
 	// Auto-promotion:
 
-	isAutoPromotionPermittedFn func(
+	checkAutoPromotionPermittedFn func(
 		ctx context.Context,
 		namespace string,
 		stageName string,
-	) (bool, error)
+	) (*kargoapi.AutoPromotionDecision, error)
 
 	listPromoPoliciesFn func(
 		context.Context,
@@ -127,12 +177,14 @@ type reconciler struct {
 		ctx context.Context,
 		namespace string,
 		stageSubs []kargoapi.StageSubscription,
+		rule *kargoapi.QualificationRule,
 	) ([]kargoapi.Freight, error)
 
 	getLatestFreightQualifiedForUpstreamStagesFn func(
 		ctx context.Context,
 		namespace string,
 		stageSubs []kargoapi.StageSubscription,
+		rule *kargoapi.QualificationRule,
 	) (*kargoapi.Freight, error)
 
 	listFreightFn func(
@@ -200,7 +252,13 @@ func SetupReconcilerWithManager(
 		).
 		WithEventFilter(shardPredicate).
 		WithOptions(controller.CommonOptions()).
-		Build(newReconciler(kargoMgr.GetClient(), argoMgr.GetClient()))
+		Build(
+			newReconciler(
+				kargoMgr.GetClient(),
+				argoMgr.GetClient(),
+				kargoMgr.GetEventRecorderFor(controller.EventSource),
+			),
+		)
 	if err != nil {
 		return errors.Wrap(err, "error building Stage reconciler")
 	}
@@ -224,24 +282,37 @@ func SetupReconcilerWithManager(
 	return nil
 }
 
-func newReconciler(kargoClient, argoClient client.Client) *reconciler {
+func newReconciler(
+	kargoClient client.Client,
+	argoClient client.Client,
+	recorder record.EventRecorder,
+) *reconciler {
 	r := &reconciler{
 		kargoClient: kargoClient,
 		argoClient:  argoClient,
+		recorder:    recorder,
 	}
 	// The following default behaviors are overridable for testing purposes:
 	// Loop guard:
-	r.hasNonTerminalPromotionsFn = r.hasNonTerminalPromotions
+	r.getNonTerminalPromotionsFn = r.getNonTerminalPromotions
 	r.listPromosFn = r.kargoClient.List
 	// Health checks:
 	r.checkHealthFn = r.checkHealth
 	r.getArgoCDAppFn = argocd.GetApplication
+	r.getResourceFn = getResource
+	// Drift detection:
+	r.checkDriftFn = r.checkDrift
+	r.reassertFreightFn = promotion.NewArgoCDMechanism(argoClient).Promote
 	// Freight qualification:
+	r.nowFn = time.Now
 	r.getFreightFn = kargoapi.GetFreight
 	r.qualifyFreightFn = r.qualifyFreight
 	r.patchFreightStatusFn = r.patchFreightStatus
+	// Auto-rollback:
+	r.autoRollbackFn = r.autoRollback
+	r.markFreightFailedFn = r.markFreightFailed
 	// Auto-promotion:
-	r.isAutoPromotionPermittedFn = r.isAutoPromotionPermitted
+	r.checkAutoPromotionPermittedFn = r.checkAutoPromotionPermitted
 	r.listPromoPoliciesFn = r.kargoClient.List
 	r.createPromotionFn = kargoClient.Create
 	// Discovering latest Freight:
@@ -294,6 +365,7 @@ func (r *reconciler) Reconcile(
 	} else {
 		newStatus, err = r.syncNormalStage(ctx, stage)
 	}
+	setConditions(&newStatus, stage.Generation, err)
 	if err != nil {
 		newStatus.Error = err.Error()
 		logger.Errorf("error syncing Stage: %s", stage.Status.Error)
@@ -301,6 +373,20 @@ func (r *reconciler) Reconcile(
 		// Be sure to blank this out in case there's an error in this field from
 		// the previous reconciliation
 		newStatus.Error = ""
+		// If the current Freight is soaking, come back as soon as it has soaked
+		// long enough
+		if newStatus.Soak != nil && newStatus.Soak.Remaining != nil &&
+			newStatus.Soak.Remaining.Duration > 0 &&
+			newStatus.Soak.Remaining.Duration < result.RequeueAfter {
+			result.RequeueAfter = newStatus.Soak.Remaining.Duration
+		}
+		// If the Stage is locked, come back as soon as the lock expires
+		if lock := stage.Spec.Lock; lock != nil && lock.Until != nil {
+			if untilExpiry := lock.Until.Sub(r.nowFn()); untilExpiry > 0 &&
+				untilExpiry < result.RequeueAfter {
+				result.RequeueAfter = untilExpiry
+			}
+		}
 	}
 
 	updateErr := kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
@@ -308,11 +394,24 @@ func (r *reconciler) Reconcile(
 	})
 	if updateErr != nil {
 		logger.Errorf("error updating Stage status: %s", updateErr)
+	} else {
+		r.recordHealthChange(stage, newStatus)
 	}
 	clearRefreshErr := kargoapi.ClearStageRefresh(ctx, r.kargoClient, stage)
 	if clearRefreshErr != nil {
 		logger.Errorf("error clearing Stage refresh annotation: %s", clearRefreshErr)
 	}
+	// Only clear the acknowledgement of a rollback once it has been recorded
+	var clearAckErr error
+	if updateErr == nil {
+		if clearAckErr =
+			kargoapi.ClearStageRollbackAcknowledgement(ctx, r.kargoClient, stage); clearAckErr != nil {
+			logger.Errorf(
+				"error clearing Stage rollback acknowledgement annotation: %s",
+				clearAckErr,
+			)
+		}
+	}
 
 	// If we had no error, but couldn't update, then we DO have an error. But we
 	// do it this way so that a failure to update is never counted as THE failure
@@ -323,6 +422,9 @@ func (r *reconciler) Reconcile(
 	if err == nil {
 		err = clearRefreshErr
 	}
+	if err == nil {
+		err = clearAckErr
+	}
 	logger.Debug("done reconciling Stage")
 
 	// Controller runtime automatically gives us a progressive backoff if err is
@@ -344,11 +446,19 @@ func (r *reconciler) syncControlFlowStage(
 	// begin with is that the Stage USED TO have promotion mechanisms, but they
 	// were removed, thus becoming a control flow Stage.
 	status.CurrentFreight = nil
+	// For the same reason, remove conditions that only apply to Stages that
+	// have Freight promoted to them.
+	for _, conditionType := range []string{
+		kargoapi.StageConditionTypeDrifted,
+		kargoapi.StageConditionTypeVerified,
+		kargoapi.StageConditionTypeAutoPromotionBlocked,
+	} {
+		meta.RemoveStatusCondition(&status.Conditions, conditionType)
+	}
 
-	// For now all available Freight (qualified upstream) should automatically and
-	// immediately be qualified for this Stage, making it available downstream. In
-	// the future, we may have more options before qualifying them (e.g. require
-	// that they were qualified in all our upstreams)
+	// All available Freight (qualified upstream in accordance with the Stage's
+	// qualification rule) should automatically and immediately be qualified for
+	// this Stage, making it available downstream.
 	var availableFreight []kargoapi.Freight
 	var err error
 	if stage.Spec.Subscriptions.Warehouse != "" {
@@ -369,6 +479,7 @@ func (r *reconciler) syncControlFlowStage(
 			ctx,
 			stage.Namespace,
 			stage.Spec.Subscriptions.UpstreamStages,
+			stage.Spec.Subscriptions.QualificationRule,
 		); err != nil {
 			return status, errors.Wrapf(
 				err,
@@ -396,6 +507,14 @@ func (r *reconciler) syncControlFlowStage(
 					stage.Name,
 				)
 			}
+			r.recordStageEvent(
+				stage,
+				af.Name,
+				corev1.EventTypeNormal,
+				kargoapi.EventReasonFreightQualified,
+				"Freight %s qualified for Stage",
+				af.Name,
+			)
 		}
 	}
 	return status, nil
@@ -409,29 +528,51 @@ func (r *reconciler) syncNormalStage(
 
 	logger := logging.LoggerFromContext(ctx)
 
+	// If the most recent automatic rollback has been acknowledged, record that.
+	// This lifts the pause on auto-promotion.
+	if _, ok := stage.Annotations[kargoapi.AnnotationKeyAcknowledgeRollback]; ok &&
+		len(status.Rollbacks) > 0 && !status.Rollbacks[0].Acknowledged {
+		status.Rollbacks[0].Acknowledged = true
+		logger.Debug("automatic rollback of Stage acknowledged")
+	}
+
 	// Skip the entire reconciliation loop if there are Promotions associate with
 	// this Stage in a non-terminal state. The promotion process and this
 	// reconciliation loop BOTH update Stage status, so this check helps us
-	// to avoid race conditions that may otherwise arise.
-	if hasNonTerminalPromos, err := r.hasNonTerminalPromotionsFn(
+	// to avoid race conditions that may otherwise arise. The one exception is
+	// that newer Freight may still be queued for auto-promotion behind those
+	// Promotions, since that does not involve Stage status.
+	nonTerminalPromos, err := r.getNonTerminalPromotionsFn(
 		ctx,
 		stage.Namespace,
 		stage.Name,
-	); err != nil {
+	)
+	if err != nil {
 		return status, err
-	} else if hasNonTerminalPromos {
+	}
+	if len(nonTerminalPromos) > 0 {
 		logger.Debug(
 			"Stage has one or more Promotions in a non-terminal phase; skipping " +
 				"this reconciliation loop",
 		)
-		return status, nil
+		return status, r.queueAutoPromotion(ctx, stage, nonTerminalPromos)
 	}
 
 	status.ObservedGeneration = stage.Generation
 	status.Health = nil // Reset health
 	status.CurrentPromotion = nil
 
+	// A locked Stage is never automatically promoted, rolled back, or otherwise
+	// modified
+	locked := stage.Spec.Lock != nil && stage.Spec.Lock.IsActive(r.nowFn())
+
 	if status.CurrentFreight == nil {
+		status.Soak = nil
+		meta.RemoveStatusCondition(
+			&status.Conditions,
+			kargoapi.StageConditionTypeDrifted,
+		)
+		setVerifiedCondition(&status, stage.Generation)
 		logger.Debug("Stage has no current Freight; no health checks to perform")
 	} else { //  Check health and qualify current Freight if applicable
 		freightLogger := logger.WithField("freight", status.CurrentFreight.ID)
@@ -441,6 +582,7 @@ func (r *reconciler) syncNormalStage(
 			ctx,
 			*status.CurrentFreight,
 			stage.Spec.PromotionMechanisms.ArgoCDAppUpdates,
+			stage.Spec.HealthChecks,
 		)
 		if status.Health != nil {
 			freightLogger.WithField("health", status.Health.Status).
@@ -449,14 +591,14 @@ func (r *reconciler) syncNormalStage(
 			freightLogger.Debug("Stage health deemed not applicable")
 		}
 
-		// If health is not applicable or healthy, qualify the current Freight for
+		// If health is not applicable or healthy, and the Stage has been so for
+		// at least its minimum soak duration, qualify the current Freight for
 		// this Stage
-		if status.Health == nil || status.Health.Status == kargoapi.HealthStateHealthy {
+		if r.soak(stage.Spec.MinimumSoakDuration, &status) {
 			if err := r.qualifyFreightFn(
 				ctx,
-				stage.Namespace,
+				stage,
 				status.CurrentFreight.ID,
-				stage.Name,
 			); err != nil {
 				return status, errors.Wrapf(
 					err,
@@ -466,15 +608,117 @@ func (r *reconciler) syncNormalStage(
 					stage.Name,
 				)
 			}
+		} else if status.Soak != nil && status.Soak.Remaining != nil {
+			freightLogger.WithField("remaining", status.Soak.Remaining.Duration).
+				Debug("Freight has not yet soaked for the minimum duration")
+		}
+		setVerifiedCondition(&status, stage.Generation)
+
+		// Check whether what is actually deployed has drifted from the current
+		// Freight
+		if argoCDAppUpdates :=
+			stage.Spec.PromotionMechanisms.ArgoCDAppUpdates; len(argoCDAppUpdates) == 0 {
+			meta.RemoveStatusCondition(
+				&status.Conditions,
+				kargoapi.StageConditionTypeDrifted,
+			)
+		} else {
+			drift := r.checkDriftFn(ctx, *status.CurrentFreight, argoCDAppUpdates)
+			setDriftedCondition(&status, stage.Generation, drift)
+			if len(drift) > 0 {
+				freightLogger.WithField("drift", drift).
+					Debug("Stage has drifted from its current Freight")
+				if stage.Spec.DriftPolicy == kargoapi.DriftPolicyReassert && !locked {
+					if _, err := r.reassertFreightFn(
+						ctx,
+						stage,
+						*status.CurrentFreight,
+					); err != nil {
+						return status, errors.Wrapf(
+							err,
+							"error re-asserting Freight %q in Stage %q in namespace %q",
+							status.CurrentFreight.ID,
+							stage.Name,
+							stage.Namespace,
+						)
+					}
+					freightLogger.Info("re-asserted current Freight to correct drift")
+				}
+			}
+		}
+
+		// If the Stage became Unhealthy shortly after a promotion, roll back
+		if !locked {
+			rolledBack, err := r.autoRollbackFn(ctx, stage, &status)
+			if err != nil {
+				return status, errors.Wrapf(
+					err,
+					"error rolling back Stage %q in namespace %q",
+					stage.Name,
+					stage.Namespace,
+				)
+			}
+			if rolledBack {
+				r.blockAutoPromotion(&status, stage.Generation, kargoapi.AutoPromotionDecision{
+					Reason: kargoapi.AutoPromotionReasonRollbackUnacknowledged,
+					Message: "Auto-promotion is paused pending acknowledgement of an " +
+						"automatic rollback",
+				})
+				return status, nil
+			}
+		}
+	}
+
+	if locked {
+		logger.Debug("Stage is locked; skipping auto-promotion")
+		if r.blockAutoPromotion(&status, stage.Generation, kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonLocked,
+			Message: "Auto-promotion is blocked while the Stage is locked",
+		}) {
+			r.recordStageEvent(
+				stage,
+				"",
+				corev1.EventTypeNormal,
+				kargoapi.EventReasonAutoPromotionSkipped,
+				"Auto-promotion skipped: Stage is locked",
+			)
+		}
+		return status, nil
+	}
+
+	// Auto-promotion is paused until the most recent automatic rollback has been
+	// acknowledged
+	if status.AutoPromotionPaused() {
+		logger.Debug(
+			"auto-promotion is paused pending acknowledgement of an automatic " +
+				"rollback",
+		)
+		if r.blockAutoPromotion(&status, stage.Generation, kargoapi.AutoPromotionDecision{
+			Reason: kargoapi.AutoPromotionReasonRollbackUnacknowledged,
+			Message: "Auto-promotion is paused pending acknowledgement of an " +
+				"automatic rollback",
+		}) {
+			r.recordStageEvent(
+				stage,
+				"",
+				corev1.EventTypeWarning,
+				kargoapi.EventReasonAutoPromotionSkipped,
+				"Auto-promotion skipped: paused pending acknowledgement of an "+
+					"automatic rollback",
+			)
 		}
+		return status, nil
 	}
 
-	// All of these conditions disqualify auto-promotion
-	if stage.Spec.Subscriptions == nil || // No subs at all
-		(stage.Spec.Subscriptions.Warehouse == "" && len(stage.Spec.Subscriptions.UpstreamStages) == 0) || // No subs at all
-		(stage.Spec.Subscriptions.Warehouse != "" && len(stage.Spec.Subscriptions.UpstreamStages) > 0) || // Ambiguous
-		len(stage.Spec.Subscriptions.UpstreamStages) > 1 { // Ambiguous
-		logger.Debug("Stage is not eligible for auto-promotion")
+	// Nothing beyond this point blocks auto-promotion, except for the latest
+	// Freight having previously failed in this Stage
+	setAutoPromotionBlockedCondition(&status, stage.Generation, "", "")
+
+	if decision :=
+		kargo.CheckAutoPromotionSubscriptions(stage.Spec.Subscriptions); decision != nil {
+		logger.WithField("reason", decision.Reason).
+			Debug("Stage is not eligible for auto-promotion")
+		r.recordAutoPromotionDecision(&status, *decision)
 		return status, nil
 	}
 
@@ -483,8 +727,8 @@ func (r *reconciler) syncNormalStage(
 	logger.Debug(
 		"Stage is eligible for auto-promotion; checking if it is permitted...",
 	)
-	if permitted, err :=
-		r.isAutoPromotionPermittedFn(ctx, stage.Namespace, stage.Name); err != nil {
+	if decision, err :=
+		r.checkAutoPromotionPermittedFn(ctx, stage.Namespace, stage.Name); err != nil {
 		return status, errors.Wrapf(
 			err,
 			"error checking if auto-promotion is permitted for Stage %q in "+
@@ -492,8 +736,10 @@ func (r *reconciler) syncNormalStage(
 			stage.Name,
 			stage.Namespace,
 		)
-	} else if !permitted {
-		logger.Debug("auto-promotion is not permitted for the Stage")
+	} else if decision != nil {
+		logger.WithField("reason", decision.Reason).
+			Debug("auto-promotion is not permitted for the Stage")
+		r.recordAutoPromotionDecision(&status, *decision)
 		return status, nil
 	}
 
@@ -513,6 +759,10 @@ func (r *reconciler) syncNormalStage(
 
 	if latestFreight == nil {
 		logger.Debug("no Freight found")
+		r.recordAutoPromotionDecision(&status, kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonNoFreight,
+			Message: "No Freight is available to the Stage",
+		})
 		return status, nil
 	}
 
@@ -522,16 +772,57 @@ func (r *reconciler) syncNormalStage(
 	if stage.Status.CurrentFreight != nil &&
 		stage.Status.CurrentFreight.ID == latestFreight.Name {
 		logger.Debug("Stage already has latest qualified Freight")
+		r.recordAutoPromotionDecision(&status, kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonAlreadyCurrent,
+			Message: "Latest available Freight is already the Stage's current Freight",
+			Freight: latestFreight.Name,
+		})
+		return status, nil
+	}
+
+	// Never automatically re-promote Freight that has already failed in this
+	// Stage
+	if failure, failed := latestFreight.Status.Failures[stage.Name]; failed {
+		logger.Debug("latest qualified Freight previously failed in Stage")
+		if r.blockAutoPromotion(&status, stage.Generation, kargoapi.AutoPromotionDecision{
+			Reason: kargoapi.AutoPromotionReasonFreightPreviouslyFailed,
+			Message: fmt.Sprintf(
+				"Auto-promotion is blocked because Freight %s previously failed "+
+					"in the Stage: %s",
+				latestFreight.Name,
+				failure.Reason,
+			),
+			Freight: latestFreight.Name,
+		}) {
+			r.recordStageEvent(
+				stage,
+				latestFreight.Name,
+				corev1.EventTypeWarning,
+				kargoapi.EventReasonAutoPromotionSkipped,
+				"Auto-promotion skipped: Freight %s previously failed in Stage: %s",
+				latestFreight.Name,
+				failure.Reason,
+			)
+		}
 		return status, nil
 	}
 
 	logger.Debug("auto-promotion will proceed")
 
 	promo := kargo.NewPromotion(*stage, latestFreight.ID)
+	// If even newer Freight turns up before this Promotion is executed, there is
+	// no point in executing it
+	promo.Spec.Supersedable = true
 	if err :=
 		r.createPromotionFn(ctx, &promo, &client.CreateOptions{}); err != nil {
 		if apierrors.IsAlreadyExists(err) {
 			logger.Debug("Promotion resource already exists")
+			r.recordAutoPromotionDecision(&status, kargoapi.AutoPromotionDecision{
+				Reason:    kargoapi.AutoPromotionReasonPromoted,
+				Message:   fmt.Sprintf("Promoted Stage to Freight %s", latestFreight.Name),
+				Freight:   latestFreight.Name,
+				Promotion: promo.Name,
+			})
 			return status, nil
 		}
 		return status, errors.Wrapf(
@@ -543,15 +834,112 @@ func (r *reconciler) syncNormalStage(
 		)
 	}
 	logger.WithField("promotion", promo.Name).Debug("created Promotion resource")
+	r.recordAutoPromotionDecision(&status, kargoapi.AutoPromotionDecision{
+		Reason:    kargoapi.AutoPromotionReasonPromoted,
+		Message:   fmt.Sprintf("Promoted Stage to Freight %s", latestFreight.Name),
+		Freight:   latestFreight.Name,
+		Promotion: promo.Name,
+	})
 
 	return status, nil
 }
 
-func (r *reconciler) hasNonTerminalPromotions(
+// queueAutoPromotion creates a supersedable Promotion of the provided Stage to
+// the latest Freight available to it behind the provided non-terminal
+// Promotions of the Stage. This happens only if the Stage is eligible for and
+// permitted to auto-promote, if that Freight is not already current or the
+// subject of one of those Promotions, and if every one of those Promotions
+// that is Pending is itself supersedable. A supersedable Promotion that is
+// still Pending when the new one is queued is then superseded by it. Because
+// the provided Promotions may update the Stage's status at any time, this
+// never modifies Stage status.
+func (r *reconciler) queueAutoPromotion(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promos []kargoapi.Promotion,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+	if stage.Spec == nil ||
+		(stage.Spec.Lock != nil && stage.Spec.Lock.IsActive(r.nowFn())) ||
+		stage.Status.AutoPromotionPaused() ||
+		kargo.CheckAutoPromotionSubscriptions(stage.Spec.Subscriptions) != nil {
+		return nil
+	}
+	for _, promo := range promos {
+		if promo.Status.Phase.IsTerminal() ||
+			promo.Status.Phase == kargoapi.PromotionPhaseRunning {
+			continue
+		}
+		if promo.Spec == nil || !promo.Spec.Supersedable {
+			// Queuing more Freight behind a Promotion that cannot be superseded
+			// would defer, rather than avoid, redundant work
+			return nil
+		}
+	}
+
+	if decision, err :=
+		r.checkAutoPromotionPermittedFn(ctx, stage.Namespace, stage.Name); err != nil {
+		return errors.Wrapf(
+			err,
+			"error checking if auto-promotion is permitted for Stage %q in "+
+				"namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	} else if decision != nil {
+		return nil
+	}
+
+	latestFreight, err :=
+		r.getLatestAvailableFreightFn(ctx, stage.Namespace, *stage.Spec.Subscriptions)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding latest Freight for Stage %q in namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	if latestFreight == nil ||
+		(stage.Status.CurrentFreight != nil &&
+			stage.Status.CurrentFreight.ID == latestFreight.Name) {
+		return nil
+	}
+	if _, failed := latestFreight.Status.Failures[stage.Name]; failed {
+		return nil
+	}
+	for _, promo := range promos {
+		if promo.Spec != nil && promo.Spec.Freight == latestFreight.Name {
+			return nil
+		}
+	}
+
+	promo := kargo.NewPromotion(*stage, latestFreight.ID)
+	promo.Spec.Supersedable = true
+	if err =
+		r.createPromotionFn(ctx, &promo, &client.CreateOptions{}); err != nil {
+		return errors.Wrapf(
+			err,
+			"error creating Promotion of Stage %q in namespace %q to Freight %q",
+			stage.Name,
+			stage.Namespace,
+			latestFreight.Name,
+		)
+	}
+	logger.WithFields(log.Fields{
+		"freight":   latestFreight.Name,
+		"promotion": promo.Name,
+	}).Debug("queued Promotion resource behind non-terminal Promotions")
+	return nil
+}
+
+// getNonTerminalPromotions returns all Promotions of the specified Stage that
+// are in a non-terminal phase.
+func (r *reconciler) getNonTerminalPromotions(
 	ctx context.Context,
 	stageNamespace string,
 	stageName string,
-) (bool, error) {
+) ([]kargoapi.Promotion, error) {
 	promos := kargoapi.PromotionList{}
 	if err := r.listPromosFn(
 		ctx,
@@ -563,7 +951,7 @@ func (r *reconciler) hasNonTerminalPromotions(
 			}).AsSelector(),
 		},
 	); err != nil {
-		return false, errors.Wrapf(
+		return nil, errors.Wrapf(
 			err,
 			"error listing Promotions in non-terminal phases for Stage %q in "+
 				"namespace %q",
@@ -571,16 +959,17 @@ func (r *reconciler) hasNonTerminalPromotions(
 			stageName,
 		)
 	}
-	return len(promos.Items) > 0, nil
+	return promos.Items, nil
 }
 
 func (r *reconciler) qualifyFreight(
 	ctx context.Context,
-	namespace string,
+	stage *kargoapi.Stage,
 	freightName string,
-	stageName string,
 ) error {
 	logger := logging.LoggerFromContext(ctx).WithField("freight", freightName)
+	namespace := stage.Namespace
+	stageName := stage.Name
 
 	// Find the Freight
 	freight, err := r.getFreightFn(
@@ -628,9 +1017,132 @@ func (r *reconciler) qualifyFreight(
 	}
 
 	logger.Debug("qualified Freight for Stage")
+	r.recordStageEvent(
+		stage,
+		freightName,
+		corev1.EventTypeNormal,
+		kargoapi.EventReasonFreightQualified,
+		"Freight %s qualified for Stage",
+		freightName,
+	)
 	return nil
 }
 
+// recordAutoPromotionDecision records the provided decision as the most
+// recent auto-promotion decision within the provided StageStatus. If the
+// decision is the same as the one already recorded, the time at which that
+// decision was first reached is retained. It returns true if the decision
+// differs from the one already recorded.
+func (r *reconciler) recordAutoPromotionDecision(
+	status *kargoapi.StageStatus,
+	decision kargoapi.AutoPromotionDecision,
+) bool {
+	decision.Time = metav1.NewTime(r.nowFn())
+	changed := true
+	if last := status.LastAutoPromotionDecision; last != nil &&
+		last.Reason == decision.Reason && last.Freight == decision.Freight {
+		decision.Time = last.Time
+		changed = false
+	}
+	status.LastAutoPromotionDecision = &decision
+	return changed
+}
+
+// blockAutoPromotion records the provided decision as the most recent
+// auto-promotion decision within the provided StageStatus and reflects it in
+// the Stage's AutoPromotionBlocked condition. It returns true if the decision
+// differs from the one already recorded, which callers use to avoid recording
+// the same Event on every reconciliation.
+func (r *reconciler) blockAutoPromotion(
+	status *kargoapi.StageStatus,
+	generation int64,
+	decision kargoapi.AutoPromotionDecision,
+) bool {
+	changed := r.recordAutoPromotionDecision(status, decision)
+	setAutoPromotionBlockedCondition(
+		status,
+		generation,
+		decision.Reason,
+		decision.Message,
+	)
+	return changed
+}
+
+// recordHealthChange records a Kubernetes Event if the health recorded in the
+// provided new status of the provided Stage differs from the health recorded
+// in its current status. No Event is recorded if health is not applicable to
+// the Stage.
+func (r *reconciler) recordHealthChange(
+	stage *kargoapi.Stage,
+	newStatus kargoapi.StageStatus,
+) {
+	if newStatus.Health == nil {
+		return
+	}
+	var previousHealth kargoapi.HealthState
+	if stage.Status.Health != nil {
+		previousHealth = stage.Status.Health.Status
+	}
+	health := newStatus.Health.Status
+	if health == previousHealth {
+		return
+	}
+	var freight string
+	if newStatus.CurrentFreight != nil {
+		freight = newStatus.CurrentFreight.ID
+	}
+	eventType := corev1.EventTypeNormal
+	if health == kargoapi.HealthStateUnhealthy {
+		eventType = corev1.EventTypeWarning
+	}
+	message := fmt.Sprintf("Stage health changed to %s", health)
+	if previousHealth != "" {
+		message = fmt.Sprintf("%s from %s", message, previousHealth)
+	}
+	if len(newStatus.Health.Issues) > 0 {
+		message = fmt.Sprintf(
+			"%s: %s",
+			message,
+			strings.Join(newStatus.Health.Issues, "; "),
+		)
+	}
+	r.recordStageEvent(
+		stage,
+		freight,
+		eventType,
+		kargoapi.EventReasonHealthChanged,
+		"%s",
+		message,
+	)
+}
+
+// recordStageEvent records a Kubernetes Event concerning the provided Stage,
+// annotated with the Stage's name and, if one is provided, the name of the
+// Freight the Event concerns.
+func (r *reconciler) recordStageEvent(
+	stage *kargoapi.Stage,
+	freight string,
+	eventType string,
+	reason string,
+	messageFmt string,
+	args ...any,
+) {
+	annotations := map[string]string{
+		kargoapi.AnnotationKeyEventStage: stage.Name,
+	}
+	if freight != "" {
+		annotations[kargoapi.AnnotationKeyEventFreight] = freight
+	}
+	r.recorder.AnnotatedEventf(
+		stage,
+		annotations,
+		eventType,
+		reason,
+		messageFmt,
+		args...,
+	)
+}
+
 func (r *reconciler) patchFreightStatus(
 	ctx context.Context,
 	freight *kargoapi.Freight,
@@ -652,12 +1164,14 @@ func (r *reconciler) patchFreightStatus(
 	)
 }
 
-func (r *reconciler) isAutoPromotionPermitted(
+// checkAutoPromotionPermitted returns a decision explaining why the
+// PromotionPolicies associated with the specified Stage do not permit
+// auto-promotion of the Stage. It returns nil if they do.
+func (r *reconciler) checkAutoPromotionPermitted(
 	ctx context.Context,
 	namespace string,
 	stageName string,
-) (bool, error) {
-	logger := logging.LoggerFromContext(ctx)
+) (*kargoapi.AutoPromotionDecision, error) {
 	policies := kargoapi.PromotionPolicyList{}
 	if err := r.listPromoPoliciesFn(
 		ctx,
@@ -669,28 +1183,14 @@ func (r *reconciler) isAutoPromotionPermitted(
 			}).AsSelector(),
 		},
 	); err != nil {
-		return false, errors.Wrapf(
+		return nil, errors.Wrapf(
 			err,
 			"error listing PromotionPolicies for Stage %q in namespace %q",
 			stageName,
 			namespace,
 		)
 	}
-	if len(policies.Items) == 0 {
-		logger.Debug("no PromotionPolicy is associated with the Stage")
-		return false, nil
-	}
-	if len(policies.Items) > 1 {
-		logger.Debug("multiple PromotionPolicies are associated with the Stage")
-		return false, nil
-	}
-	if !policies.Items[0].EnableAutoPromotion {
-		logger.Debug(
-			"PromotionPolicy does not enable auto-promotion for the Stage",
-		)
-		return false, nil
-	}
-	return true, nil
+	return kargo.CheckPromotionPolicies(policies.Items), nil
 }
 
 func (r *reconciler) getLatestAvailableFreight(
@@ -725,19 +1225,22 @@ func (r *reconciler) getLatestAvailableFreight(
 		ctx,
 		namespace,
 		subs.UpstreamStages,
+		subs.QualificationRule,
 	)
 	if err != nil {
+		upstreamStages := make([]string, len(subs.UpstreamStages))
+		for i, upstreamStage := range subs.UpstreamStages {
+			upstreamStages[i] = upstreamStage.Name
+		}
 		return nil, errors.Wrapf(
 			err,
-			"error finding Freight qualified for Stages upstream from "+
-				"Stage %q in namespace %q",
-			subs.UpstreamStages[0].Name,
+			"error finding Freight qualified for Stages %s in namespace %q",
+			strings.Join(upstreamStages, ", "),
 			namespace,
 		)
 	}
 	if latestFreight == nil {
-		logger.WithField("upstreamStage", subs.UpstreamStages[0]).
-			Debug("no qualified Freight found for upstream Stage")
+		logger.Debug("no qualified Freight found for upstream Stages")
 	}
 	return latestFreight, nil
 }
@@ -766,15 +1269,21 @@ func (r *reconciler) getAllFreightFromWarehouse(
 			namespace,
 		)
 	}
-	if len(freight.Items) == 0 {
+	// Freight derived from a partial promotion is never a candidate
+	items := make([]kargoapi.Freight, 0, len(freight.Items))
+	for _, f := range freight.Items {
+		if !kargo.IsDerivedFreight(f) {
+			items = append(items, f)
+		}
+	}
+	if len(items) == 0 {
 		return nil, nil
 	}
 	// Sort by creation timestamp, descending
-	sort.SliceStable(freight.Items, func(i, j int) bool {
-		return freight.Items[j].CreationTimestamp.
-			Before(&freight.Items[i].CreationTimestamp)
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[j].CreationTimestamp.Before(&items[i].CreationTimestamp)
 	})
-	return freight.Items, nil
+	return items, nil
 }
 
 func (r *reconciler) getLatestFreightFromWarehouse(
@@ -796,10 +1305,12 @@ func (r *reconciler) getAllFreightQualifiedForUpstreamStages(
 	ctx context.Context,
 	namespace string,
 	stageSubs []kargoapi.StageSubscription,
+	rule *kargoapi.QualificationRule,
 ) ([]kargoapi.Freight, error) {
 	// Start by building a de-duped map of Freight qualified for ANY upstream
-	// Stage
+	// Stage, keeping count of how many upstream Stages each is qualified for
 	qualifiedFreight := map[string]kargoapi.Freight{}
+	qualifications := map[string]int{}
 	for _, stageSub := range stageSubs {
 		var freight kargoapi.FreightList
 		if err := r.listFreightFn(
@@ -821,7 +1332,20 @@ func (r *reconciler) getAllFreightQualifiedForUpstreamStages(
 			)
 		}
 		for _, freight := range freight.Items {
+			// Freight derived from a partial promotion is never a candidate, even
+			// after the Stage it was promoted into has qualified it
+			if kargo.IsDerivedFreight(freight) {
+				continue
+			}
 			qualifiedFreight[freight.Name] = freight
+			qualifications[freight.Name]++
+		}
+	}
+	// Weed out any Freight that doesn't satisfy the qualification rule
+	minQualifications := rule.MinQualifications(len(stageSubs))
+	for name := range qualifiedFreight {
+		if qualifications[name] < minQualifications {
+			delete(qualifiedFreight, name)
 		}
 	}
 	if len(qualifiedFreight) == 0 {
@@ -846,9 +1370,10 @@ func (r *reconciler) getLatestFreightQualifiedForUpstreamStages(
 	ctx context.Context,
 	namespace string,
 	stageSubs []kargoapi.StageSubscription,
+	rule *kargoapi.QualificationRule,
 ) (*kargoapi.Freight, error) {
 	qualifiedFreight, err :=
-		r.getAllFreightQualifiedForUpstreamStagesFn(ctx, namespace, stageSubs)
+		r.getAllFreightQualifiedForUpstreamStagesFn(ctx, namespace, stageSubs, rule)
 	if err != nil {
 		return nil, err
 	}
@@ -8,13 +8,16 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
 )
 
 func TestNewReconciler(t *testing.T) {
@@ -22,22 +25,32 @@ func TestNewReconciler(t *testing.T) {
 	e := newReconciler(
 		kubeClient,
 		kubeClient,
+		&record.FakeRecorder{},
 	)
 	require.NotNil(t, e.kargoClient)
 	require.NotNil(t, e.argoClient)
+	require.NotNil(t, e.recorder)
 	// Assert that all overridable behaviors were initialized to a default:
 	// Loop guard:
-	require.NotNil(t, e.hasNonTerminalPromotionsFn)
+	require.NotNil(t, e.getNonTerminalPromotionsFn)
 	require.NotNil(t, e.listPromosFn)
 	// Health checks:
 	require.NotNil(t, e.checkHealthFn)
 	require.NotNil(t, e.getArgoCDAppFn)
+	require.NotNil(t, e.getResourceFn)
+	// Drift detection:
+	require.NotNil(t, e.checkDriftFn)
+	require.NotNil(t, e.reassertFreightFn)
 	// Freight qualification:
+	require.NotNil(t, e.nowFn)
 	require.NotNil(t, e.getFreightFn)
 	require.NotNil(t, e.qualifyFreightFn)
 	require.NotNil(t, e.patchFreightStatusFn)
+	// Auto-rollback:
+	require.NotNil(t, e.autoRollbackFn)
+	require.NotNil(t, e.markFreightFailedFn)
 	// Auto-promotion:
-	require.NotNil(t, e.isAutoPromotionPermittedFn)
+	require.NotNil(t, e.checkAutoPromotionPermittedFn)
 	require.NotNil(t, e.listPromoPoliciesFn)
 	require.NotNil(t, e.createPromotionFn)
 	// Discovering latest Freight:
@@ -70,6 +83,7 @@ func TestSyncControlFlowStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getAllFreightFromWarehouseFn: func(
 					context.Context,
 					string,
@@ -106,10 +120,12 @@ func TestSyncControlFlowStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getAllFreightQualifiedForUpstreamStagesFn: func(
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					*kargoapi.QualificationRule,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -140,6 +156,7 @@ func TestSyncControlFlowStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getAllFreightFromWarehouseFn: func(
 					context.Context,
 					string,
@@ -184,6 +201,7 @@ func TestSyncControlFlowStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getAllFreightFromWarehouseFn: func(
 					context.Context,
 					string,
@@ -227,6 +245,14 @@ func TestSyncNormalStage(t *testing.T) {
 		context.Context,
 		string,
 		string,
+	) ([]kargoapi.Promotion, error) {
+		return nil, nil
+	}
+
+	noAutoRollbackFn := func(
+		context.Context,
+		*kargoapi.Stage,
+		*kargoapi.StageStatus,
 	) (bool, error) {
 		return false, nil
 	}
@@ -245,12 +271,14 @@ func TestSyncNormalStage(t *testing.T) {
 			name:  "error checking for non-terminal promotions",
 			stage: &kargoapi.Stage{},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: func(
+				nowFn:    time.Now,
+				recorder: &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return false, errors.New("something went wrong")
+				) ([]kargoapi.Promotion, error) {
+					return nil, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
@@ -269,12 +297,14 @@ func TestSyncNormalStage(t *testing.T) {
 			name:  "non-terminal promotions found",
 			stage: &kargoapi.Stage{},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: func(
+				nowFn:    time.Now,
+				recorder: &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return true, nil
+				) ([]kargoapi.Promotion, error) {
+					return []kargoapi.Promotion{{}}, nil
 				},
 			},
 			assertions: func(
@@ -299,15 +329,19 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return errors.New("something went wrong")
 				},
 			},
@@ -324,6 +358,356 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error re-asserting Freight",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{}},
+					},
+					DriftPolicy: kargoapi.DriftPolicyReassert,
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
+					return nil
+				},
+				checkDriftFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) []string {
+					return []string{"something drifted"}
+				},
+				reassertFreightFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					freight kargoapi.SimpleFreight,
+				) (kargoapi.SimpleFreight, error) {
+					return freight, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error re-asserting Freight")
+				require.True(
+					t,
+					meta.IsStatusConditionTrue(
+						newStatus.Conditions,
+						kargoapi.StageConditionTypeDrifted,
+					),
+				)
+			},
+		},
+
+		{
+			name: "drift reported but not re-asserted",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{}},
+					},
+					DriftPolicy: kargoapi.DriftPolicyReport,
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
+					return nil
+				},
+				checkDriftFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+				) []string {
+					return []string{"something drifted"}
+				},
+				// If re-assertion were attempted, this would cause a panic
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				condition := meta.FindStatusCondition(
+					newStatus.Conditions,
+					kargoapi.StageConditionTypeDrifted,
+				)
+				require.NotNil(t, condition)
+				require.Equal(t, metav1.ConditionTrue, condition.Status)
+				require.Equal(t, "something drifted", condition.Message)
+			},
+		},
+
+		{
+			name: "error rolling back",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
+					return nil
+				},
+				autoRollbackFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.StageStatus,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error rolling back Stage")
+			},
+		},
+
+		{
+			name: "rolled back",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
+					return nil
+				},
+				autoRollbackFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status *kargoapi.StageStatus,
+				) (bool, error) {
+					status.RecordRollback(kargoapi.Rollback{ToFreight: "fake-freight"})
+					return true, nil
+				},
+				// If auto-promotion were attempted, this would cause a panic
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, newStatus.Rollbacks, 1)
+				require.True(t, newStatus.AutoPromotionPaused())
+			},
+		},
+
+		{
+			name: "auto-promotion paused",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Rollbacks: []kargoapi.Rollback{{}},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				// If auto-promotion were attempted, this would cause a panic
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				blocked := meta.FindStatusCondition(
+					newStatus.Conditions,
+					kargoapi.StageConditionTypeAutoPromotionBlocked,
+				)
+				require.NotNil(t, blocked)
+				require.Equal(t, metav1.ConditionTrue, blocked.Status)
+				require.Equal(t, "RollbackUnacknowledged", blocked.Reason)
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonRollbackUnacknowledged,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Status should otherwise be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "rollback acknowledged",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyAcknowledgeRollback: "true",
+					},
+				},
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Rollbacks: []kargoapi.Rollback{{}},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, newStatus.AutoPromotionPaused())
+			},
+		},
+
+		{
+			name: "Stage is locked",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Lock: &kargoapi.StageLock{
+						Reason: "fake-reason",
+					},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				recorder:                   &record.FakeRecorder{},
+				nowFn:                      time.Now,
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
+					return nil
+				},
+				// If a rollback or auto-promotion were attempted, this would cause a
+				// panic
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(
+					t,
+					meta.IsStatusConditionTrue(
+						newStatus.Conditions,
+						kargoapi.StageConditionTypeVerified,
+					),
+				)
+				blocked := meta.FindStatusCondition(
+					newStatus.Conditions,
+					kargoapi.StageConditionTypeAutoPromotionBlocked,
+				)
+				require.NotNil(t, blocked)
+				require.Equal(t, metav1.ConditionTrue, blocked.Status)
+				require.Equal(t, "Locked", blocked.Reason)
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonLocked,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Status should otherwise be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
 		{
 			name: "auto-promotion not possible",
 			stage: &kargoapi.Stage{
@@ -341,15 +725,19 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
 			},
@@ -359,11 +747,78 @@ func TestSyncNormalStage(t *testing.T) {
 				err error,
 			) {
 				require.NoError(t, err)
-				// Status should be returned unchanged
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonAmbiguousSubscriptions,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Other than its conditions and auto-promotion decision, status should
+				// be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
 
+		{
+			name: "auto-promotion possible with multiple upstream Stages",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "fake-stage"},
+							{Name: "another-fake-stage"},
+						},
+						QualificationRule: &kargoapi.QualificationRule{
+							Type: kargoapi.QualificationRuleTypeAll,
+						},
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
+					return nil
+				},
+				checkAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (*kargoapi.AutoPromotionDecision, error) {
+					// Getting this far proves the Stage was deemed eligible
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error checking if auto-promotion is permitted",
+				)
+			},
+		},
+
 		{
 			name: "error checking if auto-promotion is permitted",
 			stage: &kargoapi.Stage{
@@ -378,23 +833,27 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
-				isAutoPromotionPermittedFn: func(
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return false, errors.New("something went wrong")
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
@@ -409,7 +868,8 @@ func TestSyncNormalStage(t *testing.T) {
 					err.Error(),
 					"error checking if auto-promotion is permitted",
 				)
-				// Status should be returned unchanged
+				// Other than its conditions, status should be returned unchanged
+				newStatus.Conditions = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
@@ -428,23 +888,29 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
-				isAutoPromotionPermittedFn: func(
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return false, nil
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return &kargoapi.AutoPromotionDecision{
+						Reason: kargoapi.AutoPromotionReasonDisabled,
+					}, nil
 				},
 			},
 			assertions: func(
@@ -453,7 +919,16 @@ func TestSyncNormalStage(t *testing.T) {
 				err error,
 			) {
 				require.NoError(t, err)
-				// Status should be returned unchanged
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonDisabled,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Other than its conditions and auto-promotion decision, status should
+				// be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
@@ -472,23 +947,27 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
-				isAutoPromotionPermittedFn: func(
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return true, nil
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
 				},
 				getLatestAvailableFreightFn: func(
 					context.Context,
@@ -510,7 +989,8 @@ func TestSyncNormalStage(t *testing.T) {
 					err.Error(),
 					"error finding latest Freight for Stage",
 				)
-				// Status should be returned unchanged
+				// Other than its conditions, status should be returned unchanged
+				newStatus.Conditions = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
@@ -529,23 +1009,27 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
-				isAutoPromotionPermittedFn: func(
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return true, nil
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
 				},
 				getLatestAvailableFreightFn: func(
 					context.Context,
@@ -561,44 +1045,113 @@ func TestSyncNormalStage(t *testing.T) {
 				err error,
 			) {
 				require.NoError(t, err)
-				// Status should be returned unchanged
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonNoFreight,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Other than its conditions and auto-promotion decision, status should
+				// be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "Stage already has latest Freight",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{
+						ID: "fake-freight-id",
+					},
+				},
+			},
+			reconciler: &reconciler{
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.SimpleFreight,
+					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
+					return nil
+				},
+				checkAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					kargoapi.Subscriptions,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonAlreadyCurrent,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Other than its conditions and auto-promotion decision, status should
+				// be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
 
 		{
-			name: "Stage already has latest Freight",
+			name: "latest Freight previously failed in Stage",
 			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
 				Spec: &kargoapi.StageSpec{
 					Subscriptions: &kargoapi.Subscriptions{
 						Warehouse: "fake-warehouse",
 					},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
-				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.SimpleFreight{
-						ID: "fake-freight-id",
-					},
-				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
-				checkHealthFn: func(
-					context.Context,
-					kargoapi.SimpleFreight,
-					[]kargoapi.ArgoCDAppUpdate,
-				) *kargoapi.Health {
-					return nil
-				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
-					return nil
-				},
-				isAutoPromotionPermittedFn: func(
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return true, nil
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
 				},
 				getLatestAvailableFreightFn: func(
 					context.Context,
@@ -609,8 +1162,14 @@ func TestSyncNormalStage(t *testing.T) {
 						ObjectMeta: metav1.ObjectMeta{
 							Name: "fake-freight-id",
 						},
+						Status: kargoapi.FreightStatus{
+							Failures: map[string]kargoapi.Failure{
+								"fake-stage": {},
+							},
+						},
 					}, nil
 				},
+				// If a Promotion were created, this would cause a panic
 			},
 			assertions: func(
 				initialStatus kargoapi.StageStatus,
@@ -618,7 +1177,23 @@ func TestSyncNormalStage(t *testing.T) {
 				err error,
 			) {
 				require.NoError(t, err)
-				// Status should be returned unchanged
+				blocked := meta.FindStatusCondition(
+					newStatus.Conditions,
+					kargoapi.StageConditionTypeAutoPromotionBlocked,
+				)
+				require.NotNil(t, blocked)
+				require.Equal(t, metav1.ConditionTrue, blocked.Status)
+				require.Equal(t, "FreightPreviouslyFailed", blocked.Reason)
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonFreightPreviouslyFailed,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Other than its conditions and auto-promotion decision, status should
+				// be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
@@ -634,23 +1209,27 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
-				isAutoPromotionPermittedFn: func(
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return true, nil
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
 				},
 				getLatestAvailableFreightFn: func(
 					context.Context,
@@ -683,7 +1262,16 @@ func TestSyncNormalStage(t *testing.T) {
 				err error,
 			) {
 				require.NoError(t, err)
-				// Status should be returned unchanged
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonPromoted,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				// Other than its conditions and auto-promotion decision, status should
+				// be returned unchanged
+				newStatus.Conditions = nil
+				newStatus.LastAutoPromotionDecision = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
@@ -699,23 +1287,27 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
-				isAutoPromotionPermittedFn: func(
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return true, nil
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
 				},
 				getLatestAvailableFreightFn: func(
 					context.Context,
@@ -744,7 +1336,8 @@ func TestSyncNormalStage(t *testing.T) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
 				require.Contains(t, err.Error(), "error creating Promotion of Stage")
-				// Status should be returned unchanged
+				// Other than its conditions, status should be returned unchanged
+				newStatus.Conditions = nil
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
@@ -769,25 +1362,29 @@ func TestSyncNormalStage(t *testing.T) {
 				},
 			},
 			reconciler: &reconciler{
-				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				nowFn:                      time.Now,
+				recorder:                   &record.FakeRecorder{},
+				getNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				autoRollbackFn:             noAutoRollbackFn,
 				checkHealthFn: func(
 					context.Context,
 					kargoapi.SimpleFreight,
 					[]kargoapi.ArgoCDAppUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return &kargoapi.Health{
 						Status: kargoapi.HealthStateHealthy,
 					}
 				},
-				qualifyFreightFn: func(context.Context, string, string, string) error {
+				qualifyFreightFn: func(context.Context, *kargoapi.Stage, string) error {
 					return nil
 				},
-				isAutoPromotionPermittedFn: func(
+				checkAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
-				) (bool, error) {
-					return true, nil
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
 				},
 				getLatestAvailableFreightFn: func(
 					context.Context,
@@ -801,10 +1398,13 @@ func TestSyncNormalStage(t *testing.T) {
 					}, nil
 				},
 				createPromotionFn: func(
-					context.Context,
-					client.Object,
-					...client.CreateOption,
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
 				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					require.True(t, ok)
+					require.True(t, promo.Spec.Supersedable)
 					return nil
 				},
 			},
@@ -817,6 +1417,18 @@ func TestSyncNormalStage(t *testing.T) {
 				require.Equal(t, int64(42), newStatus.ObservedGeneration) // Set
 				require.NotNil(t, newStatus.Health)                       // Set
 				require.Nil(t, newStatus.CurrentPromotion)                // Cleared
+				require.NotNil(t, newStatus.LastAutoPromotionDecision)    // Set
+				require.Equal(
+					t,
+					kargoapi.AutoPromotionReasonPromoted,
+					newStatus.LastAutoPromotionDecision.Reason,
+				)
+				require.Equal(
+					t,
+					"fake-freight-id",
+					newStatus.LastAutoPromotionDecision.Freight,
+				)
+				require.NotEmpty(t, newStatus.LastAutoPromotionDecision.Promotion)
 			},
 		},
 	}
@@ -829,11 +1441,238 @@ func TestSyncNormalStage(t *testing.T) {
 	}
 }
 
-func TestHasNonTerminalPromotions(t *testing.T) {
+func TestSyncNormalStageQueuesAutoPromotion(t *testing.T) {
+	pendingAutoPromo := kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+		Spec: &kargoapi.PromotionSpec{
+			Stage:        "fake-stage",
+			Freight:      "older-freight",
+			Supersedable: true,
+		},
+		Status: kargoapi.PromotionStatus{
+			Phase: kargoapi.PromotionPhasePending,
+		},
+	}
+	testCases := []struct {
+		name       string
+		lock       *kargoapi.StageLock
+		promos     func() []kargoapi.Promotion
+		assertions func(created []*kargoapi.Promotion)
+	}{
+		{
+			name: "newer Freight while an auto-promotion is Pending",
+			promos: func() []kargoapi.Promotion {
+				return []kargoapi.Promotion{pendingAutoPromo}
+			},
+			assertions: func(created []*kargoapi.Promotion) {
+				require.Len(t, created, 1)
+				require.Equal(t, "fake-stage", created[0].Spec.Stage)
+				require.Equal(t, "newer-freight", created[0].Spec.Freight)
+				require.True(t, created[0].Spec.Supersedable)
+			},
+		},
+		{
+			name: "newer Freight while a Promotion is Running",
+			promos: func() []kargoapi.Promotion {
+				promo := *pendingAutoPromo.DeepCopy()
+				promo.Spec.Supersedable = false
+				promo.Status.Phase = kargoapi.PromotionPhaseRunning
+				return []kargoapi.Promotion{promo}
+			},
+			assertions: func(created []*kargoapi.Promotion) {
+				require.Len(t, created, 1)
+				require.Equal(t, "newer-freight", created[0].Spec.Freight)
+			},
+		},
+		{
+			name: "Pending Promotion is not supersedable",
+			promos: func() []kargoapi.Promotion {
+				promo := *pendingAutoPromo.DeepCopy()
+				promo.Spec.Supersedable = false
+				return []kargoapi.Promotion{promo}
+			},
+			assertions: func(created []*kargoapi.Promotion) {
+				require.Empty(t, created)
+			},
+		},
+		{
+			name: "latest Freight already queued",
+			promos: func() []kargoapi.Promotion {
+				promo := *pendingAutoPromo.DeepCopy()
+				promo.Spec.Freight = "newer-freight"
+				return []kargoapi.Promotion{promo}
+			},
+			assertions: func(created []*kargoapi.Promotion) {
+				require.Empty(t, created)
+			},
+		},
+		{
+			name: "Stage is locked",
+			lock: &kargoapi.StageLock{Reason: "fake-reason"},
+			promos: func() []kargoapi.Promotion {
+				return []kargoapi.Promotion{pendingAutoPromo}
+			},
+			assertions: func(created []*kargoapi.Promotion) {
+				require.Empty(t, created)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var created []*kargoapi.Promotion
+			r := &reconciler{
+				recorder: &record.FakeRecorder{},
+				nowFn:    time.Now,
+				getNonTerminalPromotionsFn: func(
+					context.Context,
+					string,
+					string,
+				) ([]kargoapi.Promotion, error) {
+					return testCase.promos(), nil
+				},
+				checkAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (*kargoapi.AutoPromotionDecision, error) {
+					return nil, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					kargoapi.Subscriptions,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "newer-freight"},
+						ID:         "newer-freight",
+					}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					created = append(created, obj.(*kargoapi.Promotion)) // nolint: forcetypeassert
+					return nil
+				},
+			}
+			stage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-stage",
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Lock:                testCase.lock,
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{ID: "current-freight"},
+				},
+			}
+			newStatus, err := r.syncNormalStage(context.Background(), stage)
+			require.NoError(t, err)
+			// Stage status is never modified while Promotions are non-terminal
+			require.Equal(t, stage.Status, newStatus)
+			testCase.assertions(created)
+		})
+	}
+}
+
+func TestSyncNormalStageRecordsAutoPromotionSkippedEventOnce(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &reconciler{
+		recorder: recorder,
+		nowFn:    time.Now,
+		getNonTerminalPromotionsFn: func(
+			context.Context,
+			string,
+			string,
+		) ([]kargoapi.Promotion, error) {
+			return nil, nil
+		},
+	}
+	stage := &kargoapi.Stage{
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+			Lock: &kargoapi.StageLock{
+				Reason: "fake-reason",
+			},
+		},
+	}
+	for i := 0; i < 3; i++ {
+		newStatus, err := r.syncNormalStage(context.Background(), stage)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			kargoapi.AutoPromotionReasonLocked,
+			newStatus.LastAutoPromotionDecision.Reason,
+		)
+		stage.Status = newStatus
+	}
+	require.Len(t, recorder.Events, 1)
+	require.Equal(
+		t,
+		"Normal AutoPromotionSkipped Auto-promotion skipped: Stage is locked",
+		<-recorder.Events,
+	)
+}
+
+func TestRecordAutoPromotionDecision(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	r := &reconciler{
+		nowFn: func() time.Time {
+			return now
+		},
+	}
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+	status := kargoapi.StageStatus{
+		LastAutoPromotionDecision: &kargoapi.AutoPromotionDecision{
+			Time:    earlier,
+			Reason:  kargoapi.AutoPromotionReasonAlreadyCurrent,
+			Freight: "fake-freight",
+		},
+	}
+
+	// An unchanged decision should retain the time it was first reached
+	require.False(
+		t,
+		r.recordAutoPromotionDecision(&status, kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonAlreadyCurrent,
+			Message: "fake-message",
+			Freight: "fake-freight",
+		}),
+	)
+	require.NotNil(t, status.LastAutoPromotionDecision)
+	require.Equal(t, earlier, status.LastAutoPromotionDecision.Time)
+	require.Equal(t, "fake-message", status.LastAutoPromotionDecision.Message)
+
+	// A new decision should be recorded with the current time
+	require.True(
+		t,
+		r.recordAutoPromotionDecision(&status, kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonAlreadyCurrent,
+			Freight: "another-fake-freight",
+		}),
+	)
+	require.Equal(t, metav1.NewTime(now), status.LastAutoPromotionDecision.Time)
+	require.Equal(
+		t,
+		"another-fake-freight",
+		status.LastAutoPromotionDecision.Freight,
+	)
+}
+
+func TestGetNonTerminalPromotions(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(bool, error)
+		assertions func([]kargoapi.Promotion, error)
 	}{
 		{
 			name: "error listing Promotions",
@@ -846,7 +1685,7 @@ func TestHasNonTerminalPromotions(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(_ bool, err error) {
+			assertions: func(_ []kargoapi.Promotion, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
 			},
@@ -865,9 +1704,9 @@ func TestHasNonTerminalPromotions(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(promos []kargoapi.Promotion, err error) {
 				require.NoError(t, err)
-				require.True(t, result)
+				require.Len(t, promos, 1)
 			},
 		},
 		{
@@ -884,15 +1723,15 @@ func TestHasNonTerminalPromotions(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(promos []kargoapi.Promotion, err error) {
 				require.NoError(t, err)
-				require.False(t, result)
+				require.Empty(t, promos)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			result, err := testCase.reconciler.hasNonTerminalPromotions(
+			result, err := testCase.reconciler.getNonTerminalPromotions(
 				context.Background(),
 				"fake-namespace",
 				"fake-stage",
@@ -911,6 +1750,7 @@ func TestQualifyFreight(t *testing.T) {
 		{
 			name: "error getting Freight",
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -928,6 +1768,7 @@ func TestQualifyFreight(t *testing.T) {
 		{
 			name: "Freight not found",
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -944,6 +1785,7 @@ func TestQualifyFreight(t *testing.T) {
 		{
 			name: "Freight already qualified for Stage",
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -965,6 +1807,7 @@ func TestQualifyFreight(t *testing.T) {
 		{
 			name: "error Patching Freight status",
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -988,6 +1831,7 @@ func TestQualifyFreight(t *testing.T) {
 		{
 			name: "success",
 			reconciler: &reconciler{
+				recorder: &record.FakeRecorder{},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -1013,20 +1857,24 @@ func TestQualifyFreight(t *testing.T) {
 			testCase.assertions(
 				testCase.reconciler.qualifyFreight(
 					context.Background(),
-					"fake-namespace",
+					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-stage",
+						},
+					},
 					"fake-freight",
-					"fake-stage",
 				),
 			)
 		})
 	}
 }
 
-func TestIsAutoPromotionPermitted(t *testing.T) {
+func TestCheckAutoPromotionPermitted(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(bool, error)
+		assertions func(*kargoapi.AutoPromotionDecision, error)
 	}{
 		{
 			name: "error listing PromotionPolicies",
@@ -1039,7 +1887,7 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(_ bool, err error) {
+			assertions: func(_ *kargoapi.AutoPromotionDecision, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
 				require.Contains(
@@ -1063,9 +1911,10 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(decision *kargoapi.AutoPromotionDecision, err error) {
 				require.NoError(t, err)
-				require.False(t, result)
+				require.NotNil(t, decision)
+				require.Equal(t, kargoapi.AutoPromotionReasonNoPromotionPolicy, decision.Reason)
 			},
 		},
 		{
@@ -1082,9 +1931,10 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(decision *kargoapi.AutoPromotionDecision, err error) {
 				require.NoError(t, err)
-				require.False(t, result)
+				require.NotNil(t, decision)
+				require.Equal(t, kargoapi.AutoPromotionReasonMultiplePromotionPolicies, decision.Reason)
 			},
 		},
 		{
@@ -1101,9 +1951,10 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(decision *kargoapi.AutoPromotionDecision, err error) {
 				require.NoError(t, err)
-				require.False(t, result)
+				require.NotNil(t, decision)
+				require.Equal(t, kargoapi.AutoPromotionReasonDisabled, decision.Reason)
 			},
 		},
 		{
@@ -1124,16 +1975,16 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(decision *kargoapi.AutoPromotionDecision, err error) {
 				require.NoError(t, err)
-				require.True(t, result)
+				require.Nil(t, decision)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				testCase.reconciler.isAutoPromotionPermitted(
+				testCase.reconciler.checkAutoPromotionPermitted(
 					context.Background(),
 					"fake-namespace",
 					"fake-stage",
@@ -1218,6 +2069,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -1242,6 +2094,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1261,6 +2114,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					*kargoapi.QualificationRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -1284,6 +2138,76 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 	}
 }
 
+func TestGetLatestAvailableFreightIgnoresDerivedFreight(t *testing.T) {
+	testCases := []struct {
+		name string
+		subs kargoapi.Subscriptions
+	}{
+		{
+			name: "Warehouse subscription",
+			subs: kargoapi.Subscriptions{Warehouse: "fake-warehouse"},
+		},
+		{
+			name: "upstream Stage subscription",
+			subs: kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{Name: "fake-upstream-stage"},
+				},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "warehouse-freight",
+								CreationTimestamp: metav1.Time{
+									Time: time.Now().Add(-time.Hour),
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "derived-freight",
+								Labels: map[string]string{
+									kargoapi.LabelKeyDerivedFreight: kargoapi.LabelTrueValue,
+								},
+								CreationTimestamp: metav1.Time{
+									Time: time.Now(),
+								},
+							},
+						},
+					}
+					return nil
+				},
+			}
+			r.getAllFreightFromWarehouseFn = r.getAllFreightFromWarehouse
+			r.getLatestFreightFromWarehouseFn = r.getLatestFreightFromWarehouse
+			r.getAllFreightQualifiedForUpstreamStagesFn =
+				r.getAllFreightQualifiedForUpstreamStages
+			r.getLatestFreightQualifiedForUpstreamStagesFn =
+				r.getLatestFreightQualifiedForUpstreamStages
+			freight, err := r.getLatestAvailableFreight(
+				context.Background(),
+				"fake-namespace",
+				testCase.subs,
+			)
+			require.NoError(t, err)
+			require.NotNil(t, freight)
+			require.Equal(t, "warehouse-freight", freight.Name)
+		})
+	}
+}
+
 func TestGetAllFreightFromWarehouse(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1467,6 +2391,8 @@ func TestGetAllFreightQualifiedForUpstreamStages(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
+		stageSubs  []kargoapi.StageSubscription
+		rule       *kargoapi.QualificationRule
 		assertions func([]kargoapi.Freight, error)
 	}{
 		{
@@ -1545,18 +2471,103 @@ func TestGetAllFreightQualifiedForUpstreamStages(t *testing.T) {
 				require.Equal(t, "older-freight", freight[1].Name)
 			},
 		},
+		{
+			name: "success with qualification rule",
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					opts ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					listOpts, ok := opts[0].(*client.ListOptions)
+					require.True(t, ok)
+					// Only the first two upstream Stages have the Freight qualified
+					switch listOpts.FieldSelector.String() {
+					case kubeclient.FreightByQualifiedStagesIndexField + "=fake-stage-1",
+						kubeclient.FreightByQualifiedStagesIndexField + "=fake-stage-2":
+						freight.Items = []kargoapi.Freight{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight",
+								},
+							},
+						}
+					}
+					return nil
+				},
+			},
+			stageSubs: []kargoapi.StageSubscription{
+				{Name: "fake-stage-1"},
+				{Name: "fake-stage-2"},
+				{Name: "fake-stage-3"},
+			},
+			rule: &kargoapi.QualificationRule{
+				Type:   kargoapi.QualificationRuleTypeQuorum,
+				Quorum: 2,
+			},
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 1)
+				require.Equal(t, "fake-freight", freight[0].Name)
+			},
+		},
+		{
+			name: "no Freight satisfies qualification rule",
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					opts ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					listOpts, ok := opts[0].(*client.ListOptions)
+					require.True(t, ok)
+					// Only the first upstream Stage has the Freight qualified
+					if listOpts.FieldSelector.String() ==
+						kubeclient.FreightByQualifiedStagesIndexField+"=fake-stage-1" {
+						freight.Items = []kargoapi.Freight{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight",
+								},
+							},
+						}
+					}
+					return nil
+				},
+			},
+			stageSubs: []kargoapi.StageSubscription{
+				{Name: "fake-stage-1"},
+				{Name: "fake-stage-2"},
+			},
+			rule: &kargoapi.QualificationRule{
+				Type: kargoapi.QualificationRuleTypeAll,
+			},
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			stageSubs := testCase.stageSubs
+			if stageSubs == nil {
+				stageSubs = []kargoapi.StageSubscription{
+					{
+						Name: "fake-stage",
+					},
+				}
+			}
 			testCase.assertions(
 				testCase.reconciler.getAllFreightQualifiedForUpstreamStages(
 					context.Background(),
 					"fake-namespace",
-					[]kargoapi.StageSubscription{
-						{
-							Name: "fake-stage",
-						},
-					},
+					stageSubs,
+					testCase.rule,
 				),
 			)
 		})
@@ -1576,6 +2587,7 @@ func TestGetLatestFreightQualifiedForUpstreamStages(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					*kargoapi.QualificationRule,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -1592,6 +2604,7 @@ func TestGetLatestFreightQualifiedForUpstreamStages(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					*kargoapi.QualificationRule,
 				) ([]kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1608,6 +2621,7 @@ func TestGetLatestFreightQualifiedForUpstreamStages(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					*kargoapi.QualificationRule,
 				) ([]kargoapi.Freight, error) {
 					return []kargoapi.Freight{
 						{
@@ -1644,8 +2658,64 @@ func TestGetLatestFreightQualifiedForUpstreamStages(t *testing.T) {
 					context.Background(),
 					"fake-namespace",
 					[]kargoapi.StageSubscription{},
+					nil,
 				),
 			)
 		})
 	}
 }
+
+func TestRecordHealthChange(t *testing.T) {
+	testCases := []struct {
+		name          string
+		oldHealth     *kargoapi.Health
+		newHealth     *kargoapi.Health
+		expectedEvent string
+	}{
+		{
+			name:      "health not applicable",
+			oldHealth: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+		},
+		{
+			name:      "health unchanged",
+			oldHealth: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+			newHealth: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+		},
+		{
+			name:          "initial health",
+			newHealth:     &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+			expectedEvent: "Normal HealthChanged Stage health changed to Healthy",
+		},
+		{
+			name:      "health changed",
+			oldHealth: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+			newHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Issues: []string{"issue 1", "issue 2"},
+			},
+			expectedEvent: "Warning HealthChanged Stage health changed to " +
+				"Unhealthy from Healthy: issue 1; issue 2",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			r := &reconciler{recorder: recorder}
+			r.recordHealthChange(
+				&kargoapi.Stage{
+					Status: kargoapi.StageStatus{Health: testCase.oldHealth},
+				},
+				kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+					Health:         testCase.newHealth,
+				},
+			)
+			if testCase.expectedEvent == "" {
+				require.Empty(t, recorder.Events)
+				return
+			}
+			require.Len(t, recorder.Events, 1)
+			require.Equal(t, testCase.expectedEvent, <-recorder.Events)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package warehouses
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// setDiscoveringCondition updates the Discovering condition within the
+// provided WarehouseStatus. An empty reason indicates that discovery is in
+// progress. Any other reason describes the outcome of the most recent
+// discovery attempt.
+func setDiscoveringCondition(
+	status *kargoapi.WarehouseStatus,
+	generation int64,
+	reason string,
+	message string,
+) {
+	condition := metav1.Condition{
+		Type:               kargoapi.WarehouseConditionTypeDiscovering,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+	if reason == "" {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Polling"
+		condition.Message = "Discovering new Freight from subscribed repositories"
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setReconcilingCondition updates the Reconciling condition within the
+// provided WarehouseStatus to reflect whether discovery is in progress.
+func setReconcilingCondition(
+	status *kargoapi.WarehouseStatus,
+	generation int64,
+	inProgress bool,
+) {
+	condition := metav1.Condition{
+		Type:               kargoapi.ConditionTypeReconciling,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Idle",
+		Message:            "Warehouse is not discovering new Freight",
+		ObservedGeneration: generation,
+	}
+	if inProgress {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Discovering"
+		condition.Message = "Warehouse is discovering new Freight"
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setConditions updates the Ready, Reconciling, and Stalled conditions within
+// the provided WarehouseStatus to reflect the outcome of a reconciliation,
+// which failed if the provided error is non-nil.
+func setConditions(
+	status *kargoapi.WarehouseStatus,
+	generation int64,
+	err error,
+) {
+	setReconcilingCondition(status, generation, false)
+	stalled := metav1.Condition{
+		Type:               kargoapi.ConditionTypeStalled,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Synced",
+		Message:            "Warehouse was synced successfully",
+		ObservedGeneration: generation,
+	}
+	ready := metav1.Condition{
+		Type:               kargoapi.ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Ready",
+		Message:            "Warehouse is ready",
+		ObservedGeneration: generation,
+	}
+	if err != nil {
+		stalled.Status = metav1.ConditionTrue
+		stalled.Reason = "SyncFailed"
+		stalled.Message = err.Error()
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = stalled.Reason
+		ready.Message = stalled.Message
+	}
+	meta.SetStatusCondition(&status.Conditions, stalled)
+	meta.SetStatusCondition(&status.Conditions, ready)
+}
@@ -0,0 +1,85 @@
+package warehouses
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSetDiscoveringCondition(t *testing.T) {
+	status := kargoapi.WarehouseStatus{}
+	setDiscoveringCondition(&status, 42, "", "")
+	condition := meta.FindStatusCondition(
+		status.Conditions,
+		kargoapi.WarehouseConditionTypeDiscovering,
+	)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.Equal(t, "Polling", condition.Reason)
+	require.Equal(t, int64(42), condition.ObservedGeneration)
+
+	setDiscoveringCondition(&status, 42, "FreightCreated", "fake-message")
+	condition = meta.FindStatusCondition(
+		status.Conditions,
+		kargoapi.WarehouseConditionTypeDiscovering,
+	)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, "FreightCreated", condition.Reason)
+	require.Equal(t, "fake-message", condition.Message)
+}
+
+func TestSetConditions(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		expectedReady   metav1.ConditionStatus
+		expectedStalled metav1.ConditionStatus
+	}{
+		{
+			name:            "error",
+			err:             errors.New("something went wrong"),
+			expectedReady:   metav1.ConditionFalse,
+			expectedStalled: metav1.ConditionTrue,
+		},
+		{
+			name:            "success",
+			expectedReady:   metav1.ConditionTrue,
+			expectedStalled: metav1.ConditionFalse,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := kargoapi.WarehouseStatus{}
+			setReconcilingCondition(&status, 42, true)
+			setConditions(&status, 42, testCase.err)
+			require.True(
+				t,
+				meta.IsStatusConditionFalse(
+					status.Conditions,
+					kargoapi.ConditionTypeReconciling,
+				),
+			)
+			ready := meta.FindStatusCondition(
+				status.Conditions,
+				kargoapi.ConditionTypeReady,
+			)
+			require.NotNil(t, ready)
+			require.Equal(t, testCase.expectedReady, ready.Status)
+			stalled := meta.FindStatusCondition(
+				status.Conditions,
+				kargoapi.ConditionTypeStalled,
+			)
+			require.NotNil(t, stalled)
+			require.Equal(t, testCase.expectedStalled, stalled.Status)
+			if testCase.err != nil {
+				require.Equal(t, "something went wrong", stalled.Message)
+			}
+		})
+	}
+}
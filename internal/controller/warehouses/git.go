@@ -84,7 +84,7 @@ func getLatestCommitMeta(
 	if creds == nil {
 		creds = &git.RepoCredentials{}
 	}
-	repo, err := git.Clone(repoURL, *creds)
+	repo, err := git.Clone(ctx, repoURL, *creds, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error cloning git repo %q", repoURL)
 
@@ -2,11 +2,14 @@ package warehouses
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -27,6 +30,7 @@ import (
 type reconciler struct {
 	client                     client.Client
 	credentialsDB              credentials.Database
+	recorder                   record.EventRecorder
 	imageSourceURLFnsByBaseURL map[string]func(string, string) string
 
 	// The following behaviors are overridable for testing purposes:
@@ -110,7 +114,13 @@ func SetupReconcilerWithManager(
 				),
 			).
 			WithOptions(controller.CommonOptions()).
-			Complete(newReconciler(mgr.GetClient(), credentialsDB)),
+			Complete(
+				newReconciler(
+					mgr.GetClient(),
+					credentialsDB,
+					mgr.GetEventRecorderFor(controller.EventSource),
+				),
+			),
 		"error building Warehouse reconciler",
 	)
 }
@@ -118,10 +128,12 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kubeClient client.Client,
 	credentialsDB credentials.Database,
+	recorder record.EventRecorder,
 ) *reconciler {
 	r := &reconciler{
 		client:        kubeClient,
 		credentialsDB: credentialsDB,
+		recorder:      recorder,
 		imageSourceURLFnsByBaseURL: map[string]func(string, string) string{
 			githubURLPrefix: getGithubImageSourceURL,
 		},
@@ -171,7 +183,22 @@ func (r *reconciler) Reconcile(
 		return result, nil
 	}
 
+	// Give visibility into discovery being in progress, which, depending on
+	// the number of subscriptions, may take some time
+	if err = kubeclient.PatchStatus(
+		ctx,
+		r.client,
+		warehouse,
+		func(status *kargoapi.WarehouseStatus) {
+			setDiscoveringCondition(status, warehouse.Generation, "", "")
+			setReconcilingCondition(status, warehouse.Generation, true)
+		},
+	); err != nil {
+		logger.Errorf("error updating Warehouse status: %s", err)
+	}
+
 	newStatus, err := r.syncWarehouse(ctx, warehouse)
+	setConditions(&newStatus, warehouse.Generation, err)
 	if err != nil {
 		newStatus.Error = err.Error()
 		logger.Errorf("error syncing Warehouse: %s", err)
@@ -214,11 +241,23 @@ func (r *reconciler) syncWarehouse(
 
 	freight, err := r.getLatestFreightFromReposFn(ctx, warehouse)
 	if err != nil {
-		return status,
-			errors.Wrap(err, "error getting latest Freight from repositories")
+		err = errors.Wrap(err, "error getting latest Freight from repositories")
+		setDiscoveringCondition(
+			&status,
+			warehouse.Generation,
+			"DiscoveryFailed",
+			err.Error(),
+		)
+		return status, err
 	}
 	if freight == nil {
 		logger.Debug("found no Freight from repositories")
+		setDiscoveringCondition(
+			&status,
+			warehouse.Generation,
+			"NoFreight",
+			"Found no Freight from subscribed repositories",
+		)
 		return status, nil
 	}
 	logger.Debug("got latest Freight from repositories")
@@ -230,20 +269,50 @@ func (r *reconciler) syncWarehouse(
 				freight.Name,
 				freight.Namespace,
 			)
+			setDiscoveringCondition(
+				&status,
+				warehouse.Generation,
+				"NoNewFreight",
+				fmt.Sprintf("Latest Freight %s already exists", freight.Name),
+			)
 			return status, nil
 		}
-		return status, errors.Wrapf(
+		err = errors.Wrapf(
 			err,
 			"error creating Freight %q in namespace %q",
 			freight.Name,
 			freight.Namespace,
 		)
+		setDiscoveringCondition(
+			&status,
+			warehouse.Generation,
+			"DiscoveryFailed",
+			err.Error(),
+		)
+		return status, err
 	}
 	log.Debugf(
 		"created Freight %q in namespace %q",
 		freight.Name,
 		freight.Namespace,
 	)
+	r.recorder.AnnotatedEventf(
+		warehouse,
+		map[string]string{
+			kargoapi.AnnotationKeyEventWarehouse: warehouse.Name,
+			kargoapi.AnnotationKeyEventFreight:   freight.Name,
+		},
+		corev1.EventTypeNormal,
+		kargoapi.EventReasonFreightCreated,
+		"Created Freight %s",
+		freight.Name,
+	)
+	setDiscoveringCondition(
+		&status,
+		warehouse.Generation,
+		"FreightCreated",
+		fmt.Sprintf("Created Freight %s", freight.Name),
+	)
 
 	return status, nil
 }
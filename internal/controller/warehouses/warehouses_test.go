@@ -7,9 +7,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -22,9 +24,11 @@ func TestNewReconciler(t *testing.T) {
 	e := newReconciler(
 		kubeClient,
 		&credentials.FakeDB{},
+		&record.FakeRecorder{},
 	)
 	require.NotNil(t, e.client)
 	require.NotNil(t, e.credentialsDB)
+	require.NotNil(t, e.recorder)
 	require.NotEmpty(t, e.imageSourceURLFnsByBaseURL)
 
 	// Assert that all overridable behaviors were initialized to a default:
@@ -44,10 +48,11 @@ func TestSyncWarehouse(t *testing.T) {
 	testWarehouse := &kargoapi.Warehouse{
 		Spec: &kargoapi.WarehouseSpec{},
 	}
+	recorder := record.NewFakeRecorder(1)
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(error)
+		assertions func(kargoapi.WarehouseStatus, error)
 	}{
 		{
 			name: "error getting latest Freight from repos",
@@ -59,8 +64,15 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.Error(t, err)
+				discovering := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.WarehouseConditionTypeDiscovering,
+				)
+				require.NotNil(t, discovering)
+				require.Equal(t, metav1.ConditionFalse, discovering.Status)
+				require.Equal(t, "DiscoveryFailed", discovering.Reason)
 				require.Contains(t, err.Error(), "something went wrong")
 				require.Contains(
 					t,
@@ -80,8 +92,15 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil, nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
+				discovering := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.WarehouseConditionTypeDiscovering,
+				)
+				require.NotNil(t, discovering)
+				require.Equal(t, metav1.ConditionFalse, discovering.Status)
+				require.Equal(t, "NoFreight", discovering.Reason)
 			},
 		},
 
@@ -108,8 +127,15 @@ func TestSyncWarehouse(t *testing.T) {
 					)
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
+				discovering := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.WarehouseConditionTypeDiscovering,
+				)
+				require.NotNil(t, discovering)
+				require.Equal(t, metav1.ConditionFalse, discovering.Status)
+				require.Equal(t, "NoNewFreight", discovering.Reason)
 			},
 		},
 
@@ -130,8 +156,15 @@ func TestSyncWarehouse(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.Error(t, err)
+				discovering := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.WarehouseConditionTypeDiscovering,
+				)
+				require.NotNil(t, discovering)
+				require.Equal(t, metav1.ConditionFalse, discovering.Status)
+				require.Equal(t, "DiscoveryFailed", discovering.Reason)
 				require.Contains(t, err.Error(), "something went wrong")
 				require.Contains(t, err.Error(), "error creating Freight")
 			},
@@ -140,6 +173,7 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "success creating Freight",
 			reconciler: &reconciler{
+				recorder: recorder,
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
@@ -159,16 +193,29 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
+				discovering := meta.FindStatusCondition(
+					status.Conditions,
+					kargoapi.WarehouseConditionTypeDiscovering,
+				)
+				require.NotNil(t, discovering)
+				require.Equal(t, metav1.ConditionFalse, discovering.Status)
+				require.Equal(t, "FreightCreated", discovering.Reason)
+				require.Len(t, recorder.Events, 1)
+				require.Equal(
+					t,
+					"Normal FreightCreated Created Freight fake-freight",
+					<-recorder.Events,
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			_, err :=
+			status, err :=
 				testCase.reconciler.syncWarehouse(context.Background(), testWarehouse)
-			testCase.assertions(err)
+			testCase.assertions(status, err)
 		})
 	}
 }
@@ -40,6 +40,12 @@ type Credentials struct {
 	// SSHPrivateKey is a private key that can be used for access to some remote
 	// repository. This is primarily applicable for Git repositories.
 	SSHPrivateKey string
+	// SigningKey is a private key that can be used to sign commits made to some
+	// repository. This is only applicable for Git repositories.
+	SigningKey string
+	// SigningKeyType indicates the type of SigningKey. Supported values are
+	// "gpg" (the default) and "ssh".
+	SigningKeyType string
 }
 
 // Database is an interface for a Credentials store.
@@ -234,8 +240,10 @@ func getCredentialsSecret(
 
 func secretToCreds(secret *corev1.Secret) Credentials {
 	return Credentials{
-		Username:      string(secret.Data["username"]),
-		Password:      string(secret.Data["password"]),
-		SSHPrivateKey: string(secret.Data["sshPrivateKey"]),
+		Username:       string(secret.Data["username"]),
+		Password:       string(secret.Data["password"]),
+		SSHPrivateKey:  string(secret.Data["sshPrivateKey"]),
+		SigningKey:     string(secret.Data["signingKey"]),
+		SigningKeyType: string(secret.Data["signingKeyType"]),
 	}
 }
@@ -138,13 +138,17 @@ func TestGetCredentialsSecret(t *testing.T) {
 func TestSecretToCreds(t *testing.T) {
 	secret := &corev1.Secret{
 		Data: map[string][]byte{
-			"username":      []byte("fake-username"),
-			"password":      []byte("fake-password"),
-			"sshPrivateKey": []byte("fake-ssh-private-key"),
+			"username":       []byte("fake-username"),
+			"password":       []byte("fake-password"),
+			"sshPrivateKey":  []byte("fake-ssh-private-key"),
+			"signingKey":     []byte("fake-signing-key"),
+			"signingKeyType": []byte("ssh"),
 		},
 	}
 	creds := secretToCreds(secret)
 	require.Equal(t, string(secret.Data["username"]), creds.Username)
 	require.Equal(t, string(secret.Data["password"]), creds.Password)
 	require.Equal(t, string(secret.Data["sshPrivateKey"]), creds.SSHPrivateKey)
+	require.Equal(t, string(secret.Data["signingKey"]), creds.SigningKey)
+	require.Equal(t, string(secret.Data["signingKeyType"]), creds.SigningKeyType)
 }
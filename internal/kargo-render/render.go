@@ -52,6 +52,10 @@ type Request struct {
 	// Images specifies images to incorporate into environment-specific
 	// manifests.
 	Images []string `json:"images,omitempty"`
+	// LocalOutPath, if specified, is a path to a directory that does not yet
+	// exist, into which rendered manifests should be written instead of being
+	// committed to the TargetBranch.
+	LocalOutPath string `json:"localOutPath,omitempty"`
 }
 
 // Response encapsulates details of a successful rendering of some
@@ -99,5 +103,8 @@ func buildRenderCmd(req Request) *exec.Cmd {
 	for _, image := range req.Images {
 		cmdTokens = append(cmdTokens, "--image", image)
 	}
+	if req.LocalOutPath != "" {
+		cmdTokens = append(cmdTokens, "--local-out", req.LocalOutPath)
+	}
 	return exec.Command(cmdTokens[0], cmdTokens[1:]...) // nolint: gosec
 }
@@ -0,0 +1,248 @@
+package kargo
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// IsDerivedFreight returns a bool indicating whether the provided Freight was
+// derived from a partial promotion rather than produced by a Warehouse.
+func IsDerivedFreight(freight kargoapi.Freight) bool {
+	return freight.Labels[kargoapi.LabelKeyDerivedFreight] == kargoapi.LabelTrueValue
+}
+
+// ComposeFreight returns the Freight into which a Stage transitions when only
+// those artifacts of the provided target Freight that are selected by the
+// provided selector are promoted into it. Artifacts that are not selected
+// retain the versions found in the provided current Freight of the Stage,
+// which may be nil if the Stage has none. The ID of the returned Freight is
+// derived from its contents and is equal to the ID of the target Freight if
+// every artifact is selected. An error is returned if the selector references
+// artifacts that the target Freight does not, if it selects none of the target
+// Freight's artifacts, or if an artifact that is not selected cannot be found
+// in the current Freight.
+func ComposeFreight(
+	target kargoapi.SimpleFreight,
+	current *kargoapi.SimpleFreight,
+	selector kargoapi.ArtifactSelector,
+) (kargoapi.SimpleFreight, error) {
+	if err := validateArtifactReferences(target, selector.Include); err != nil {
+		return kargoapi.SimpleFreight{}, err
+	}
+	if err := validateArtifactReferences(target, selector.Exclude); err != nil {
+		return kargoapi.SimpleFreight{}, err
+	}
+	if current == nil {
+		current = &kargoapi.SimpleFreight{}
+	}
+
+	composed := kargoapi.SimpleFreight{}
+	var selected int
+
+	for _, commit := range target.Commits {
+		if selectsArtifact(selector, commitRefs, commitMatches(commit)) {
+			composed.Commits = append(composed.Commits, commit)
+			selected++
+			continue
+		}
+		var found bool
+		for _, currentCommit := range current.Commits {
+			if currentCommit.RepoURL == commit.RepoURL {
+				composed.Commits = append(composed.Commits, currentCommit)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return kargoapi.SimpleFreight{}, errors.Errorf(
+				"commit from Git repository %q is not selected for promotion, but "+
+					"the Stage's current Freight references no commit from that "+
+					"repository",
+				commit.RepoURL,
+			)
+		}
+	}
+
+	for _, image := range target.Images {
+		if selectsArtifact(selector, imageRefs, imageMatches(image)) {
+			composed.Images = append(composed.Images, image)
+			selected++
+			continue
+		}
+		var found bool
+		for _, currentImage := range current.Images {
+			if currentImage.RepoURL == image.RepoURL {
+				composed.Images = append(composed.Images, currentImage)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return kargoapi.SimpleFreight{}, errors.Errorf(
+				"image %q is not selected for promotion, but the Stage's current "+
+					"Freight references no version of that image",
+				image.RepoURL,
+			)
+		}
+	}
+
+	for _, chart := range target.Charts {
+		if selectsArtifact(selector, chartRefs, chartMatches(chart)) {
+			composed.Charts = append(composed.Charts, chart)
+			selected++
+			continue
+		}
+		var found bool
+		for _, currentChart := range current.Charts {
+			if currentChart.RegistryURL == chart.RegistryURL &&
+				currentChart.Name == chart.Name {
+				composed.Charts = append(composed.Charts, currentChart)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return kargoapi.SimpleFreight{}, errors.Errorf(
+				"chart %q is not selected for promotion, but the Stage's current "+
+					"Freight references no version of that chart",
+				chartName(chart),
+			)
+		}
+	}
+
+	if selected == 0 {
+		return kargoapi.SimpleFreight{}, errors.Errorf(
+			"artifact selector selects none of the artifacts of Freight %q",
+			target.ID,
+		)
+	}
+
+	composed.UpdateID()
+	return composed, nil
+}
+
+// validateArtifactReferences returns an error if the provided references,
+// which may be nil, identify any artifact not referenced by the provided
+// Freight.
+func validateArtifactReferences(
+	freight kargoapi.SimpleFreight,
+	refs *kargoapi.ArtifactReferences,
+) error {
+	if refs == nil {
+		return nil
+	}
+	for _, ref := range refs.Commits {
+		var found bool
+		for _, commit := range freight.Commits {
+			if found = commitMatches(commit)(ref); found {
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf(
+				"Freight %q references no commit from Git repository %q",
+				freight.ID,
+				ref,
+			)
+		}
+	}
+	for _, ref := range refs.Images {
+		var found bool
+		for _, image := range freight.Images {
+			if found = imageMatches(image)(ref); found {
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf(
+				"Freight %q references no version of image %q",
+				freight.ID,
+				ref,
+			)
+		}
+	}
+	for _, ref := range refs.Charts {
+		var found bool
+		for _, chart := range freight.Charts {
+			if found = chartMatches(chart)(ref); found {
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf(
+				"Freight %q references no version of chart %q",
+				freight.ID,
+				ref,
+			)
+		}
+	}
+	return nil
+}
+
+// selectsArtifact returns true if the provided selector selects an artifact
+// that is matched by the provided match function. Only the references
+// returned by the provided refsFn, which selects references to artifacts of
+// the same kind, are considered.
+func selectsArtifact(
+	selector kargoapi.ArtifactSelector,
+	refsFn func(kargoapi.ArtifactReferences) []string,
+	matches func(string) bool,
+) bool {
+	if selector.Include != nil &&
+		!anyReferenceMatches(refsFn(*selector.Include), matches) {
+		return false
+	}
+	return selector.Exclude == nil ||
+		!anyReferenceMatches(refsFn(*selector.Exclude), matches)
+}
+
+func anyReferenceMatches(refs []string, matches func(string) bool) bool {
+	for _, ref := range refs {
+		if matches(ref) {
+			return true
+		}
+	}
+	return false
+}
+
+func commitRefs(refs kargoapi.ArtifactReferences) []string {
+	return refs.Commits
+}
+
+func imageRefs(refs kargoapi.ArtifactReferences) []string {
+	return refs.Images
+}
+
+func chartRefs(refs kargoapi.ArtifactReferences) []string {
+	return refs.Charts
+}
+
+func commitMatches(commit kargoapi.GitCommit) func(string) bool {
+	return func(ref string) bool {
+		return ref == commit.RepoURL
+	}
+}
+
+func imageMatches(image kargoapi.Image) func(string) bool {
+	return func(ref string) bool {
+		return ref == image.RepoURL
+	}
+}
+
+func chartMatches(chart kargoapi.Chart) func(string) bool {
+	return func(ref string) bool {
+		return ref == chart.Name || ref == chartName(chart)
+	}
+}
+
+// chartName returns the fully qualified name of the provided chart, composed
+// of its registry URL and name.
+func chartName(chart kargoapi.Chart) string {
+	if chart.Name == "" {
+		return chart.RegistryURL
+	}
+	return strings.TrimSuffix(chart.RegistryURL, "/") + "/" + chart.Name
+}
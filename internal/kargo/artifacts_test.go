@@ -0,0 +1,160 @@
+package kargo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestComposeFreight(t *testing.T) {
+	newFreight := func(
+		commitID string,
+		imageTag string,
+		chartVersion string,
+	) kargoapi.SimpleFreight {
+		freight := kargoapi.SimpleFreight{
+			Commits: []kargoapi.GitCommit{{
+				RepoURL: "https://github.com/example/repo.git",
+				ID:      commitID,
+			}},
+			Images: []kargoapi.Image{{
+				RepoURL: "example/image",
+				Tag:     imageTag,
+			}},
+			Charts: []kargoapi.Chart{{
+				RegistryURL: "https://charts.example.com",
+				Name:        "example-chart",
+				Version:     chartVersion,
+			}},
+		}
+		freight.UpdateID()
+		return freight
+	}
+	current := newFreight("old-commit", "v1.0.0", "1.0.0")
+	target := newFreight("new-commit", "v2.0.0", "2.0.0")
+	testCases := []struct {
+		name       string
+		current    *kargoapi.SimpleFreight
+		selector   kargoapi.ArtifactSelector
+		assertions func(kargoapi.SimpleFreight, error)
+	}{
+		{
+			name: "include references unknown artifact",
+			selector: kargoapi.ArtifactSelector{
+				Include: &kargoapi.ArtifactReferences{
+					Images: []string{"example/other-image"},
+				},
+			},
+			assertions: func(_ kargoapi.SimpleFreight, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					`references no version of image "example/other-image"`,
+				)
+			},
+		},
+		{
+			name: "exclude references unknown artifact",
+			selector: kargoapi.ArtifactSelector{
+				Exclude: &kargoapi.ArtifactReferences{
+					Commits: []string{"https://github.com/example/other.git"},
+				},
+			},
+			assertions: func(_ kargoapi.SimpleFreight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "references no commit")
+			},
+		},
+		{
+			name:    "nothing selected",
+			current: &current,
+			selector: kargoapi.ArtifactSelector{
+				Exclude: &kargoapi.ArtifactReferences{
+					Commits: []string{"https://github.com/example/repo.git"},
+					Images:  []string{"example/image"},
+					Charts:  []string{"example-chart"},
+				},
+			},
+			assertions: func(_ kargoapi.SimpleFreight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "selects none of the artifacts")
+			},
+		},
+		{
+			name: "Stage has no current Freight",
+			selector: kargoapi.ArtifactSelector{
+				Include: &kargoapi.ArtifactReferences{
+					Images: []string{"example/image"},
+				},
+			},
+			assertions: func(_ kargoapi.SimpleFreight, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"current Freight references no commit",
+				)
+			},
+		},
+		{
+			name:    "include only an image",
+			current: &current,
+			selector: kargoapi.ArtifactSelector{
+				Include: &kargoapi.ArtifactReferences{
+					Images: []string{"example/image"},
+				},
+			},
+			assertions: func(freight kargoapi.SimpleFreight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, current.Commits, freight.Commits)
+				require.Equal(t, target.Images, freight.Images)
+				require.Equal(t, current.Charts, freight.Charts)
+				require.NotEqual(t, target.ID, freight.ID)
+				require.NotEqual(t, current.ID, freight.ID)
+				expected := freight
+				expected.UpdateID()
+				require.Equal(t, expected.ID, freight.ID)
+			},
+		},
+		{
+			name:    "exclude a chart by registry URL and name",
+			current: &current,
+			selector: kargoapi.ArtifactSelector{
+				Exclude: &kargoapi.ArtifactReferences{
+					Charts: []string{"https://charts.example.com/example-chart"},
+				},
+			},
+			assertions: func(freight kargoapi.SimpleFreight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, target.Commits, freight.Commits)
+				require.Equal(t, target.Images, freight.Images)
+				require.Equal(t, current.Charts, freight.Charts)
+			},
+		},
+		{
+			name:    "everything selected",
+			current: &current,
+			selector: kargoapi.ArtifactSelector{
+				Include: &kargoapi.ArtifactReferences{
+					Commits: []string{"https://github.com/example/repo.git"},
+					Images:  []string{"example/image"},
+					Charts:  []string{"example-chart"},
+				},
+			},
+			assertions: func(freight kargoapi.SimpleFreight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, target, freight)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				ComposeFreight(target, testCase.current, testCase.selector),
+			)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package kargo
+
+import (
+	"fmt"
+	"sort"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// Reasons describing whether an individual piece of Freight is eligible for
+// auto-promotion into a Stage, in addition to those of the
+// kargoapi.AutoPromotionReason* constants.
+const (
+	// FreightEligibilityReasonEligible indicates that the Freight is the latest
+	// available to the Stage and that nothing prevents it from being
+	// auto-promoted.
+	FreightEligibilityReasonEligible = "Eligible"
+	// FreightEligibilityReasonNotQualifiedUpstream indicates that the Freight
+	// has not qualified in enough of the Stage's upstream Stages to satisfy the
+	// Stage's qualification rule.
+	FreightEligibilityReasonNotQualifiedUpstream = "NotQualifiedUpstream"
+	// FreightEligibilityReasonNotLatest indicates that newer Freight is
+	// available to the Stage. Only the latest available Freight is ever
+	// auto-promoted.
+	FreightEligibilityReasonNotLatest = "NotLatest"
+)
+
+// FreightEligibility describes whether a piece of Freight is eligible for
+// auto-promotion into a Stage and why.
+type FreightEligibility struct {
+	Freight  kargoapi.Freight
+	Eligible bool
+	Reason   string
+	Message  string
+}
+
+// CheckAutoPromotionSubscriptions returns a decision explaining why a Stage
+// with the provided Subscriptions is not eligible for auto-promotion. It
+// returns nil if the Stage is eligible.
+func CheckAutoPromotionSubscriptions(
+	subs *kargoapi.Subscriptions,
+) *kargoapi.AutoPromotionDecision {
+	switch {
+	case subs == nil ||
+		(subs.Warehouse == "" && len(subs.UpstreamStages) == 0):
+		return &kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonNoSubscriptions,
+			Message: "Stage subscribes to neither a Warehouse nor any upstream Stages",
+		}
+	case subs.Warehouse != "" && len(subs.UpstreamStages) > 0:
+		return &kargoapi.AutoPromotionDecision{
+			Reason: kargoapi.AutoPromotionReasonAmbiguousSubscriptions,
+			Message: "Stage subscribes to both a Warehouse and upstream Stages; " +
+				"the Freight available to it is ambiguous",
+		}
+	case len(subs.UpstreamStages) > 1 && subs.QualificationRule == nil:
+		return &kargoapi.AutoPromotionDecision{
+			Reason: kargoapi.AutoPromotionReasonAmbiguousSubscriptions,
+			Message: "Stage subscribes to multiple upstream Stages without " +
+				"specifying a qualification rule; the Freight available to it " +
+				"is ambiguous",
+		}
+	}
+	return nil
+}
+
+// CheckPromotionPolicies returns a decision explaining why the provided
+// PromotionPolicies, which should be all of those associated with a single
+// Stage, do not permit auto-promotion of that Stage. It returns nil if they
+// do.
+func CheckPromotionPolicies(
+	policies []kargoapi.PromotionPolicy,
+) *kargoapi.AutoPromotionDecision {
+	switch {
+	case len(policies) == 0:
+		return &kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonNoPromotionPolicy,
+			Message: "No PromotionPolicy is associated with the Stage",
+		}
+	case len(policies) > 1:
+		return &kargoapi.AutoPromotionDecision{
+			Reason:  kargoapi.AutoPromotionReasonMultiplePromotionPolicies,
+			Message: "Multiple PromotionPolicies are associated with the Stage",
+		}
+	case !policies[0].EnableAutoPromotion:
+		return &kargoapi.AutoPromotionDecision{
+			Reason: kargoapi.AutoPromotionReasonDisabled,
+			Message: fmt.Sprintf(
+				"PromotionPolicy %q does not enable auto-promotion of the Stage",
+				policies[0].Name,
+			),
+		}
+	}
+	return nil
+}
+
+// ExplainFreightEligibility explains, for each of the provided candidate
+// Freight, whether it is eligible for auto-promotion into the provided Stage
+// and why. Candidates should include all Freight from the Stage's Warehouse
+// or, for a Stage that subscribes to upstream Stages, all Freight qualified in
+// any of them. If the provided blocker is non-nil, it describes why the Stage
+// cannot be auto-promoted regardless of what Freight is available and
+// applies to the latest available Freight. Results are ordered from newest
+// Freight to oldest.
+func ExplainFreightEligibility(
+	stage *kargoapi.Stage,
+	candidates []kargoapi.Freight,
+	blocker *kargoapi.AutoPromotionDecision,
+) []FreightEligibility {
+	sorted := make([]kargoapi.Freight, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[j].CreationTimestamp.Before(&sorted[i].CreationTimestamp)
+	})
+
+	var upstreamStages []kargoapi.StageSubscription
+	var rule *kargoapi.QualificationRule
+	if stage.Spec != nil && stage.Spec.Subscriptions != nil {
+		upstreamStages = stage.Spec.Subscriptions.UpstreamStages
+		rule = stage.Spec.Subscriptions.QualificationRule
+	}
+	minQualifications := rule.MinQualifications(len(upstreamStages))
+	var currentFreight string
+	if stage.Status.CurrentFreight != nil {
+		currentFreight = stage.Status.CurrentFreight.ID
+	}
+
+	var latest string
+	results := make([]FreightEligibility, len(sorted))
+	for i, freight := range sorted {
+		result := FreightEligibility{Freight: freight}
+		var qualifications int
+		for _, upstreamStage := range upstreamStages {
+			if _, ok := freight.Status.Qualifications[upstreamStage.Name]; ok {
+				qualifications++
+			}
+		}
+		failure, failed := freight.Status.Failures[stage.Name]
+		switch {
+		case qualifications < minQualifications:
+			result.Reason = FreightEligibilityReasonNotQualifiedUpstream
+			result.Message = fmt.Sprintf(
+				"Freight has qualified in %d of %d upstream Stages; %d required",
+				qualifications,
+				len(upstreamStages),
+				minQualifications,
+			)
+			results[i] = result
+			continue
+		case freight.Name == currentFreight:
+			result.Reason = kargoapi.AutoPromotionReasonAlreadyCurrent
+			result.Message = "Freight is the Stage's current Freight"
+		case failed:
+			result.Reason = kargoapi.AutoPromotionReasonFreightPreviouslyFailed
+			result.Message = fmt.Sprintf(
+				"Freight previously failed in the Stage: %s",
+				failure.Reason,
+			)
+		case latest != "":
+			result.Reason = FreightEligibilityReasonNotLatest
+			result.Message = fmt.Sprintf("Newer Freight %s is available", latest)
+		case blocker != nil:
+			result.Reason = blocker.Reason
+			result.Message = blocker.Message
+		default:
+			result.Eligible = true
+			result.Reason = FreightEligibilityReasonEligible
+			result.Message = "Freight is the latest available to the Stage"
+		}
+		if latest == "" {
+			latest = freight.Name
+		}
+		results[i] = result
+	}
+	return results
+}
@@ -0,0 +1,274 @@
+package kargo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCheckAutoPromotionSubscriptions(t *testing.T) {
+	testCases := []struct {
+		name           string
+		subs           *kargoapi.Subscriptions
+		expectedReason string
+	}{
+		{
+			name:           "no subscriptions",
+			expectedReason: kargoapi.AutoPromotionReasonNoSubscriptions,
+		},
+		{
+			name: "Warehouse and upstream Stages",
+			subs: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+				UpstreamStages: []kargoapi.StageSubscription{
+					{Name: "fake-stage"},
+				},
+			},
+			expectedReason: kargoapi.AutoPromotionReasonAmbiguousSubscriptions,
+		},
+		{
+			name: "multiple upstream Stages without qualification rule",
+			subs: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{Name: "fake-stage"},
+					{Name: "another-fake-stage"},
+				},
+			},
+			expectedReason: kargoapi.AutoPromotionReasonAmbiguousSubscriptions,
+		},
+		{
+			name: "multiple upstream Stages with qualification rule",
+			subs: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{Name: "fake-stage"},
+					{Name: "another-fake-stage"},
+				},
+				QualificationRule: &kargoapi.QualificationRule{
+					Type: kargoapi.QualificationRuleTypeAll,
+				},
+			},
+		},
+		{
+			name: "Warehouse",
+			subs: &kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			decision := CheckAutoPromotionSubscriptions(testCase.subs)
+			if testCase.expectedReason == "" {
+				require.Nil(t, decision)
+				return
+			}
+			require.NotNil(t, decision)
+			require.Equal(t, testCase.expectedReason, decision.Reason)
+			require.NotEmpty(t, decision.Message)
+		})
+	}
+}
+
+func TestCheckPromotionPolicies(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policies       []kargoapi.PromotionPolicy
+		expectedReason string
+	}{
+		{
+			name:           "no policies",
+			expectedReason: kargoapi.AutoPromotionReasonNoPromotionPolicy,
+		},
+		{
+			name: "multiple policies",
+			policies: []kargoapi.PromotionPolicy{
+				{EnableAutoPromotion: true},
+				{EnableAutoPromotion: true},
+			},
+			expectedReason: kargoapi.AutoPromotionReasonMultiplePromotionPolicies,
+		},
+		{
+			name:           "auto-promotion disabled",
+			policies:       []kargoapi.PromotionPolicy{{}},
+			expectedReason: kargoapi.AutoPromotionReasonDisabled,
+		},
+		{
+			name: "auto-promotion enabled",
+			policies: []kargoapi.PromotionPolicy{
+				{EnableAutoPromotion: true},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			decision := CheckPromotionPolicies(testCase.policies)
+			if testCase.expectedReason == "" {
+				require.Nil(t, decision)
+				return
+			}
+			require.NotNil(t, decision)
+			require.Equal(t, testCase.expectedReason, decision.Reason)
+		})
+	}
+}
+
+func TestExplainFreightEligibility(t *testing.T) {
+	now := time.Now()
+	newFreight := func(name string, age time.Duration) kargoapi.Freight {
+		return kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+		}
+	}
+	testCases := []struct {
+		name            string
+		stage           *kargoapi.Stage
+		candidates      []kargoapi.Freight
+		blocker         *kargoapi.AutoPromotionDecision
+		expectedReasons []string
+	}{
+		{
+			name: "latest Freight is eligible",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+			},
+			candidates: []kargoapi.Freight{
+				newFreight("older", time.Hour),
+				newFreight("newer", time.Minute),
+			},
+			expectedReasons: []string{
+				FreightEligibilityReasonEligible,
+				FreightEligibilityReasonNotLatest,
+			},
+		},
+		{
+			name: "latest Freight is current",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{ID: "newer"},
+				},
+			},
+			candidates: []kargoapi.Freight{
+				newFreight("older", time.Hour),
+				newFreight("newer", time.Minute),
+			},
+			expectedReasons: []string{
+				kargoapi.AutoPromotionReasonAlreadyCurrent,
+				FreightEligibilityReasonNotLatest,
+			},
+		},
+		{
+			name: "latest Freight previously failed",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+			},
+			candidates: func() []kargoapi.Freight {
+				failed := newFreight("newer", time.Minute)
+				failed.Status.Failures = map[string]kargoapi.Failure{
+					"fake-stage": {Reason: "something went wrong"},
+				}
+				return []kargoapi.Freight{newFreight("older", time.Hour), failed}
+			}(),
+			expectedReasons: []string{
+				kargoapi.AutoPromotionReasonFreightPreviouslyFailed,
+				FreightEligibilityReasonNotLatest,
+			},
+		},
+		{
+			name: "Stage is blocked",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+			},
+			candidates: []kargoapi.Freight{
+				newFreight("older", time.Hour),
+				newFreight("newer", time.Minute),
+			},
+			blocker: &kargoapi.AutoPromotionDecision{
+				Reason:  kargoapi.AutoPromotionReasonLocked,
+				Message: "Stage is locked",
+			},
+			expectedReasons: []string{
+				kargoapi.AutoPromotionReasonLocked,
+				FreightEligibilityReasonNotLatest,
+			},
+		},
+		{
+			name: "Freight not qualified in enough upstream Stages",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "upstream-a"},
+							{Name: "upstream-b"},
+						},
+						QualificationRule: &kargoapi.QualificationRule{
+							Type: kargoapi.QualificationRuleTypeAll,
+						},
+					},
+				},
+			},
+			candidates: func() []kargoapi.Freight {
+				older := newFreight("older", time.Hour)
+				older.Status.Qualifications = map[string]kargoapi.Qualification{
+					"upstream-a": {},
+					"upstream-b": {},
+				}
+				newer := newFreight("newer", time.Minute)
+				newer.Status.Qualifications = map[string]kargoapi.Qualification{
+					"upstream-a": {},
+				}
+				return []kargoapi.Freight{older, newer}
+			}(),
+			expectedReasons: []string{
+				FreightEligibilityReasonNotQualifiedUpstream,
+				FreightEligibilityReasonEligible,
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			results := ExplainFreightEligibility(
+				testCase.stage,
+				testCase.candidates,
+				testCase.blocker,
+			)
+			require.Len(t, results, len(testCase.expectedReasons))
+			require.Equal(t, "newer", results[0].Freight.Name)
+			for i, reason := range testCase.expectedReasons {
+				require.Equal(t, reason, results[i].Reason)
+				require.Equal(
+					t,
+					reason == FreightEligibilityReasonEligible,
+					results[i].Eligible,
+				)
+				require.NotEmpty(t, results[i].Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package kargo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ListStageDependants returns descriptions of all resources that depend on the
+// provided Stage: Stages subscribed to it and its running Promotion, if any.
+// Stages that are already being deleted are disregarded.
+func ListStageDependants(
+	ctx context.Context,
+	c client.Client,
+	stage *kargoapi.Stage,
+) ([]string, error) {
+	var dependants []string
+	if stage.Status.CurrentPromotion != nil {
+		dependants = append(
+			dependants,
+			fmt.Sprintf("Promotion %q is running", stage.Status.CurrentPromotion.Name),
+		)
+	}
+	stages, err := listStages(ctx, c, stage.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stages {
+		for _, upstream := range stageSubscriptions(&s).UpstreamStages {
+			if upstream.Name == stage.Name && s.Name != stage.Name {
+				dependants = append(
+					dependants,
+					fmt.Sprintf("Stage %q subscribes to it", s.Name),
+				)
+				break
+			}
+		}
+	}
+	return dependants, nil
+}
+
+// ListWarehouseDependants returns descriptions of all resources that depend on
+// the provided Warehouse: Stages in which Freight produced by the Warehouse is
+// current. Stages that are already being deleted are disregarded.
+func ListWarehouseDependants(
+	ctx context.Context,
+	c client.Client,
+	warehouse *kargoapi.Warehouse,
+) ([]string, error) {
+	var freight kargoapi.FreightList
+	if err := c.List(ctx, &freight, client.InNamespace(warehouse.Namespace)); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing Freight in namespace %q",
+			warehouse.Namespace,
+		)
+	}
+	owned := map[string]struct{}{}
+	for i := range freight.Items {
+		if metav1.IsControlledBy(&freight.Items[i], warehouse) {
+			owned[freight.Items[i].Name] = struct{}{}
+		}
+	}
+	if len(owned) == 0 {
+		return nil, nil
+	}
+	stages, err := listStages(ctx, c, warehouse.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var dependants []string
+	for _, s := range stages {
+		if s.Status.CurrentFreight == nil {
+			continue
+		}
+		if _, ok := owned[s.Status.CurrentFreight.ID]; ok {
+			dependants = append(
+				dependants,
+				fmt.Sprintf(
+					"its Freight %q is current in Stage %q",
+					s.Status.CurrentFreight.ID,
+					s.Name,
+				),
+			)
+		}
+	}
+	return dependants, nil
+}
+
+// ListFreightDependants returns descriptions of all resources that depend on
+// the provided Freight: Stages in which it is current and non-terminal
+// Promotions to it. Stages that are already being deleted are disregarded.
+func ListFreightDependants(
+	ctx context.Context,
+	c client.Client,
+	freight *kargoapi.Freight,
+) ([]string, error) {
+	stages, err := listStages(ctx, c, freight.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var dependants []string
+	for _, s := range stages {
+		if s.Status.CurrentFreight != nil &&
+			s.Status.CurrentFreight.ID == freight.Name {
+			dependants = append(
+				dependants,
+				fmt.Sprintf("it is current in Stage %q", s.Name),
+			)
+		}
+	}
+	var promos kargoapi.PromotionList
+	if err = c.List(ctx, &promos, client.InNamespace(freight.Namespace)); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing Promotions in namespace %q",
+			freight.Namespace,
+		)
+	}
+	for _, promo := range promos.Items {
+		if promo.Spec == nil || promo.Spec.Freight != freight.Name ||
+			promo.Status.Phase.IsTerminal() {
+			continue
+		}
+		dependants = append(
+			dependants,
+			fmt.Sprintf("Promotion %q is %s", promo.Name, promotionPhaseOrPending(promo)),
+		)
+	}
+	return dependants, nil
+}
+
+// listStages returns all Stages in the specified namespace that are not
+// already being deleted.
+func listStages(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+) ([]kargoapi.Stage, error) {
+	var stages kargoapi.StageList
+	if err := c.List(ctx, &stages, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing Stages in namespace %q",
+			namespace,
+		)
+	}
+	res := make([]kargoapi.Stage, 0, len(stages.Items))
+	for _, stage := range stages.Items {
+		if stage.DeletionTimestamp == nil {
+			res = append(res, stage)
+		}
+	}
+	return res, nil
+}
+
+func promotionPhaseOrPending(promo kargoapi.Promotion) string {
+	if promo.Status.Phase == "" {
+		return string(kargoapi.PromotionPhasePending)
+	}
+	return string(promo.Status.Phase)
+}
@@ -0,0 +1,166 @@
+package kargo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const testDependantsNamespace = "fake-namespace"
+
+func newDependantsTestClient(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newDependantsTestStage(
+	name string,
+	currentFreight string,
+	upstreams ...string,
+) *kargoapi.Stage {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testDependantsNamespace,
+			Name:      name,
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{},
+		},
+	}
+	for _, upstream := range upstreams {
+		stage.Spec.Subscriptions.UpstreamStages = append(
+			stage.Spec.Subscriptions.UpstreamStages,
+			kargoapi.StageSubscription{Name: upstream},
+		)
+	}
+	if currentFreight != "" {
+		stage.Status.CurrentFreight = &kargoapi.SimpleFreight{ID: currentFreight}
+	}
+	return stage
+}
+
+func TestListStageDependants(t *testing.T) {
+	stage := newDependantsTestStage("a", "")
+	stage.Status.CurrentPromotion = &kargoapi.PromotionInfo{Name: "fake-promotion"}
+	dependants, err := ListStageDependants(
+		context.Background(),
+		newDependantsTestClient(
+			t,
+			stage,
+			newDependantsTestStage("b", "", "a"),
+			newDependantsTestStage("c", "", "b"),
+		),
+		stage,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{
+			`Promotion "fake-promotion" is running`,
+			`Stage "b" subscribes to it`,
+		},
+		dependants,
+	)
+}
+
+func TestListWarehouseDependants(t *testing.T) {
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testDependantsNamespace,
+			Name:      "fake-warehouse",
+			UID:       types.UID("fake-uid"),
+		},
+	}
+	ownedFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testDependantsNamespace,
+			Name:      "owned-freight",
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(
+					warehouse,
+					kargoapi.GroupVersion.WithKind("Warehouse"),
+				),
+			},
+		},
+	}
+	otherFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testDependantsNamespace,
+			Name:      "other-freight",
+		},
+	}
+	dependants, err := ListWarehouseDependants(
+		context.Background(),
+		newDependantsTestClient(
+			t,
+			warehouse,
+			ownedFreight,
+			otherFreight,
+			newDependantsTestStage("a", "owned-freight"),
+			newDependantsTestStage("b", "other-freight"),
+		),
+		warehouse,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{`its Freight "owned-freight" is current in Stage "a"`},
+		dependants,
+	)
+}
+
+func TestListFreightDependants(t *testing.T) {
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testDependantsNamespace,
+			Name:      "fake-freight",
+		},
+	}
+	newPromo := func(name string, phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testDependantsNamespace,
+				Name:      name,
+			},
+			Spec: &kargoapi.PromotionSpec{
+				Stage:   "b",
+				Freight: "fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: phase,
+			},
+		}
+	}
+	dependants, err := ListFreightDependants(
+		context.Background(),
+		newDependantsTestClient(
+			t,
+			freight,
+			newDependantsTestStage("a", "fake-freight"),
+			newDependantsTestStage("b", "other-freight"),
+			newPromo("pending-promotion", ""),
+			newPromo("running-promotion", kargoapi.PromotionPhaseRunning),
+			newPromo("succeeded-promotion", kargoapi.PromotionPhaseSucceeded),
+		),
+		freight,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{
+			`it is current in Stage "a"`,
+			`Promotion "pending-promotion" is Pending`,
+			`Promotion "running-promotion" is Running`,
+		},
+		dependants,
+	)
+}
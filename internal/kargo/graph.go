@@ -0,0 +1,162 @@
+package kargo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// StageSubscriptionIssues describes problems with a Stage's subscriptions
+// within the graph formed by all Stages and Warehouses of its project.
+type StageSubscriptionIssues struct {
+	// Cycle, if non-empty, lists the names of the Stages forming a subscription
+	// cycle, in the order Freight would flow through them, beginning and ending
+	// with the Stage being checked.
+	Cycle []string
+	// Warnings describes references to Warehouses or upstream Stages that do
+	// not exist.
+	Warnings []string
+}
+
+// CycleError returns an error describing the subscription cycle, if any. If
+// there is no cycle, nil is returned.
+func (s StageSubscriptionIssues) CycleError() error {
+	if len(s.Cycle) == 0 {
+		return nil
+	}
+	return errors.Errorf(
+		"subscriptions would introduce a cycle: %s",
+		strings.Join(s.Cycle, " -> "),
+	)
+}
+
+// CheckStageSubscriptions lists all Stages and Warehouses in the provided
+// Stage's project and checks the Stage's subscriptions against them. Any
+// pending Stages or Warehouses (for instance, those about to be created along
+// with the provided Stage) are treated as if they already existed, replacing
+// existing resources of the same kind and name.
+func CheckStageSubscriptions(
+	ctx context.Context,
+	c client.Client,
+	stage *kargoapi.Stage,
+	pending ...client.Object,
+) (StageSubscriptionIssues, error) {
+	var stages kargoapi.StageList
+	if err := c.List(ctx, &stages, client.InNamespace(stage.Namespace)); err != nil {
+		return StageSubscriptionIssues{}, errors.Wrapf(
+			err,
+			"error listing Stages in namespace %q",
+			stage.Namespace,
+		)
+	}
+	var warehouses kargoapi.WarehouseList
+	if err := c.List(ctx, &warehouses, client.InNamespace(stage.Namespace)); err != nil {
+		return StageSubscriptionIssues{}, errors.Wrapf(
+			err,
+			"error listing Warehouses in namespace %q",
+			stage.Namespace,
+		)
+	}
+	stagesByName := make(map[string]*kargoapi.Stage, len(stages.Items))
+	for i := range stages.Items {
+		stagesByName[stages.Items[i].Name] = &stages.Items[i]
+	}
+	warehouseNames := make(map[string]struct{}, len(warehouses.Items))
+	for _, warehouse := range warehouses.Items {
+		warehouseNames[warehouse.Name] = struct{}{}
+	}
+	for _, obj := range pending {
+		if obj.GetNamespace() != stage.Namespace {
+			continue
+		}
+		switch o := obj.(type) {
+		case *kargoapi.Stage:
+			stagesByName[o.Name] = o
+		case *kargoapi.Warehouse:
+			warehouseNames[o.Name] = struct{}{}
+		}
+	}
+	return checkStageSubscriptions(stage, stagesByName, warehouseNames), nil
+}
+
+func checkStageSubscriptions(
+	stage *kargoapi.Stage,
+	stagesByName map[string]*kargoapi.Stage,
+	warehouseNames map[string]struct{},
+) StageSubscriptionIssues {
+	var issues StageSubscriptionIssues
+	subs := stageSubscriptions(stage)
+	if subs.Warehouse != "" {
+		if _, ok := warehouseNames[subs.Warehouse]; !ok {
+			issues.Warnings = append(
+				issues.Warnings,
+				fmt.Sprintf(
+					"Warehouse %q does not exist in namespace %q",
+					subs.Warehouse,
+					stage.Namespace,
+				),
+			)
+		}
+	}
+	for _, upstream := range subs.UpstreamStages {
+		if upstream.Name == stage.Name {
+			continue
+		}
+		if _, ok := stagesByName[upstream.Name]; !ok {
+			issues.Warnings = append(
+				issues.Warnings,
+				fmt.Sprintf(
+					"upstream Stage %q does not exist in namespace %q",
+					upstream.Name,
+					stage.Namespace,
+				),
+			)
+		}
+	}
+
+	// Walk upstream from the Stage being checked. If the walk leads back to the
+	// Stage, its subscriptions would introduce a cycle.
+	stagesByName[stage.Name] = stage
+	visited := map[string]struct{}{}
+	var walk func(name string, path []string) []string
+	walk = func(name string, path []string) []string {
+		s, ok := stagesByName[name]
+		if !ok {
+			return nil
+		}
+		for _, upstream := range stageSubscriptions(s).UpstreamStages {
+			if upstream.Name == stage.Name {
+				return append(path, upstream.Name)
+			}
+			if _, ok := visited[upstream.Name]; ok {
+				continue
+			}
+			visited[upstream.Name] = struct{}{}
+			if cycle := walk(upstream.Name, append(path, upstream.Name)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	if cycle := walk(stage.Name, []string{stage.Name}); cycle != nil {
+		// The walk followed subscriptions upstream; reverse the path so it
+		// follows the flow of Freight instead.
+		for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+			cycle[i], cycle[j] = cycle[j], cycle[i]
+		}
+		issues.Cycle = cycle
+	}
+	return issues
+}
+
+func stageSubscriptions(stage *kargoapi.Stage) *kargoapi.Subscriptions {
+	if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+		return &kargoapi.Subscriptions{}
+	}
+	return stage.Spec.Subscriptions
+}
@@ -0,0 +1,157 @@
+package kargo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCheckStageSubscriptions(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	newStage := func(name, warehouse string, upstreams ...string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      name,
+			},
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{
+					Warehouse: warehouse,
+				},
+			},
+		}
+		for _, upstream := range upstreams {
+			stage.Spec.Subscriptions.UpstreamStages = append(
+				stage.Spec.Subscriptions.UpstreamStages,
+				kargoapi.StageSubscription{Name: upstream},
+			)
+		}
+		return stage
+	}
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "fake-warehouse",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		existing   []client.Object
+		pending    []client.Object
+		assertions func(StageSubscriptionIssues, error)
+	}{
+		{
+			name:     "no issues",
+			stage:    newStage("b", "", "a"),
+			existing: []client.Object{testWarehouse, newStage("a", "fake-warehouse")},
+			assertions: func(issues StageSubscriptionIssues, err error) {
+				require.NoError(t, err)
+				require.Empty(t, issues.Cycle)
+				require.Empty(t, issues.Warnings)
+				require.NoError(t, issues.CycleError())
+			},
+		},
+		{
+			name:  "missing Warehouse",
+			stage: newStage("a", "fake-warehouse"),
+			assertions: func(issues StageSubscriptionIssues, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						`Warehouse "fake-warehouse" does not exist in namespace "fake-namespace"`,
+					},
+					issues.Warnings,
+				)
+			},
+		},
+		{
+			name:  "missing upstream Stage",
+			stage: newStage("b", "", "a"),
+			assertions: func(issues StageSubscriptionIssues, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						`upstream Stage "a" does not exist in namespace "fake-namespace"`,
+					},
+					issues.Warnings,
+				)
+			},
+		},
+		{
+			name:    "pending resources are considered",
+			stage:   newStage("b", "", "a"),
+			pending: []client.Object{testWarehouse, newStage("a", "fake-warehouse")},
+			assertions: func(issues StageSubscriptionIssues, err error) {
+				require.NoError(t, err)
+				require.Empty(t, issues.Warnings)
+			},
+		},
+		{
+			name:  "Stage subscribes to itself",
+			stage: newStage("a", "", "a"),
+			assertions: func(issues StageSubscriptionIssues, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"a", "a"}, issues.Cycle)
+				require.Empty(t, issues.Warnings)
+			},
+		},
+		{
+			name:  "cycle",
+			stage: newStage("a", "", "c"),
+			existing: []client.Object{
+				newStage("b", "", "a"),
+				newStage("c", "", "b"),
+			},
+			assertions: func(issues StageSubscriptionIssues, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"a", "b", "c", "a"}, issues.Cycle)
+				require.EqualError(
+					t,
+					issues.CycleError(),
+					"subscriptions would introduce a cycle: a -> b -> c -> a",
+				)
+			},
+		},
+		{
+			name:  "existing cycle not involving the Stage",
+			stage: newStage("c", "", "a"),
+			existing: []client.Object{
+				newStage("a", "", "b"),
+				newStage("b", "", "a"),
+			},
+			assertions: func(issues StageSubscriptionIssues, err error) {
+				require.NoError(t, err)
+				require.Empty(t, issues.Cycle)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.existing...).
+				Build()
+			testCase.assertions(
+				CheckStageSubscriptions(
+					context.Background(),
+					c,
+					testCase.stage,
+					testCase.pending...,
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package kargo
+
+import (
+	"fmt"
+	"time"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// Outcomes of promoting an individual Stage as one of many Stages selected
+// for promotion at once.
+const (
+	// StagePromotionOutcomeCreated indicates that a Promotion of the Stage was
+	// created and that its completion was not awaited.
+	StagePromotionOutcomeCreated = "Created"
+	// StagePromotionOutcomeSucceeded indicates that a Promotion of the Stage was
+	// created and succeeded.
+	StagePromotionOutcomeSucceeded = "Succeeded"
+	// StagePromotionOutcomeFailed indicates that a Promotion of the Stage could
+	// not be created or did not succeed.
+	StagePromotionOutcomeFailed = "Failed"
+	// StagePromotionOutcomeSkipped indicates that the Freight was not eligible
+	// for promotion into the Stage.
+	StagePromotionOutcomeSkipped = "Skipped"
+	// StagePromotionOutcomeHalted indicates that the Stage was not promoted
+	// because a Promotion in an earlier wave failed.
+	StagePromotionOutcomeHalted = "Halted"
+)
+
+// CheckPromotionEligibility returns a message explaining why the provided
+// Freight cannot be promoted into the provided Stage at the provided time. It
+// returns an empty string if the Freight can be promoted into the Stage.
+func CheckPromotionEligibility(
+	stage kargoapi.Stage,
+	freight kargoapi.Freight,
+	now time.Time,
+) string {
+	if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+		return "Stage has no subscriptions"
+	}
+	if stage.Spec.Lock.IsActive(now) {
+		return fmt.Sprintf("Stage is locked: %s", stage.Spec.Lock.Reason)
+	}
+	if stage.Status.CurrentFreight != nil &&
+		stage.Status.CurrentFreight.ID == freight.Name {
+		return "Freight is already the Stage's current Freight"
+	}
+	subs := stage.Spec.Subscriptions
+	if subs.Warehouse != "" {
+		for _, ownerRef := range freight.OwnerReferences {
+			if ownerRef.APIVersion == kargoapi.GroupVersion.String() &&
+				ownerRef.Kind == "Warehouse" &&
+				ownerRef.Name == subs.Warehouse {
+				return ""
+			}
+		}
+		return fmt.Sprintf(
+			"Freight did not originate from the Stage's Warehouse %q",
+			subs.Warehouse,
+		)
+	}
+	var qualifications int
+	for _, upstreamStage := range subs.UpstreamStages {
+		if _, ok := freight.Status.Qualifications[upstreamStage.Name]; ok {
+			qualifications++
+		}
+	}
+	minQualifications :=
+		subs.QualificationRule.MinQualifications(len(subs.UpstreamStages))
+	if minQualifications == 0 {
+		// Freight must always have qualified in at least one upstream Stage
+		minQualifications = 1
+	}
+	if qualifications < minQualifications {
+		return fmt.Sprintf(
+			"Freight has qualified in %d of %d upstream Stages; %d required",
+			qualifications,
+			len(subs.UpstreamStages),
+			minQualifications,
+		)
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+package kargo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCheckPromotionEligibility(t *testing.T) {
+	now := time.Now()
+	testFreight := kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-freight",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: kargoapi.GroupVersion.String(),
+				Kind:       "Warehouse",
+				Name:       "fake-warehouse",
+			}},
+		},
+		Status: kargoapi.FreightStatus{
+			Qualifications: map[string]kargoapi.Qualification{
+				"upstream-a": {},
+			},
+		},
+	}
+	testCases := []struct {
+		name     string
+		stage    kargoapi.Stage
+		expected string
+	}{
+		{
+			name:     "Stage has no subscriptions",
+			stage:    kargoapi.Stage{Spec: &kargoapi.StageSpec{}},
+			expected: "Stage has no subscriptions",
+		},
+		{
+			name: "Stage is locked",
+			stage: kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					Lock: &kargoapi.StageLock{Reason: "change freeze"},
+				},
+			},
+			expected: "Stage is locked: change freeze",
+		},
+		{
+			name: "expired lock is ignored",
+			stage: kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					Lock: &kargoapi.StageLock{
+						Reason: "change freeze",
+						Until:  &metav1.Time{Time: now.Add(-time.Minute)},
+					},
+				},
+			},
+		},
+		{
+			name: "Freight is already current",
+			stage: kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.SimpleFreight{ID: "fake-freight"},
+				},
+			},
+			expected: "Freight is already the Stage's current Freight",
+		},
+		{
+			name: "Freight from a different Warehouse",
+			stage: kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "other-warehouse",
+					},
+				},
+			},
+			expected: `Freight did not originate from the Stage's Warehouse "other-warehouse"`,
+		},
+		{
+			name: "Freight not qualified upstream",
+			stage: kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "upstream-b"},
+						},
+					},
+				},
+			},
+			expected: "Freight has qualified in 0 of 1 upstream Stages; 1 required",
+		},
+		{
+			name: "Freight not qualified in all upstream Stages",
+			stage: kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "upstream-a"},
+							{Name: "upstream-b"},
+						},
+						QualificationRule: &kargoapi.QualificationRule{
+							Type: kargoapi.QualificationRuleTypeAll,
+						},
+					},
+				},
+			},
+			expected: "Freight has qualified in 1 of 2 upstream Stages; 2 required",
+		},
+		{
+			name: "Freight qualified upstream",
+			stage: kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "upstream-a"},
+							{Name: "upstream-b"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				CheckPromotionEligibility(testCase.stage, testFreight, now),
+			)
+		})
+	}
+}
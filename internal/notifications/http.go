@@ -0,0 +1,49 @@
+package notifications
+
+import (
+	"context"
+	"net/http"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// httpSink is an implementation of the Sink interface that POSTs the output of
+// a template to an arbitrary HTTP endpoint.
+type httpSink struct {
+	url        string
+	headers    map[string]string
+	template   string
+	httpClient *http.Client
+}
+
+func newHTTPSink(
+	spec kargoapi.HTTPNotificationSink,
+	secretHeaders map[string]string,
+) Sink {
+	contentType := spec.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	headers := map[string]string{"Content-Type": contentType}
+	for k, v := range spec.Headers {
+		headers[k] = v
+	}
+	for k, v := range secretHeaders {
+		headers[k] = v
+	}
+	return &httpSink{
+		url:        spec.URL,
+		headers:    headers,
+		template:   spec.Template,
+		httpClient: &http.Client{},
+	}
+}
+
+// Send implements the Sink interface.
+func (h *httpSink) Send(ctx context.Context, event Event) error {
+	body, err := render(h.template, event)
+	if err != nil {
+		return err
+	}
+	return post(ctx, h.httpClient, h.url, h.headers, []byte(body))
+}
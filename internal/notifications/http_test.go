@@ -0,0 +1,49 @@
+package notifications
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestHTTPSinkSend(t *testing.T) {
+	var receivedHeaders http.Header
+	var receivedBody string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			receivedHeaders = r.Header
+			body, _ := io.ReadAll(r.Body)
+			receivedBody = string(body)
+		}),
+	)
+	defer server.Close()
+
+	sink := newHTTPSink(
+		kargoapi.HTTPNotificationSink{
+			URL:         server.URL,
+			Headers:     map[string]string{"X-Fake": "fake-value"},
+			ContentType: "text/plain",
+			Template:    "{{ .Promotion }} is {{ .Phase }}",
+		},
+		map[string]string{"Authorization": "Bearer fake-token"},
+	)
+	err := sink.Send(
+		context.Background(),
+		Event{
+			Type:      kargoapi.NotificationEventTypePromotionPhaseChanged,
+			Promotion: "fake-promotion",
+			Phase:     "Succeeded",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-promotion is Succeeded", receivedBody)
+	require.Equal(t, "text/plain", receivedHeaders.Get("Content-Type"))
+	require.Equal(t, "fake-value", receivedHeaders.Get("X-Fake"))
+	require.Equal(t, "Bearer fake-token", receivedHeaders.Get("Authorization"))
+}
@@ -0,0 +1,283 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// requestTimeout is the maximum length of time to wait for a single request to
+// a webhook or HTTP endpoint to complete.
+const requestTimeout = 30 * time.Second
+
+// Event describes something that happened in a project that may be of
+// interest to its users. Events are made available to templates as the
+// template's data.
+type Event struct {
+	// Type is the type of the event.
+	Type kargoapi.NotificationEventType `json:"type"`
+	// Time is the time at which the event was observed.
+	Time time.Time `json:"time"`
+	// Project is the name of the project in which the event occurred.
+	Project string `json:"project"`
+	// Stage is the name of the Stage the event concerns, if any.
+	Stage string `json:"stage,omitempty"`
+	// Warehouse is the name of the Warehouse the event concerns, if any.
+	Warehouse string `json:"warehouse,omitempty"`
+	// Freight is the ID of the Freight the event concerns, if any.
+	Freight string `json:"freight,omitempty"`
+	// Promotion is the name of the Promotion the event concerns, if any.
+	Promotion string `json:"promotion,omitempty"`
+	// Phase is the phase a Promotion has moved into, if applicable.
+	Phase string `json:"phase,omitempty"`
+	// Health is the new health of a Stage, if applicable.
+	Health string `json:"health,omitempty"`
+	// PreviousHealth is the previous health of a Stage, if applicable.
+	PreviousHealth string `json:"previousHealth,omitempty"`
+	// Details provides additional information about the event, such as the
+	// error that caused a Promotion to fail or the issues that made a Stage
+	// unhealthy.
+	Details string `json:"details,omitempty"`
+	// Message is a human-readable summary of the event.
+	Message string `json:"message"`
+}
+
+// Sink is a destination for notifications.
+type Sink interface {
+	// Send delivers a notification describing the provided Event.
+	Send(context.Context, Event) error
+}
+
+// NewSink returns a Sink for the provided sink specification. Any Secrets it
+// references are read from the specified project namespace using the provided
+// client. An error is returned if the specification is invalid or a referenced
+// Secret cannot be read.
+func NewSink(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	sink kargoapi.NotificationSink,
+) (Sink, error) {
+	if err := ValidateSink(sink); err != nil {
+		return nil, err
+	}
+	switch {
+	case sink.Slack != nil:
+		webhookURL, err :=
+			getSecretValue(ctx, c, namespace, sink.Slack.WebhookURL)
+		if err != nil {
+			return nil, err
+		}
+		return newSlackSink(webhookURL, sink.Slack.Template), nil
+	case sink.Teams != nil:
+		webhookURL, err :=
+			getSecretValue(ctx, c, namespace, sink.Teams.WebhookURL)
+		if err != nil {
+			return nil, err
+		}
+		return newTeamsSink(webhookURL, sink.Teams.Template), nil
+	case sink.HTTP != nil:
+		var secretHeaders map[string]string
+		if sink.HTTP.HeadersSecret != nil {
+			secret, err :=
+				getSecret(ctx, c, namespace, sink.HTTP.HeadersSecret.Name)
+			if err != nil {
+				return nil, err
+			}
+			secretHeaders = make(map[string]string, len(secret.Data))
+			for k, v := range secret.Data {
+				secretHeaders[k] = string(v)
+			}
+		}
+		return newHTTPSink(*sink.HTTP, secretHeaders), nil
+	default:
+		var username, password string
+		if sink.SMTP.CredentialsSecret != nil {
+			secret, err :=
+				getSecret(ctx, c, namespace, sink.SMTP.CredentialsSecret.Name)
+			if err != nil {
+				return nil, err
+			}
+			username = string(secret.Data["username"])
+			password = string(secret.Data["password"])
+		}
+		return newSMTPSink(*sink.SMTP, username, password), nil
+	}
+}
+
+// ValidateSink returns an error if the provided sink specification does not
+// specify exactly one type of sink or if any of its templates cannot be
+// parsed.
+func ValidateSink(sink kargoapi.NotificationSink) error {
+	var count int
+	var templates []string
+	if sink.Slack != nil {
+		count++
+		templates = append(templates, sink.Slack.Template)
+	}
+	if sink.Teams != nil {
+		count++
+		templates = append(templates, sink.Teams.Template)
+	}
+	if sink.HTTP != nil {
+		count++
+		templates = append(templates, sink.HTTP.Template)
+	}
+	if sink.SMTP != nil {
+		count++
+		templates = append(templates, sink.SMTP.SubjectTemplate, sink.SMTP.Template)
+	}
+	if count != 1 {
+		return errors.Errorf(
+			"sink %q must specify exactly one of slack, teams, http, or smtp",
+			sink.Name,
+		)
+	}
+	for _, tmpl := range templates {
+		if _, err := parseTemplate(tmpl); err != nil {
+			return errors.Wrapf(err, "sink %q has an invalid template", sink.Name)
+		}
+	}
+	return nil
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes a value as JSON. It is useful for safely embedding strings
+	// in JSON payloads.
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+func parseTemplate(tmpl string) (*template.Template, error) {
+	return template.New("notification").Funcs(templateFuncs).Parse(tmpl)
+}
+
+// render renders the provided template using the provided Event as its data.
+// If the template is empty, the Event's message is returned instead.
+func render(tmpl string, event Event) (string, error) {
+	if tmpl == "" {
+		return event.Message, nil
+	}
+	t, err := parseTemplate(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "error parsing template")
+	}
+	buf := &strings.Builder{}
+	if err = t.Execute(buf, event); err != nil {
+		return "", errors.Wrap(err, "error rendering template")
+	}
+	return buf.String(), nil
+}
+
+func getSecret(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	name string,
+) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		secret,
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error getting Secret %q in namespace %q",
+			name,
+			namespace,
+		)
+	}
+	return secret, nil
+}
+
+func getSecretValue(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	selector corev1.SecretKeySelector,
+) (string, error) {
+	secret, err := getSecret(ctx, c, namespace, selector.Name)
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", errors.Errorf(
+			"Secret %q in namespace %q has no key %q",
+			selector.Name,
+			namespace,
+			selector.Key,
+		)
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+// post sends the provided body to the provided URL using an HTTP POST request
+// with the provided headers. An error is returned if the request cannot be
+// sent or if the response status code does not indicate success.
+func post(
+	ctx context.Context,
+	httpClient *http.Client,
+	endpoint string,
+	headers map[string]string,
+	body []byte,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		endpoint,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return errors.Wrap(err, "error creating request")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		// The URL, which may embed a secret token, is deliberately omitted
+		return errors.Errorf("error sending request: %s", redactURL(err))
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return errors.Errorf(
+			"received unexpected response status %d: %s",
+			res.StatusCode,
+			strings.TrimSpace(string(resBody)),
+		)
+	}
+	return nil
+}
+
+// redactURL returns the message of the provided error, omitting the URL if the
+// error is a *url.Error.
+func redactURL(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err.Error()
+	}
+	return err.Error()
+}
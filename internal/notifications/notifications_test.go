@@ -0,0 +1,367 @@
+package notifications
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewSink(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	testClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "webhook",
+			},
+			Data: map[string][]byte{
+				"url": []byte("https://example.com/hook\n"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "headers",
+			},
+			Data: map[string][]byte{
+				"Authorization": []byte("Bearer fake-token"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "smtp",
+			},
+			Data: map[string][]byte{
+				"username": []byte("fake-username"),
+				"password": []byte("fake-password"),
+			},
+		},
+	).Build()
+
+	webhookURL := corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "webhook"},
+		Key:                  "url",
+	}
+
+	testCases := []struct {
+		name       string
+		sink       kargoapi.NotificationSink
+		assertions func(Sink, error)
+	}{
+		{
+			name: "invalid sink",
+			sink: kargoapi.NotificationSink{Name: "fake-sink"},
+			assertions: func(_ Sink, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must specify exactly one of")
+			},
+		},
+		{
+			name: "Secret not found",
+			sink: kargoapi.NotificationSink{
+				Name: "fake-sink",
+				Slack: &kargoapi.SlackNotificationSink{
+					WebhookURL: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "nonexistent",
+						},
+						Key: "url",
+					},
+				},
+			},
+			assertions: func(_ Sink, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting Secret")
+			},
+		},
+		{
+			name: "Secret key not found",
+			sink: kargoapi.NotificationSink{
+				Name: "fake-sink",
+				Teams: &kargoapi.TeamsNotificationSink{
+					WebhookURL: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "webhook",
+						},
+						Key: "nonexistent",
+					},
+				},
+			},
+			assertions: func(_ Sink, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "has no key")
+			},
+		},
+		{
+			name: "slack",
+			sink: kargoapi.NotificationSink{
+				Name:  "fake-sink",
+				Slack: &kargoapi.SlackNotificationSink{WebhookURL: webhookURL},
+			},
+			assertions: func(sink Sink, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &slackSink{}, sink)
+				// Trailing whitespace should have been trimmed from the URL
+				require.Equal(
+					t,
+					"https://example.com/hook",
+					sink.(*slackSink).webhookURL, // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name: "teams",
+			sink: kargoapi.NotificationSink{
+				Name:  "fake-sink",
+				Teams: &kargoapi.TeamsNotificationSink{WebhookURL: webhookURL},
+			},
+			assertions: func(sink Sink, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &teamsSink{}, sink)
+				require.Equal(
+					t,
+					"https://example.com/hook",
+					sink.(*teamsSink).webhookURL, // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name: "http",
+			sink: kargoapi.NotificationSink{
+				Name: "fake-sink",
+				HTTP: &kargoapi.HTTPNotificationSink{
+					URL:      "https://example.com",
+					Headers:  map[string]string{"X-Fake": "fake-value"},
+					Template: "{{ .Message }}",
+					HeadersSecret: &corev1.LocalObjectReference{
+						Name: "headers",
+					},
+				},
+			},
+			assertions: func(sink Sink, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &httpSink{}, sink)
+				require.Equal(
+					t,
+					map[string]string{
+						"Content-Type":  "application/json",
+						"X-Fake":        "fake-value",
+						"Authorization": "Bearer fake-token",
+					},
+					sink.(*httpSink).headers, // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name: "smtp",
+			sink: kargoapi.NotificationSink{
+				Name: "fake-sink",
+				SMTP: &kargoapi.SMTPNotificationSink{
+					Host: "smtp.example.com",
+					From: "kargo@example.com",
+					To:   []string{"team@example.com"},
+					CredentialsSecret: &corev1.LocalObjectReference{
+						Name: "smtp",
+					},
+				},
+			},
+			assertions: func(sink Sink, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &smtpSink{}, sink)
+				s := sink.(*smtpSink) // nolint: forcetypeassert
+				require.Equal(t, "smtp.example.com:587", s.addr)
+				require.Equal(t, "fake-username", s.username)
+				require.Equal(t, "fake-password", s.password)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				NewSink(context.Background(), testClient, testNamespace, testCase.sink),
+			)
+		})
+	}
+}
+
+func TestValidateSink(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sink       kargoapi.NotificationSink
+		assertions func(error)
+	}{
+		{
+			name: "no sink type specified",
+			sink: kargoapi.NotificationSink{Name: "fake-sink"},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must specify exactly one of")
+			},
+		},
+		{
+			name: "multiple sink types specified",
+			sink: kargoapi.NotificationSink{
+				Name:  "fake-sink",
+				Slack: &kargoapi.SlackNotificationSink{},
+				Teams: &kargoapi.TeamsNotificationSink{},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must specify exactly one of")
+			},
+		},
+		{
+			name: "invalid template",
+			sink: kargoapi.NotificationSink{
+				Name: "fake-sink",
+				SMTP: &kargoapi.SMTPNotificationSink{
+					SubjectTemplate: "{{ .Message ",
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "has an invalid template")
+			},
+		},
+		{
+			name: "valid",
+			sink: kargoapi.NotificationSink{
+				Name: "fake-sink",
+				HTTP: &kargoapi.HTTPNotificationSink{
+					Template: `{"text":{{ json .Message }}}`,
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(ValidateSink(testCase.sink))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	testEvent := Event{
+		Type:    kargoapi.NotificationEventTypeStageHealthChanged,
+		Stage:   "fake-stage",
+		Message: `Stage "fake-stage" is Unhealthy`,
+	}
+	testCases := []struct {
+		name       string
+		template   string
+		assertions func(string, error)
+	}{
+		{
+			name: "empty template",
+			assertions: func(output string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testEvent.Message, output)
+			},
+		},
+		{
+			name:     "error rendering template",
+			template: "{{ .Nonexistent }}",
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error rendering template")
+			},
+		},
+		{
+			name:     "success",
+			template: `{{ .Type }} {{ .Stage }} {{ json .Message }}`,
+			assertions: func(output string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`StageHealthChanged fake-stage "Stage \"fake-stage\" is Unhealthy"`,
+					output,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(render(testCase.template, testEvent))
+		})
+	}
+}
+
+func TestPost(t *testing.T) {
+	testCases := []struct {
+		name       string
+		handler    http.HandlerFunc
+		endpoint   func(string) string
+		assertions func(error)
+	}{
+		{
+			name:    "error sending request",
+			handler: func(http.ResponseWriter, *http.Request) {},
+			endpoint: func(string) string {
+				return "http://127.0.0.1:0/secret-token"
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error sending request")
+				require.NotContains(t, err.Error(), "secret-token")
+			},
+		},
+		{
+			name: "unexpected response status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("invalid_payload"))
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unexpected response status 400")
+				require.Contains(t, err.Error(), "invalid_payload")
+			},
+		},
+		{
+			name: "success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodPost ||
+					r.Header.Get("X-Fake") != "fake-value" ||
+					string(body) != "fake-body" {
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+			endpoint := server.URL
+			if testCase.endpoint != nil {
+				endpoint = testCase.endpoint(server.URL)
+			}
+			testCase.assertions(
+				post(
+					context.Background(),
+					server.Client(),
+					endpoint,
+					map[string]string{"X-Fake": "fake-value"},
+					[]byte("fake-body"),
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package notifications
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const defaultSMTPPort = 587
+
+// smtpSink is an implementation of the Sink interface that sends email
+// through an SMTP server.
+type smtpSink struct {
+	addr            string
+	host            string
+	username        string
+	password        string
+	from            string
+	to              []string
+	subjectTemplate string
+	template        string
+	// This behavior is overridable for testing purposes:
+	sendMailFn func(
+		ctx context.Context,
+		addr string,
+		host string,
+		auth smtp.Auth,
+		from string,
+		to []string,
+		msg []byte,
+	) error
+}
+
+func newSMTPSink(
+	spec kargoapi.SMTPNotificationSink,
+	username string,
+	password string,
+) Sink {
+	port := int(spec.Port)
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+	return &smtpSink{
+		addr:            net.JoinHostPort(spec.Host, strconv.Itoa(port)),
+		host:            spec.Host,
+		username:        username,
+		password:        password,
+		from:            spec.From,
+		to:              spec.To,
+		subjectTemplate: spec.SubjectTemplate,
+		template:        spec.Template,
+		sendMailFn:      sendMail,
+	}
+}
+
+// Send implements the Sink interface.
+func (s *smtpSink) Send(ctx context.Context, event Event) error {
+	subject, err := render(s.subjectTemplate, event)
+	if err != nil {
+		return err
+	}
+	if s.subjectTemplate == "" {
+		subject = fmt.Sprintf("[kargo] %s: %s", event.Project, event.Type)
+	}
+	body, err := render(s.template, event)
+	if err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+	// Line breaks in the subject would allow arbitrary headers to be injected
+	subject = strings.Join(strings.Fields(subject), " ")
+	msg := fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=\"utf-8\"\r\n\r\n%s\r\n",
+		s.from,
+		strings.Join(s.to, ", "),
+		subject,
+		body,
+	)
+	return errors.Wrap(
+		s.sendMailFn(ctx, s.addr, s.host, auth, s.from, s.to, []byte(msg)),
+		"error sending email",
+	)
+}
+
+// sendMail is like smtp.SendMail, but gives up once the provided context is
+// canceled or the request timeout elapses, whichever comes first.
+func sendMail(
+	ctx context.Context,
+	addr string,
+	host string,
+	auth smtp.Auth,
+	from string,
+	to []string,
+	msg []byte,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if err = c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(from); err != nil {
+		return err
+	}
+	for _, rcpt := range to {
+		if err = c.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
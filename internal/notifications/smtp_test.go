@@ -0,0 +1,132 @@
+package notifications
+
+import (
+	"context"
+	"net/smtp"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSMTPSinkSend(t *testing.T) {
+	testEvent := Event{
+		Type:    kargoapi.NotificationEventTypeFreightFailed,
+		Project: "fake-project",
+		Message: "fake-message",
+	}
+	testCases := []struct {
+		name       string
+		spec       kargoapi.SMTPNotificationSink
+		username   string
+		sendMailFn func(
+			context.Context,
+			string,
+			string,
+			smtp.Auth,
+			string,
+			[]string,
+			[]byte,
+		) error
+		assertions func(error)
+	}{
+		{
+			name: "error sending email",
+			spec: kargoapi.SMTPNotificationSink{
+				Host: "smtp.example.com",
+				From: "kargo@example.com",
+				To:   []string{"team@example.com"},
+			},
+			sendMailFn: func(
+				context.Context,
+				string,
+				string,
+				smtp.Auth,
+				string,
+				[]string,
+				[]byte,
+			) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error sending email")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "default subject and body",
+			spec: kargoapi.SMTPNotificationSink{
+				Host: "smtp.example.com",
+				From: "kargo@example.com",
+				To:   []string{"team@example.com", "oncall@example.com"},
+			},
+			sendMailFn: func(
+				_ context.Context,
+				addr string,
+				_ string,
+				auth smtp.Auth,
+				from string,
+				to []string,
+				msg []byte,
+			) error {
+				require.Equal(t, "smtp.example.com:587", addr)
+				require.Nil(t, auth)
+				require.Equal(t, "kargo@example.com", from)
+				require.Equal(t, []string{"team@example.com", "oncall@example.com"}, to)
+				require.Contains(t, string(msg), "To: team@example.com, oncall@example.com\r\n")
+				require.Contains(t, string(msg), "Subject: [kargo] fake-project: FreightFailed\r\n")
+				require.Contains(t, string(msg), "\r\n\r\nfake-message\r\n")
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "templated subject and body with auth",
+			spec: kargoapi.SMTPNotificationSink{
+				Host: "smtp.example.com",
+				Port: 2525,
+				From: "kargo@example.com",
+				To:   []string{"team@example.com"},
+				// The line break must not make it into the headers
+				SubjectTemplate: "{{ .Type }}\r\nBcc: attacker@example.com",
+				Template:        "Project: {{ .Project }}",
+			},
+			username: "fake-username",
+			sendMailFn: func(
+				_ context.Context,
+				addr string,
+				_ string,
+				auth smtp.Auth,
+				_ string,
+				_ []string,
+				msg []byte,
+			) error {
+				require.Equal(t, "smtp.example.com:2525", addr)
+				require.NotNil(t, auth)
+				require.Contains(
+					t,
+					string(msg),
+					"Subject: FreightFailed Bcc: attacker@example.com\r\n",
+				)
+				require.NotContains(t, string(msg), "\r\nBcc:")
+				require.Contains(t, string(msg), "\r\n\r\nProject: fake-project\r\n")
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sink := newSMTPSink(testCase.spec, testCase.username, "fake-password")
+			sink.(*smtpSink).sendMailFn = testCase.sendMailFn // nolint: forcetypeassert
+			testCase.assertions(sink.Send(context.Background(), testEvent))
+		})
+	}
+}
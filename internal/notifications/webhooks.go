@@ -0,0 +1,85 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// slackSink is an implementation of the Sink interface that posts messages to
+// a Slack-compatible incoming webhook.
+type slackSink struct {
+	webhookURL string
+	template   string
+	httpClient *http.Client
+}
+
+func newSlackSink(webhookURL, template string) Sink {
+	return &slackSink{
+		webhookURL: webhookURL,
+		template:   template,
+		httpClient: &http.Client{},
+	}
+}
+
+// Send implements the Sink interface.
+func (s *slackSink) Send(ctx context.Context, event Event) error {
+	text, err := render(s.template, event)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return errors.Wrap(err, "error encoding Slack message")
+	}
+	return post(
+		ctx,
+		s.httpClient,
+		s.webhookURL,
+		map[string]string{"Content-Type": "application/json"},
+		body,
+	)
+}
+
+// teamsSink is an implementation of the Sink interface that posts messages to
+// a Microsoft Teams incoming webhook.
+type teamsSink struct {
+	webhookURL string
+	template   string
+	httpClient *http.Client
+}
+
+func newTeamsSink(webhookURL, template string) Sink {
+	return &teamsSink{
+		webhookURL: webhookURL,
+		template:   template,
+		httpClient: &http.Client{},
+	}
+}
+
+// Send implements the Sink interface.
+func (t *teamsSink) Send(ctx context.Context, event Event) error {
+	text, err := render(t.template, event)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{
+		"@type":    "MessageCard",
+		"@context": "https://schema.org/extensions",
+		"summary":  event.Message,
+		"title":    string(event.Type),
+		"text":     text,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error encoding Teams message")
+	}
+	return post(
+		ctx,
+		t.httpClient,
+		t.webhookURL,
+		map[string]string{"Content-Type": "application/json"},
+		body,
+	)
+}
@@ -0,0 +1,69 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSlackSinkSend(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}),
+	)
+	defer server.Close()
+
+	err := newSlackSink(server.URL, "Stage {{ .Stage }}: {{ .Health }}").Send(
+		context.Background(),
+		Event{
+			Type:   kargoapi.NotificationEventTypeStageHealthChanged,
+			Stage:  "fake-stage",
+			Health: "Unhealthy",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]string{"text": "Stage fake-stage: Unhealthy"},
+		received,
+	)
+}
+
+func TestTeamsSinkSend(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}),
+	)
+	defer server.Close()
+
+	err := newTeamsSink(server.URL, "").Send(
+		context.Background(),
+		Event{
+			Type:    kargoapi.NotificationEventTypeFreightCreated,
+			Message: "fake-message",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "MessageCard", received["@type"])
+	require.Equal(t, "FreightCreated", received["title"])
+	require.Equal(t, "fake-message", received["summary"])
+	require.Equal(t, "fake-message", received["text"])
+}
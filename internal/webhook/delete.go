@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// IsDeleteForced returns true if the provided object has been annotated to
+// permit its deletion even though other resources depend on it.
+func IsDeleteForced(obj client.Object) bool {
+	return obj.GetAnnotations()[kargoapi.AnnotationKeyForceDelete] ==
+		kargoapi.LabelTrueValue
+}
+
+// IsNamespaceTerminating returns true if the specified namespace does not exist
+// or is being deleted, in which case deletion of all resources within it should
+// be permitted.
+func IsNamespaceTerminating(
+	ctx context.Context,
+	kubeClient client.Client,
+	namespace string,
+) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := kubeClient.Get(
+		ctx,
+		client.ObjectKey{Name: namespace},
+		ns,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return ns.DeletionTimestamp != nil, nil
+}
+
+// NewInUseError returns a Forbidden error explaining that the named resource
+// cannot be deleted because of the provided dependants. If there are no
+// dependants, nil is returned.
+func NewInUseError(
+	gr schema.GroupResource,
+	name string,
+	dependants []string,
+) error {
+	if len(dependants) == 0 {
+		return nil
+	}
+	return apierrors.NewForbidden(
+		gr,
+		name,
+		errors.Errorf(
+			"in use: %s; annotate it with %s=%q to delete it anyway",
+			strings.Join(dependants, "; "),
+			kargoapi.AnnotationKeyForceDelete,
+			kargoapi.LabelTrueValue,
+		),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -11,13 +12,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
-var freightGroupKind = schema.GroupKind{
-	Group: kargoapi.GroupVersion.Group,
-	Kind:  "Freight",
-}
+var (
+	freightGroupKind = schema.GroupKind{
+		Group: kargoapi.GroupVersion.Group,
+		Kind:  "Freight",
+	}
+	freightGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "Freight",
+	}
+)
 
 type webhook struct {
 	client client.Client
@@ -30,6 +38,20 @@ type webhook struct {
 		schema.GroupKind,
 		client.Object,
 	) error
+
+	isNamespaceTerminatingFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
+	listDependantsFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Freight,
+	) ([]string, error)
+
+	isWarehouseDeletedFn func(context.Context, *kargoapi.Freight) (bool, error)
 }
 
 func SetupWebhookWithManager(mgr ctrl.Manager) error {
@@ -42,10 +64,14 @@ func SetupWebhookWithManager(mgr ctrl.Manager) error {
 }
 
 func newWebhook(kubeClient client.Client) *webhook {
-	return &webhook{
-		client:            kubeClient,
-		validateProjectFn: libWebhook.ValidateProject,
+	w := &webhook{
+		client: kubeClient,
 	}
+	w.validateProjectFn = libWebhook.ValidateProject
+	w.isNamespaceTerminatingFn = libWebhook.IsNamespaceTerminating
+	w.listDependantsFn = kargo.ListFreightDependants
+	w.isWarehouseDeletedFn = w.isWarehouseDeleted
+	return w
 }
 
 func (w *webhook) Default(_ context.Context, obj runtime.Object) error {
@@ -111,7 +137,62 @@ func (w *webhook) ValidateUpdate(
 	return nil
 }
 
-func (w *webhook) ValidateDelete(context.Context, runtime.Object) error {
-	// No-op
-	return nil
+func (w *webhook) ValidateDelete(
+	ctx context.Context,
+	obj runtime.Object,
+) error {
+	freight := obj.(*kargoapi.Freight) // nolint: forcetypeassert
+	if libWebhook.IsDeleteForced(freight) {
+		return nil
+	}
+	terminating, err :=
+		w.isNamespaceTerminatingFn(ctx, w.client, freight.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if terminating {
+		return nil
+	}
+	// Freight is garbage collected when the Warehouse that produced it is
+	// deleted.
+	warehouseDeleted, err := w.isWarehouseDeletedFn(ctx, freight)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if warehouseDeleted {
+		return nil
+	}
+	dependants, err := w.listDependantsFn(ctx, w.client, freight)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	return libWebhook.NewInUseError(freightGroupResource, freight.Name, dependants)
+}
+
+// isWarehouseDeleted returns true if the Warehouse that produced the provided
+// Freight no longer exists or is being deleted.
+func (w *webhook) isWarehouseDeleted(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+) (bool, error) {
+	ownerRef := metav1.GetControllerOf(freight)
+	if ownerRef == nil || ownerRef.Kind != "Warehouse" {
+		return false, nil
+	}
+	warehouse := &kargoapi.Warehouse{}
+	if err := w.client.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: freight.Namespace,
+			Name:      ownerRef.Name,
+		},
+		warehouse,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return warehouse.UID != ownerRef.UID ||
+		warehouse.DeletionTimestamp != nil, nil
 }
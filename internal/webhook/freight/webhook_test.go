@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +20,9 @@ func TestNewWebhook(t *testing.T) {
 	w := newWebhook(kubeClient)
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.isNamespaceTerminatingFn)
+	require.NotNil(t, w.listDependantsFn)
+	require.NotNil(t, w.isWarehouseDeletedFn)
 }
 
 func TestDefault(t *testing.T) {
@@ -170,12 +174,199 @@ func TestValidateUpdate(t *testing.T) {
 }
 
 func TestValidateDelete(t *testing.T) {
-	w := &webhook{}
-	require.NoError(
-		t,
-		w.ValidateDelete(
-			context.Background(),
-			&kargoapi.Freight{},
-		),
-	)
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		forced     bool
+		assertions func(error)
+	}{
+		{
+			name:    "deletion forced",
+			webhook: &webhook{},
+			forced:  true,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error checking namespace",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "namespace terminating",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error checking Warehouse",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				isWarehouseDeletedFn: func(
+					context.Context,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "Warehouse deleted",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				isWarehouseDeletedFn: func(
+					context.Context,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return true, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error listing dependants",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				isWarehouseDeletedFn: func(
+					context.Context,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Freight,
+				) ([]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "in use",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				isWarehouseDeletedFn: func(
+					context.Context,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Freight,
+				) ([]string, error) {
+					return []string{"something depends on it"}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				require.Contains(t, err.Error(), "something depends on it")
+				require.Contains(t, err.Error(), kargoapi.AnnotationKeyForceDelete)
+			},
+		},
+		{
+			name: "not in use",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				isWarehouseDeletedFn: func(
+					context.Context,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Freight,
+				) ([]string, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			freight := &kargoapi.Freight{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "fake-freight",
+					Namespace: "fake-namespace",
+				},
+			}
+			if testCase.forced {
+				freight.Annotations = map[string]string{
+					kargoapi.AnnotationKeyForceDelete: kargoapi.LabelTrueValue,
+				}
+			}
+			testCase.assertions(
+				testCase.webhook.ValidateDelete(context.Background(), freight),
+			)
+		})
+	}
 }
@@ -0,0 +1,127 @@
+package notificationpolicy
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/notifications"
+	libWebhook "github.com/akuity/kargo/internal/webhook"
+)
+
+var notificationPolicyGroupKind = schema.GroupKind{
+	Group: kargoapi.GroupVersion.Group,
+	Kind:  "NotificationPolicy",
+}
+
+type webhook struct {
+	client client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	validateProjectFn func(
+		context.Context,
+		client.Client,
+		schema.GroupKind,
+		client.Object,
+	) error
+
+	validateCreateOrUpdateFn func(*kargoapi.NotificationPolicy) error
+
+	validateSpecFn func(
+		*field.Path,
+		*kargoapi.NotificationPolicySpec,
+	) field.ErrorList
+}
+
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	w := newWebhook(mgr.GetClient())
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&kargoapi.NotificationPolicy{}).
+		WithValidator(w).
+		Complete()
+}
+
+func newWebhook(kubeClient client.Client) *webhook {
+	w := &webhook{
+		client: kubeClient,
+	}
+	w.validateProjectFn = libWebhook.ValidateProject
+	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
+	w.validateSpecFn = w.validateSpec
+	return w
+}
+
+func (w *webhook) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) error {
+	policy := obj.(*kargoapi.NotificationPolicy) // nolint: forcetypeassert
+	if err := w.validateProjectFn(
+		ctx,
+		w.client,
+		notificationPolicyGroupKind,
+		policy,
+	); err != nil {
+		return err
+	}
+	return w.validateCreateOrUpdateFn(policy)
+}
+
+func (w *webhook) ValidateUpdate(
+	_ context.Context,
+	_ runtime.Object,
+	newObj runtime.Object,
+) error {
+	policy := newObj.(*kargoapi.NotificationPolicy) // nolint: forcetypeassert
+	return w.validateCreateOrUpdateFn(policy)
+}
+
+func (w *webhook) ValidateDelete(context.Context, runtime.Object) error {
+	// No-op
+	return nil
+}
+
+func (w *webhook) validateCreateOrUpdate(
+	policy *kargoapi.NotificationPolicy,
+) error {
+	if errs :=
+		w.validateSpecFn(field.NewPath("spec"), policy.Spec); len(errs) > 0 {
+		return apierrors.NewInvalid(notificationPolicyGroupKind, policy.Name, errs)
+	}
+	return nil
+}
+
+func (w *webhook) validateSpec(
+	f *field.Path,
+	spec *kargoapi.NotificationPolicySpec,
+) field.ErrorList {
+	if spec == nil { // nil spec is caught by declarative validations
+		return nil
+	}
+	return w.validateSinks(f.Child("sinks"), spec.Sinks)
+}
+
+func (w *webhook) validateSinks(
+	f *field.Path,
+	sinks []kargoapi.NotificationSink,
+) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]struct{}, len(sinks))
+	for i, sink := range sinks {
+		if _, ok := names[sink.Name]; ok {
+			errs = append(errs, field.Duplicate(f.Index(i).Child("name"), sink.Name))
+		}
+		names[sink.Name] = struct{}{}
+		if err := notifications.ValidateSink(sink); err != nil {
+			errs = append(errs, field.Invalid(f.Index(i), sink.Name, err.Error()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,274 @@
+package notificationpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewWebhook(t *testing.T) {
+	kubeClient := fake.NewClientBuilder().Build()
+	w := newWebhook(kubeClient)
+	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.validateCreateOrUpdateFn)
+	require.NotNil(t, w.validateSpecFn)
+}
+
+func TestValidateCreate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(error)
+	}{
+		{
+			name: "error validating project",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error validating notification policy",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(*kargoapi.NotificationPolicy) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(*kargoapi.NotificationPolicy) error {
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.webhook.ValidateCreate(
+					context.Background(),
+					&kargoapi.NotificationPolicy{},
+				),
+			)
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(error)
+	}{
+		{
+			name: "error validating notification policy",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(*kargoapi.NotificationPolicy) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(*kargoapi.NotificationPolicy) error {
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.webhook.ValidateUpdate(
+					context.Background(),
+					nil,
+					&kargoapi.NotificationPolicy{},
+				),
+			)
+		})
+	}
+}
+
+func TestValidateDelete(t *testing.T) {
+	w := &webhook{}
+	require.NoError(
+		t,
+		w.ValidateDelete(context.Background(), &kargoapi.NotificationPolicy{}),
+	)
+}
+
+func TestValidateCreateOrUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(error)
+	}{
+		{
+			name: "error validating spec",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.NotificationPolicySpec,
+				) field.ErrorList {
+					return field.ErrorList{{}}
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.NotificationPolicySpec,
+				) field.ErrorList {
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.webhook.validateCreateOrUpdate(
+					&kargoapi.NotificationPolicy{},
+				),
+			)
+		})
+	}
+}
+
+func TestValidateSpec(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       *kargoapi.NotificationPolicySpec
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "invalid",
+			spec: &kargoapi.NotificationPolicySpec{
+				Sinks: []kargoapi.NotificationSink{
+					{
+						Name:  "fake-sink",
+						Slack: &kargoapi.SlackNotificationSink{},
+					},
+					{
+						Name:  "fake-sink",
+						Teams: &kargoapi.TeamsNotificationSink{},
+					},
+					{
+						Name: "other-sink",
+					},
+					{
+						Name: "bad-template",
+						HTTP: &kargoapi.HTTPNotificationSink{
+							Template: "{{ .Message ",
+						},
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 3)
+				require.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+				require.Equal(t, "spec.sinks[1].name", errs[0].Field)
+				require.Equal(t, field.ErrorTypeInvalid, errs[1].Type)
+				require.Equal(t, "spec.sinks[2]", errs[1].Field)
+				require.Contains(t, errs[1].Detail, "must specify exactly one of")
+				require.Equal(t, field.ErrorTypeInvalid, errs[2].Type)
+				require.Equal(t, "spec.sinks[3]", errs[2].Field)
+				require.Contains(t, errs[2].Detail, "has an invalid template")
+			},
+		},
+		{
+			name: "valid",
+			spec: &kargoapi.NotificationPolicySpec{
+				Sinks: []kargoapi.NotificationSink{
+					{
+						Name:  "slack",
+						Slack: &kargoapi.SlackNotificationSink{},
+					},
+					{
+						Name: "http",
+						HTTP: &kargoapi.HTTPNotificationSink{
+							Template: `{"text":{{ json .Message }}}`,
+						},
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateSpec(field.NewPath("spec"), testCase.spec),
+			)
+		})
+	}
+}
@@ -2,9 +2,14 @@ package promotion
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,8 +36,26 @@ var (
 	}
 )
 
+// WebhookConfig is configuration for the Promotion webhook.
+type WebhookConfig struct {
+	// APIServerUsername is the username with which the Kargo API server
+	// authenticates to Kubernetes. Only Promotions created by this user may
+	// specify on whose behalf they were created. If empty, the creator of every
+	// Promotion is recorded as the user who created it.
+	APIServerUsername string `envconfig:"API_SERVER_USERNAME"`
+}
+
+// WebhookConfigFromEnv returns a WebhookConfig populated from environment
+// variables.
+func WebhookConfigFromEnv() WebhookConfig {
+	cfg := WebhookConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 type webhook struct {
 	client client.Client
+	cfg    WebhookConfig
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -55,6 +78,11 @@ type webhook struct {
 		action string,
 	) error
 
+	validateStageLockFn func(
+		ctx context.Context,
+		promo *kargoapi.Promotion,
+	) error
+
 	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
 
 	createSubjectAccessReviewFn func(
@@ -64,8 +92,8 @@ type webhook struct {
 	) error
 }
 
-func SetupWebhookWithManager(mgr ctrl.Manager) error {
-	w := newWebhook(mgr.GetClient())
+func SetupWebhookWithManager(cfg WebhookConfig, mgr ctrl.Manager) error {
+	w := newWebhook(cfg, mgr.GetClient())
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Promotion{}).
 		WithDefaulter(w).
@@ -73,13 +101,15 @@ func SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
-func newWebhook(kubeClient client.Client) *webhook {
+func newWebhook(cfg WebhookConfig, kubeClient client.Client) *webhook {
 	w := &webhook{
 		client: kubeClient,
+		cfg:    cfg,
 	}
 	w.getStageFn = kargoapi.GetStage
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.authorizeFn = w.authorize
+	w.validateStageLockFn = w.validateStageLock
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.createSubjectAccessReviewFn = w.client.Create
 	return w
@@ -113,6 +143,28 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 	ownerRef :=
 		metav1.NewControllerRef(stage, kargoapi.GroupVersion.WithKind("Stage"))
 	promo.ObjectMeta.OwnerReferences = []metav1.OwnerReference{*ownerRef}
+
+	// Record who created the promo. Only the API server, which creates promos on
+	// behalf of its users, is trusted to have recorded this already. Anything
+	// else recorded by the creator is overwritten, since it ends up in commit
+	// trailers that are relied upon for auditing.
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil || req.Operation != admissionv1.Create {
+		return nil
+	}
+	if w.cfg.APIServerUsername != "" &&
+		req.UserInfo.Username == w.cfg.APIServerUsername &&
+		promo.Annotations[kargoapi.AnnotationKeyCreateActor] != "" {
+		return nil
+	}
+	if req.UserInfo.Username == "" {
+		delete(promo.Annotations, kargoapi.AnnotationKeyCreateActor)
+		return nil
+	}
+	if promo.Annotations == nil {
+		promo.Annotations = map[string]string{}
+	}
+	promo.Annotations[kargoapi.AnnotationKeyCreateActor] = req.UserInfo.Username
 	return nil
 }
 
@@ -125,7 +177,10 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, promotionGroupKind, promo); err != nil {
 		return err
 	}
-	return w.authorizeFn(ctx, promo, "create")
+	if err := w.authorizeFn(ctx, promo, "create"); err != nil {
+		return err
+	}
+	return w.validateStageLockFn(ctx, promo)
 }
 
 func (w *webhook) ValidateUpdate(
@@ -138,8 +193,10 @@ func (w *webhook) ValidateUpdate(
 		return err
 	}
 
-	// PromotionSpecs are meant to be immutable
-	if *promo.Spec != *(oldObj.(*kargoapi.Promotion).Spec) { // nolint: forcetypeassert
+	// PromotionSpecs are meant to be immutable. They contain pointers, so they
+	// must be compared semantically rather than with ==.
+	oldPromo := oldObj.(*kargoapi.Promotion) // nolint: forcetypeassert
+	if !equality.Semantic.DeepEqual(promo.Spec, oldPromo.Spec) {
 		return apierrors.NewInvalid(
 			promotionGroupKind,
 			promo.Name,
@@ -152,6 +209,24 @@ func (w *webhook) ValidateUpdate(
 			},
 		)
 	}
+
+	// The creator of a promo is recorded only once, when it is created
+	if oldActor, newActor :=
+		oldPromo.Annotations[kargoapi.AnnotationKeyCreateActor],
+		promo.Annotations[kargoapi.AnnotationKeyCreateActor]; newActor != oldActor {
+		return apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("metadata", "annotations").
+						Key(kargoapi.AnnotationKeyCreateActor),
+					newActor,
+					"annotation is immutable",
+				),
+			},
+		)
+	}
 	return nil
 }
 
@@ -167,6 +242,75 @@ func (w *webhook) authorize(
 	ctx context.Context,
 	promo *kargoapi.Promotion,
 	action string,
+) error {
+	return w.authorizeVerb(
+		ctx,
+		promo,
+		"promote",
+		action,
+		fmt.Sprintf("%s Promotions for Stage %q", action, promo.Spec.Stage),
+	)
+}
+
+// validateStageLock returns an error if the Stage referenced by the provided
+// Promotion is locked, unless the Promotion requests that the lock be
+// overridden and the subject creating it is permitted to do so.
+func (w *webhook) validateStageLock(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	stage, err := w.getStageFn(
+		ctx,
+		w.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Stage %q in namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
+	}
+	if stage == nil || !stage.Spec.Lock.IsActive(time.Now()) {
+		return nil
+	}
+	if _, ok := promo.Annotations[kargoapi.AnnotationKeyOverrideStageLock]; !ok {
+		lock := stage.Spec.Lock
+		msg := fmt.Sprintf("Stage %q is locked", stage.Name)
+		if lock.By != "" {
+			msg = fmt.Sprintf("%s by %q", msg, lock.By)
+		}
+		if lock.Until != nil {
+			msg = fmt.Sprintf("%s until %s", msg, lock.Until.Format(time.RFC3339))
+		}
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			errors.Errorf("%s: %s", msg, lock.Reason),
+		)
+	}
+	return w.authorizeVerb(
+		ctx,
+		promo,
+		"override-lock",
+		"create",
+		fmt.Sprintf("override the lock on Stage %q", promo.Spec.Stage),
+	)
+}
+
+// authorizeVerb returns an error if the subject of the admission request
+// bound to the provided context is not permitted the specified verb on the
+// Stage referenced by the provided Promotion.
+func (w *webhook) authorizeVerb(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	verb string,
+	action string,
+	permission string,
 ) error {
 	logger := logging.LoggerFromContext(ctx)
 
@@ -192,7 +336,7 @@ func (w *webhook) authorize(
 				Group:     kargoapi.GroupVersion.Group,
 				Resource:  "stages",
 				Name:      promo.Spec.Stage,
-				Verb:      "promote",
+				Verb:      verb,
 				Namespace: promo.Namespace,
 			},
 		},
@@ -214,10 +358,9 @@ func (w *webhook) authorize(
 			promotionGroupResource,
 			promo.Name,
 			errors.Errorf(
-				"subject %q is not permitted to %s Promotions for Stage %q",
+				"subject %q is not permitted to %s",
 				req.UserInfo.Username,
-				action,
-				promo.Spec.Stage,
+				permission,
 			),
 		)
 	}
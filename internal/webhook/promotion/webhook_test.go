@@ -3,9 +3,12 @@ package promotion
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authnv1 "k8s.io/api/authentication/v1"
 	authzv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,11 +22,12 @@ import (
 
 func TestNewWebhook(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
-	w := newWebhook(kubeClient)
+	w := newWebhook(WebhookConfig{}, kubeClient)
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.getStageFn)
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.authorizeFn)
+	require.NotNil(t, w.validateStageLockFn)
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.createSubjectAccessReviewFn)
 }
@@ -31,6 +35,7 @@ func TestNewWebhook(t *testing.T) {
 func TestDefault(t *testing.T) {
 	testCases := []struct {
 		name       string
+		promo      *kargoapi.Promotion
 		webhook    *webhook
 		assertions func(*kargoapi.Promotion, error)
 	}{
@@ -76,19 +81,189 @@ func TestDefault(t *testing.T) {
 				) (*kargoapi.Stage, error) {
 					return &kargoapi.Stage{}, nil
 				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
 			},
 			assertions: func(promo *kargoapi.Promotion, err error) {
 				require.NoError(t, err)
 				require.NotEmpty(t, promo.OwnerReferences)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+				)
+			},
+		},
+		{
+			name: "create actor recorded by API server",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyCreateActor: "api-user",
+					},
+				},
+			},
+			webhook: &webhook{
+				cfg: WebhookConfig{
+					APIServerUsername: "system:serviceaccount:kargo:kargo-api",
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: "system:serviceaccount:kargo:kargo-api",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"api-user",
+					promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+				)
+			},
+		},
+		{
+			name: "spoofed create actor",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyCreateActor: "api-user",
+					},
+				},
+			},
+			webhook: &webhook{
+				cfg: WebhookConfig{
+					APIServerUsername: "system:serviceaccount:kargo:kargo-api",
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+				)
+			},
+		},
+		{
+			name: "spoofed create actor without API server configured",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyCreateActor: "api-user",
+					},
+				},
+			},
+			webhook: &webhook{
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Create,
+							UserInfo: authnv1.UserInfo{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+				)
+			},
+		},
+		{
+			name: "create actor not recorded on update",
+			webhook: &webhook{
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Update,
+							UserInfo: authnv1.UserInfo{
+								Username: "fake-user",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Empty(t, promo.Annotations[kargoapi.AnnotationKeyCreateActor])
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			promo := &kargoapi.Promotion{
-				Spec: &kargoapi.PromotionSpec{
-					Stage: "fake-stage",
-				},
+			promo := testCase.promo
+			if promo == nil {
+				promo = &kargoapi.Promotion{}
+			}
+			promo.Spec = &kargoapi.PromotionSpec{
+				Stage: "fake-stage",
 			}
 			err := testCase.webhook.Default(context.Background(), promo)
 			testCase.assertions(promo, err)
@@ -139,6 +314,29 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "Stage is locked",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				validateStageLockFn: func(context.Context, *kargoapi.Promotion) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -153,6 +351,9 @@ func TestValidateCreate(t *testing.T) {
 				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
 					return nil
 				},
+				validateStageLockFn: func(context.Context, *kargoapi.Promotion) error {
+					return nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -223,6 +424,127 @@ func TestValidateUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "attempt to mutate artifact selector",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+						Artifacts: &kargoapi.ArtifactSelector{
+							Include: &kargoapi.ArtifactReferences{
+								Images: []string{"fake-image"},
+							},
+						},
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Spec.Artifacts.Include.Images = []string{"another-fake-image"}
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "spec is immutable")
+			},
+		},
+
+		{
+			name: "annotate promotion with artifact selector",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+						Artifacts: &kargoapi.ArtifactSelector{
+							Include: &kargoapi.ArtifactReferences{
+								Images: []string{"fake-image"},
+							},
+						},
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Annotations = map[string]string{
+					kargoapi.AnnotationKeyAbort: kargoapi.LabelTrueValue,
+				}
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+
+		{
+			name: "attempt to change create actor",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyCreateActor: "fake-user",
+						},
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Annotations[kargoapi.AnnotationKeyCreateActor] = "another-user"
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "\"fake-name\" is invalid")
+				require.Contains(t, err.Error(), "annotation is immutable")
+			},
+		},
+
+		{
+			name: "attempt to add create actor",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Annotations = map[string]string{
+					kargoapi.AnnotationKeyCreateActor: "fake-user",
+				}
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "annotation is immutable")
+			},
+		},
+
 		{
 			name: "update without mutation",
 			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
@@ -414,3 +736,180 @@ func TestAuthorize(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStageLock(t *testing.T) {
+	lockedStage := &kargoapi.Stage{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "fake-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			Lock: &kargoapi.StageLock{
+				By:     "alice",
+				Reason: "incident in progress",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		promo      *kargoapi.Promotion
+		getStageFn func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Stage, error)
+		createSubjectAccessReviewFn func(
+			context.Context,
+			client.Object,
+			...client.CreateOption,
+		) error
+		assertions func(error)
+	}{
+		{
+			name: "error getting Stage",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error finding Stage")
+			},
+		},
+		{
+			name: "Stage is not locked",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Spec: &kargoapi.StageSpec{},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Stage lock has expired",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{
+					Spec: &kargoapi.StageSpec{
+						Lock: &kargoapi.StageLock{
+							Until: &v1.Time{Time: time.Now().Add(-time.Hour)},
+						},
+					},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Stage is locked",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return lockedStage, nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					`Stage "fake-stage" is locked by "alice": incident in progress`,
+				)
+			},
+		},
+		{
+			name: "Stage is locked and subject may not override",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyOverrideStageLock: "true",
+					},
+				},
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return lockedStage, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				require.Equal(t, "override-lock", review.Spec.ResourceAttributes.Verb)
+				review.Status.Allowed = false
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not permitted to override the lock")
+			},
+		},
+		{
+			name: "Stage is locked and subject may override",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyOverrideStageLock: "true",
+					},
+				},
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return lockedStage, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				obj.(*authzv1.SubjectAccessReview).Status.Allowed = true // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				getStageFn: testCase.getStageFn,
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{}, nil
+				},
+				createSubjectAccessReviewFn: testCase.createSubjectAccessReviewFn,
+			}
+			promo := testCase.promo
+			if promo == nil {
+				promo = &kargoapi.Promotion{}
+			}
+			promo.Spec = &kargoapi.PromotionSpec{
+				Stage: "fake-stage",
+			}
+			testCase.assertions(w.validateStageLock(context.Background(), promo))
+		})
+	}
+}
@@ -3,23 +3,37 @@ package stage
 import (
 	"context"
 	"fmt"
+	"strings"
+	"text/template"
 
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
+// validatePath is the path at which the webhook is served. It must match the
+// path in the chart's ValidatingWebhookConfiguration.
+const validatePath = "/validate-kargo-akuity-io-v1alpha1-stage"
+
 var (
 	stageGroupKind = schema.GroupKind{
 		Group: kargoapi.GroupVersion.Group,
 		Kind:  "Stage",
 	}
+	stageGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "Stage",
+	}
 )
 
 type webhook struct {
@@ -36,15 +50,43 @@ type webhook struct {
 
 	validateCreateOrUpdateFn func(*kargoapi.Stage) error
 
+	validateSubscriptionGraphFn func(context.Context, *kargoapi.Stage) error
+
+	validateHookServiceAccountsFn func(context.Context, *kargoapi.Stage) error
+
+	checkStageSubscriptionsFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Stage,
+		...client.Object,
+	) (kargo.StageSubscriptionIssues, error)
+
 	validateSpecFn func(*field.Path, *kargoapi.StageSpec) field.ErrorList
+
+	isNamespaceTerminatingFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
+	listDependantsFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Stage,
+	) ([]string, error)
 }
 
 func SetupWebhookWithManager(mgr ctrl.Manager) error {
 	w := newWebhook(mgr.GetClient())
-	return ctrl.NewWebhookManagedBy(mgr).
-		For(&kargoapi.Stage{}).
-		WithValidator(w).
-		Complete()
+	// This webhook is registered directly instead of using the webhook builder
+	// so that it can return admission warnings.
+	mgr.GetWebhookServer().Register(
+		validatePath,
+		libWebhook.WithWarnings(
+			admission.WithCustomValidator(&kargoapi.Stage{}, w),
+		),
+	)
+	return nil
 }
 
 func newWebhook(kubeClient client.Client) *webhook {
@@ -54,6 +96,11 @@ func newWebhook(kubeClient client.Client) *webhook {
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
 	w.validateSpecFn = w.validateSpec
+	w.validateSubscriptionGraphFn = w.validateSubscriptionGraph
+	w.validateHookServiceAccountsFn = w.validateHookServiceAccounts
+	w.checkStageSubscriptionsFn = kargo.CheckStageSubscriptions
+	w.isNamespaceTerminatingFn = libWebhook.IsNamespaceTerminating
+	w.listDependantsFn = kargo.ListStageDependants
 	return w
 }
 
@@ -66,21 +113,51 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, stageGroupKind, stage); err != nil {
 		return err
 	}
-	return w.validateCreateOrUpdateFn(stage)
+	if err := w.validateCreateOrUpdateFn(stage); err != nil {
+		return err
+	}
+	if err := w.validateHookServiceAccountsFn(ctx, stage); err != nil {
+		return err
+	}
+	return w.validateSubscriptionGraphFn(ctx, stage)
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) error {
 	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	return w.validateCreateOrUpdateFn(stage)
+	if err := w.validateCreateOrUpdateFn(stage); err != nil {
+		return err
+	}
+	if err := w.validateHookServiceAccountsFn(ctx, stage); err != nil {
+		return err
+	}
+	return w.validateSubscriptionGraphFn(ctx, stage)
 }
 
-func (w *webhook) ValidateDelete(context.Context, runtime.Object) error {
-	// No-op
-	return nil
+func (w *webhook) ValidateDelete(
+	ctx context.Context,
+	obj runtime.Object,
+) error {
+	stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
+	if libWebhook.IsDeleteForced(stage) {
+		return nil
+	}
+	terminating, err :=
+		w.isNamespaceTerminatingFn(ctx, w.client, stage.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if terminating {
+		return nil
+	}
+	dependants, err := w.listDependantsFn(ctx, w.client, stage)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	return libWebhook.NewInUseError(stageGroupResource, stage.Name, dependants)
 }
 
 func (w *webhook) validateCreateOrUpdate(e *kargoapi.Stage) error {
@@ -90,6 +167,117 @@ func (w *webhook) validateCreateOrUpdate(e *kargoapi.Stage) error {
 	return nil
 }
 
+// validateHookServiceAccounts rejects the provided Stage if any of its
+// promotion hooks specifies a ServiceAccount for its Job that is not listed in
+// the kargo.akuity.io/hook-service-accounts annotation of the Stage's project.
+// Hooks that do not specify a ServiceAccount run as the namespace's default
+// ServiceAccount and are always permitted.
+func (w *webhook) validateHookServiceAccounts(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	if stage.Spec == nil || stage.Spec.PromotionMechanisms == nil {
+		return nil
+	}
+	mechsPath := field.NewPath("spec", "promotionMechanisms")
+	type hookServiceAccount struct {
+		path *field.Path
+		name string
+	}
+	var serviceAccounts []hookServiceAccount
+	for _, hooks := range []struct {
+		path  *field.Path
+		hooks []kargoapi.PromotionHook
+	}{
+		{mechsPath.Child("preHooks"), stage.Spec.PromotionMechanisms.PreHooks},
+		{mechsPath.Child("postHooks"), stage.Spec.PromotionMechanisms.PostHooks},
+	} {
+		for i, hook := range hooks.hooks {
+			podSpecPath := hooks.path.Index(i).
+				Child("template", "spec", "template", "spec")
+			podSpec := hook.Template.Spec.Template.Spec
+			if podSpec.ServiceAccountName != "" {
+				serviceAccounts = append(serviceAccounts, hookServiceAccount{
+					path: podSpecPath.Child("serviceAccountName"),
+					name: podSpec.ServiceAccountName,
+				})
+			}
+			if podSpec.DeprecatedServiceAccount != "" {
+				serviceAccounts = append(serviceAccounts, hookServiceAccount{
+					path: podSpecPath.Child("serviceAccount"),
+					name: podSpec.DeprecatedServiceAccount,
+				})
+			}
+		}
+	}
+	if len(serviceAccounts) == 0 {
+		return nil
+	}
+	ns := &corev1.Namespace{}
+	if err := w.client.Get(ctx, client.ObjectKey{Name: stage.Namespace}, ns); err != nil {
+		return apierrors.NewInternalError(
+			errors.Wrapf(err, "error getting namespace %q", stage.Namespace),
+		)
+	}
+	permitted := map[string]struct{}{}
+	for _, name := range strings.Split(
+		ns.Annotations[kargoapi.AnnotationKeyHookServiceAccounts],
+		",",
+	) {
+		if name = strings.TrimSpace(name); name != "" {
+			permitted[name] = struct{}{}
+		}
+	}
+	var errs field.ErrorList
+	for _, sa := range serviceAccounts {
+		if _, ok := permitted[sa.name]; !ok {
+			errs = append(
+				errs,
+				field.Forbidden(
+					sa.path,
+					fmt.Sprintf(
+						"ServiceAccount %q is not permitted for hooks in project %q; "+
+							"permitted ServiceAccounts are listed in the %s annotation "+
+							"of the project's namespace",
+						sa.name,
+						stage.Namespace,
+						kargoapi.AnnotationKeyHookServiceAccounts,
+					),
+				),
+			)
+		}
+	}
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(stageGroupKind, stage.Name, errs)
+	}
+	return nil
+}
+
+// validateSubscriptionGraph rejects the provided Stage if its subscriptions
+// would introduce a cycle into its project's pipeline graph and warns of any
+// subscriptions to Warehouses or upstream Stages that do not exist.
+func (w *webhook) validateSubscriptionGraph(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	issues, err := w.checkStageSubscriptionsFn(ctx, w.client, stage)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	libWebhook.AddWarnings(ctx, issues.Warnings...)
+	if err = issues.CycleError(); err != nil {
+		f := field.NewPath("spec", "subscriptions", "upstreamStages")
+		return apierrors.NewInvalid(
+			stageGroupKind,
+			stage.Name,
+			field.ErrorList{
+				field.Invalid(f, stage.Spec.Subscriptions.UpstreamStages, err.Error()),
+			},
+		)
+	}
+	return nil
+}
+
 func (w *webhook) validateSpec(
 	f *field.Path,
 	spec *kargoapi.StageSpec,
@@ -98,12 +286,39 @@ func (w *webhook) validateSpec(
 		return nil
 	}
 	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
-	return append(
+	errs = append(
 		errs,
 		w.validatePromotionMechanisms(
 			f.Child("promotionMechanisms"),
 			spec.PromotionMechanisms)...,
 	)
+	if spec.MinimumSoakDuration != nil && spec.MinimumSoakDuration.Duration < 0 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("minimumSoakDuration"),
+				spec.MinimumSoakDuration.Duration.String(),
+				fmt.Sprintf(
+					"%s must not be negative",
+					f.Child("minimumSoakDuration").String(),
+				),
+			),
+		)
+	}
+	if spec.AutoRollback != nil && spec.AutoRollback.Window.Duration <= 0 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("autoRollback", "window"),
+				spec.AutoRollback.Window.Duration.String(),
+				fmt.Sprintf(
+					"%s must be positive",
+					f.Child("autoRollback", "window").String(),
+				),
+			),
+		)
+	}
+	return errs
 }
 
 func (w *webhook) validateSubs(
@@ -128,6 +343,63 @@ func (w *webhook) validateSubs(
 			),
 		}
 	}
+	return w.validateQualificationRule(
+		f.Child("qualificationRule"),
+		subs.QualificationRule,
+		len(subs.UpstreamStages),
+	)
+}
+
+func (w *webhook) validateQualificationRule(
+	f *field.Path,
+	rule *kargoapi.QualificationRule,
+	upstreamStages int,
+) field.ErrorList {
+	if rule == nil {
+		return nil
+	}
+	if upstreamStages == 0 {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				rule,
+				fmt.Sprintf(
+					"%s may only be defined when subscribing to upstream Stages",
+					f.String(),
+				),
+			),
+		}
+	}
+	if rule.Type != kargoapi.QualificationRuleTypeQuorum {
+		if rule.Quorum != 0 {
+			return field.ErrorList{
+				field.Invalid(
+					f.Child("quorum"),
+					rule.Quorum,
+					fmt.Sprintf(
+						"%s may only be defined when %s is %q",
+						f.Child("quorum").String(),
+						f.Child("type").String(),
+						kargoapi.QualificationRuleTypeQuorum,
+					),
+				),
+			}
+		}
+		return nil
+	}
+	if rule.Quorum < 1 || int(rule.Quorum) > upstreamStages {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("quorum"),
+				rule.Quorum,
+				fmt.Sprintf(
+					"%s must be between 1 and the number of upstream Stages (%d)",
+					f.Child("quorum").String(),
+					upstreamStages,
+				),
+			),
+		}
+	}
 	return nil
 }
 
@@ -154,10 +426,17 @@ func (w *webhook) validatePromotionMechanisms(
 			),
 		}
 	}
-	return w.validateGitRepoUpdates(
+	errs := w.validateGitRepoUpdates(
 		f.Child("gitRepoUpdates"),
 		promoMechs.GitRepoUpdates,
 	)
+	return append(
+		errs,
+		w.validateArgoCDAppUpdates(
+			f.Child("argoCDAppUpdates"),
+			promoMechs.ArgoCDAppUpdates,
+		)...,
+	)
 }
 
 func (w *webhook) validateGitRepoUpdates(
@@ -200,7 +479,80 @@ func (w *webhook) validateGitRepoUpdate(
 			),
 		}
 	}
-	return w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	if update.Render != nil && update.Commit != nil {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("commit"),
+				update.Commit,
+				fmt.Sprintf(
+					"%s.commit may not be defined when %s.render is defined; Kargo "+
+						"Render makes its own commits",
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	errs := w.validateGitCommitOptions(f.Child("commit"), update.Commit)
+	return append(
+		errs,
+		w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)...,
+	)
+}
+
+func (w *webhook) validateGitCommitOptions(
+	f *field.Path,
+	opts *kargoapi.GitCommitOptions,
+) field.ErrorList {
+	if opts == nil || opts.MessageTemplate == "" {
+		return nil
+	}
+	if _, err := template.New("commit").Parse(opts.MessageTemplate); err != nil {
+		return field.ErrorList{
+			field.Invalid(f.Child("messageTemplate"), opts.MessageTemplate, err.Error()),
+		}
+	}
+	return nil
+}
+
+func (w *webhook) validateArgoCDAppUpdates(
+	f *field.Path,
+	updates []kargoapi.ArgoCDAppUpdate,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, update := range updates {
+		for j, srcUpdate := range update.SourceUpdates {
+			errs = append(
+				errs,
+				w.validateArgoCDSourceUpdate(
+					f.Index(i).Child("sourceUpdates").Index(j),
+					srcUpdate,
+				)...,
+			)
+		}
+	}
+	return errs
+}
+
+func (w *webhook) validateArgoCDSourceUpdate(
+	f *field.Path,
+	update kargoapi.ArgoCDSourceUpdate,
+) field.ErrorList {
+	// A specific source may be addressed by index XOR by ref
+	if update.SourceIndex != nil && update.Ref != "" {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				update,
+				fmt.Sprintf(
+					"no more than one of %s.sourceIndex or %s.ref may be defined",
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	return nil
 }
 
 func (w *webhook) validateHelmPromotionMechanism(
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
 )
 
 func TestNewWebhook(t *testing.T) {
@@ -21,6 +27,11 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.validateSubscriptionGraphFn)
+	require.NotNil(t, w.validateHookServiceAccountsFn)
+	require.NotNil(t, w.checkStageSubscriptionsFn)
+	require.NotNil(t, w.isNamespaceTerminatingFn)
+	require.NotNil(t, w.listDependantsFn)
 }
 
 func TestValidateCreate(t *testing.T) {
@@ -66,6 +77,64 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "error validating hook ServiceAccounts",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+					return nil
+				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error validating subscription graph",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+					return nil
+				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return nil
+				},
+				validateSubscriptionGraphFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -80,6 +149,18 @@ func TestValidateCreate(t *testing.T) {
 				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
 					return nil
 				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return nil
+				},
+				validateSubscriptionGraphFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -116,12 +197,66 @@ func TestValidateUpdate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "error validating hook ServiceAccounts",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+					return nil
+				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error validating subscription graph",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+					return nil
+				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return nil
+				},
+				validateSubscriptionGraphFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
 				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
 					return nil
 				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return nil
+				},
+				validateSubscriptionGraphFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) error {
+					return nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -142,8 +277,140 @@ func TestValidateUpdate(t *testing.T) {
 }
 
 func TestValidateDelete(t *testing.T) {
-	w := &webhook{}
-	require.NoError(t, w.ValidateDelete(context.Background(), nil))
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		forced     bool
+		assertions func(error)
+	}{
+		{
+			name:    "deletion forced",
+			webhook: &webhook{},
+			forced:  true,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error checking namespace",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "namespace terminating",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error listing dependants",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+				) ([]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "in use",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+				) ([]string, error) {
+					return []string{"something depends on it"}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				require.Contains(t, err.Error(), "something depends on it")
+				require.Contains(t, err.Error(), kargoapi.AnnotationKeyForceDelete)
+			},
+		},
+		{
+			name: "not in use",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+				) ([]string, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+			}
+			if testCase.forced {
+				stage.Annotations = map[string]string{
+					kargoapi.AnnotationKeyForceDelete: kargoapi.LabelTrueValue,
+				}
+			}
+			testCase.assertions(
+				testCase.webhook.ValidateDelete(context.Background(), stage),
+			)
+		})
+	}
 }
 
 func TestValidateCreateOrUpdate(t *testing.T) {
@@ -190,6 +457,202 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateSubscriptionGraph(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{Name: "fake-stage"},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(error)
+	}{
+		{
+			name: "error checking subscriptions",
+			webhook: &webhook{
+				checkStageSubscriptionsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					...client.Object,
+				) (kargo.StageSubscriptionIssues, error) {
+					return kargo.StageSubscriptionIssues{},
+						errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsInternalError(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "cycle",
+			webhook: &webhook{
+				checkStageSubscriptionsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					...client.Object,
+				) (kargo.StageSubscriptionIssues, error) {
+					return kargo.StageSubscriptionIssues{
+						Cycle: []string{"fake-stage", "fake-stage"},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsInvalid(err))
+				require.Contains(
+					t,
+					err.Error(),
+					"subscriptions would introduce a cycle: fake-stage -> fake-stage",
+				)
+			},
+		},
+		{
+			name: "warnings only",
+			webhook: &webhook{
+				checkStageSubscriptionsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					...client.Object,
+				) (kargo.StageSubscriptionIssues, error) {
+					return kargo.StageSubscriptionIssues{
+						Warnings: []string{"something is missing"},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.webhook.validateSubscriptionGraph(
+					context.Background(),
+					testStage,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHookServiceAccounts(t *testing.T) {
+	newStage := func(preHookSA, postHookSA string) *kargoapi.Stage {
+		newHook := func(sa string) kargoapi.PromotionHook {
+			hook := kargoapi.PromotionHook{Name: "fake-hook"}
+			hook.Template.Spec.Template.Spec.ServiceAccountName = sa
+			return hook
+		}
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-stage",
+			},
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					PreHooks:  []kargoapi.PromotionHook{newHook(preHookSA)},
+					PostHooks: []kargoapi.PromotionHook{newHook(postHookSA)},
+				},
+			},
+		}
+	}
+	newProject := func(serviceAccounts string) *corev1.Namespace {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-project",
+			},
+		}
+		if serviceAccounts != "" {
+			ns.Annotations = map[string]string{
+				kargoapi.AnnotationKeyHookServiceAccounts: serviceAccounts,
+			}
+		}
+		return ns
+	}
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		objects    []client.Object
+		assertions func(error)
+	}{
+		{
+			name:  "no ServiceAccounts specified",
+			stage: newStage("", ""),
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "error getting project",
+			stage: newStage("fake-sa", ""),
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting namespace")
+			},
+		},
+		{
+			name:    "no ServiceAccounts permitted",
+			stage:   newStage("fake-sa", ""),
+			objects: []client.Object{newProject("")},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsInvalid(err))
+				require.Contains(
+					t,
+					err.Error(),
+					"spec.promotionMechanisms.preHooks[0].template.spec.template.spec."+
+						"serviceAccountName",
+				)
+				require.Contains(t, err.Error(), `ServiceAccount "fake-sa" is not permitted`)
+			},
+		},
+		{
+			name:    "ServiceAccount not permitted",
+			stage:   newStage("fake-sa", "other-sa"),
+			objects: []client.Object{newProject("fake-sa, another-sa")},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "postHooks[0]")
+				require.Contains(t, err.Error(), `ServiceAccount "other-sa" is not permitted`)
+				require.NotContains(t, err.Error(), `"fake-sa"`)
+			},
+		},
+		{
+			name:    "ServiceAccounts permitted",
+			stage:   newStage("fake-sa", "another-sa"),
+			objects: []client.Object{newProject("fake-sa, another-sa")},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			testCase.assertions(
+				w.validateHookServiceAccounts(context.Background(), testCase.stage),
+			)
+		})
+	}
+}
+
 func TestValidateSpec(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -215,6 +678,10 @@ func TestValidateSpec(t *testing.T) {
 				},
 				// Doesn't actually define any mechanisms...
 				PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				// Negative soak duration...
+				MinimumSoakDuration: &metav1.Duration{Duration: -time.Minute},
+				// Non-positive auto-rollback window...
+				AutoRollback: &kargoapi.AutoRollback{},
 			},
 			assertions: func(spec *kargoapi.StageSpec, errs field.ErrorList) {
 				// We really want to see that all underlying errors have been bubbled up
@@ -237,6 +704,18 @@ func TestValidateSpec(t *testing.T) {
 								"spec.promotionMechanisms.gitRepoUpdates or " +
 								"spec.promotionMechanisms.argoCDAppUpdates must be non-empty",
 						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.minimumSoakDuration",
+							BadValue: "-1m0s",
+							Detail:   "spec.minimumSoakDuration must not be negative",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.autoRollback.window",
+							BadValue: "0s",
+							Detail:   "spec.autoRollback.window must be positive",
+						},
 					},
 					errs,
 				)
@@ -351,6 +830,117 @@ func TestValidateSubs(t *testing.T) {
 	}
 }
 
+func TestValidateQualificationRule(t *testing.T) {
+	testCases := []struct {
+		name           string
+		rule           *kargoapi.QualificationRule
+		upstreamStages int
+		assertions     func(*kargoapi.QualificationRule, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(_ *kargoapi.QualificationRule, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "no upstream Stages",
+			rule: &kargoapi.QualificationRule{
+				Type: kargoapi.QualificationRuleTypeAll,
+			},
+			assertions: func(rule *kargoapi.QualificationRule, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "qualificationRule",
+							BadValue: rule,
+							Detail: "qualificationRule may only be defined when " +
+								"subscribing to upstream Stages",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "quorum specified for non-quorum rule",
+			rule: &kargoapi.QualificationRule{
+				Type:   kargoapi.QualificationRuleTypeAll,
+				Quorum: 2,
+			},
+			upstreamStages: 3,
+			assertions: func(_ *kargoapi.QualificationRule, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "qualificationRule.quorum",
+							BadValue: int32(2),
+							Detail: "qualificationRule.quorum may only be defined when " +
+								`qualificationRule.type is "Quorum"`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "quorum exceeds number of upstream Stages",
+			rule: &kargoapi.QualificationRule{
+				Type:   kargoapi.QualificationRuleTypeQuorum,
+				Quorum: 4,
+			},
+			upstreamStages: 3,
+			assertions: func(_ *kargoapi.QualificationRule, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "qualificationRule.quorum",
+							BadValue: int32(4),
+							Detail: "qualificationRule.quorum must be between 1 and the " +
+								"number of upstream Stages (3)",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "success",
+			rule: &kargoapi.QualificationRule{
+				Type:   kargoapi.QualificationRuleTypeQuorum,
+				Quorum: 2,
+			},
+			upstreamStages: 3,
+			assertions: func(_ *kargoapi.QualificationRule, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.rule,
+				w.validateQualificationRule(
+					field.NewPath("qualificationRule"),
+					testCase.rule,
+					testCase.upstreamStages,
+				),
+			)
+		})
+	}
+}
+
 func TestValidatePromotionMechanisms(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -504,11 +1094,50 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 				)
 			},
 		},
-
+		{
+			name: "commit options with Kargo Render",
+			update: kargoapi.GitRepoUpdate{
+				Render: &kargoapi.KargoRenderPromotionMechanism{},
+				Commit: &kargoapi.GitCommitOptions{
+					AuthorName: "Kargo Bot",
+				},
+			},
+			assertions: func(update kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "gitRepoUpdate.commit",
+							BadValue: update.Commit,
+							Detail: "gitRepoUpdate.commit may not be defined when " +
+								"gitRepoUpdate.render is defined; Kargo Render makes its " +
+								"own commits",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "invalid commit message template",
+			update: kargoapi.GitRepoUpdate{
+				Commit: &kargoapi.GitCommitOptions{
+					MessageTemplate: "{{ .Freight.ID",
+				},
+			},
+			assertions: func(_ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "gitRepoUpdate.commit.messageTemplate", errs[0].Field)
+			},
+		},
 		{
 			name: "valid",
 			update: kargoapi.GitRepoUpdate{
 				Kustomize: &kargoapi.KustomizePromotionMechanism{},
+				Commit: &kargoapi.GitCommitOptions{
+					MessageTemplate: "Promote {{ .Freight.ID }}",
+				},
 			},
 			assertions: func(_ kargoapi.GitRepoUpdate, errs field.ErrorList) {
 				require.Nil(t, errs)
@@ -529,6 +1158,88 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateArgoCDAppUpdates(t *testing.T) {
+	updates := []kargoapi.ArgoCDAppUpdate{
+		{
+			SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+				{},
+				{
+					SourceIndex: pointer.Int32(0),
+					Ref:         "values",
+				},
+			},
+		},
+	}
+	w := &webhook{}
+	require.Equal(
+		t,
+		field.ErrorList{
+			{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "argoCDAppUpdates[0].sourceUpdates[1]",
+				BadValue: updates[0].SourceUpdates[1],
+				Detail: "no more than one of " +
+					"argoCDAppUpdates[0].sourceUpdates[1].sourceIndex or " +
+					"argoCDAppUpdates[0].sourceUpdates[1].ref may be defined",
+			},
+		},
+		w.validateArgoCDAppUpdates(field.NewPath("argoCDAppUpdates"), updates),
+	)
+}
+
+func TestValidateArgoCDSourceUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.ArgoCDSourceUpdate
+		assertions func(kargoapi.ArgoCDSourceUpdate, field.ErrorList)
+	}{
+		{
+			name: "both sourceIndex and ref specified",
+			update: kargoapi.ArgoCDSourceUpdate{
+				SourceIndex: pointer.Int32(0),
+				Ref:         "values",
+			},
+			assertions: func(update kargoapi.ArgoCDSourceUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "sourceUpdate",
+							BadValue: update,
+							Detail: "no more than one of sourceUpdate.sourceIndex or " +
+								"sourceUpdate.ref may be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			update: kargoapi.ArgoCDSourceUpdate{
+				Ref: "values",
+			},
+			assertions: func(_ kargoapi.ArgoCDSourceUpdate, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.update,
+				w.validateArgoCDSourceUpdate(
+					field.NewPath("sourceUpdate"),
+					testCase.update,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateHelmPromotionMechanism(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -14,13 +14,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
-var warehouseGroupKind = schema.GroupKind{
-	Group: kargoapi.GroupVersion.Group,
-	Kind:  "Warehouse",
-}
+var (
+	warehouseGroupKind = schema.GroupKind{
+		Group: kargoapi.GroupVersion.Group,
+		Kind:  "Warehouse",
+	}
+	warehouseGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "Warehouse",
+	}
+)
 
 type webhook struct {
 	client client.Client
@@ -37,6 +44,18 @@ type webhook struct {
 	validateCreateOrUpdateFn func(*kargoapi.Warehouse) error
 
 	validateSpecFn func(*field.Path, *kargoapi.WarehouseSpec) field.ErrorList
+
+	isNamespaceTerminatingFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (bool, error)
+
+	listDependantsFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Warehouse,
+	) ([]string, error)
 }
 
 func SetupWebhookWithManager(mgr ctrl.Manager) error {
@@ -54,6 +73,8 @@ func newWebhook(kubeClient client.Client) *webhook {
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
 	w.validateSpecFn = w.validateSpec
+	w.isNamespaceTerminatingFn = libWebhook.IsNamespaceTerminating
+	w.listDependantsFn = kargo.ListWarehouseDependants
 	return w
 }
 
@@ -82,9 +103,27 @@ func (w *webhook) ValidateUpdate(
 	return w.validateCreateOrUpdateFn(warehouse)
 }
 
-func (w *webhook) ValidateDelete(context.Context, runtime.Object) error {
-	// No-op
-	return nil
+func (w *webhook) ValidateDelete(
+	ctx context.Context,
+	obj runtime.Object,
+) error {
+	warehouse := obj.(*kargoapi.Warehouse) // nolint: forcetypeassert
+	if libWebhook.IsDeleteForced(warehouse) {
+		return nil
+	}
+	terminating, err :=
+		w.isNamespaceTerminatingFn(ctx, w.client, warehouse.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if terminating {
+		return nil
+	}
+	dependants, err := w.listDependantsFn(ctx, w.client, warehouse)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	return libWebhook.NewInUseError(warehouseGroupResource, warehouse.Name, dependants)
 }
 
 func (w *webhook) validateCreateOrUpdate(warehouse *kargoapi.Warehouse) error {
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,6 +23,8 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.isNamespaceTerminatingFn)
+	require.NotNil(t, w.listDependantsFn)
 }
 
 func TestValidateCreate(t *testing.T) {
@@ -142,8 +146,140 @@ func TestValidateUpdate(t *testing.T) {
 }
 
 func TestValidateDelete(t *testing.T) {
-	w := &webhook{}
-	require.NoError(t, w.ValidateDelete(context.Background(), nil))
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		forced     bool
+		assertions func(error)
+	}{
+		{
+			name:    "deletion forced",
+			webhook: &webhook{},
+			forced:  true,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error checking namespace",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "namespace terminating",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error listing dependants",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Warehouse,
+				) ([]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "in use",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Warehouse,
+				) ([]string, error) {
+					return []string{"something depends on it"}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				require.Contains(t, err.Error(), "something depends on it")
+				require.Contains(t, err.Error(), kargoapi.AnnotationKeyForceDelete)
+			},
+		},
+		{
+			name: "not in use",
+			webhook: &webhook{
+				isNamespaceTerminatingFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				listDependantsFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Warehouse,
+				) ([]string, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warehouse := &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-warehouse",
+					Namespace: "fake-namespace",
+				},
+			}
+			if testCase.forced {
+				warehouse.Annotations = map[string]string{
+					kargoapi.AnnotationKeyForceDelete: kargoapi.LabelTrueValue,
+				}
+			}
+			testCase.assertions(
+				testCase.webhook.ValidateDelete(context.Background(), warehouse),
+			)
+		})
+	}
 }
 
 func TestValidateCreateOrUpdate(t *testing.T) {
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type warningsContextKey struct{}
+
+type warnings struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+// WithWarnings wraps the handler of the provided admission webhook so that any
+// warnings recorded using AddWarnings while handling a request are returned to
+// the client along with the admission response. The provided webhook is
+// returned for convenience.
+func WithWarnings(wh *admission.Webhook) *admission.Webhook {
+	wh.Handler = &warningsHandler{handler: wh.Handler}
+	return wh
+}
+
+// AddWarnings records admission warnings to be returned to the client. It is a
+// no-op if the provided context did not originate from a webhook wrapped using
+// WithWarnings.
+func AddWarnings(ctx context.Context, messages ...string) {
+	w, ok := ctx.Value(warningsContextKey{}).(*warnings)
+	if !ok || len(messages) == 0 {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.messages = append(w.messages, messages...)
+}
+
+type warningsHandler struct {
+	handler admission.Handler
+}
+
+func (h *warningsHandler) Handle(
+	ctx context.Context,
+	req admission.Request,
+) admission.Response {
+	w := &warnings{}
+	res := h.handler.Handle(context.WithValue(ctx, warningsContextKey{}, w), req)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.messages) == 0 {
+		return res
+	}
+	return res.WithWarnings(w.messages...)
+}
+
+// InjectDecoder passes the provided decoder through to the wrapped handler.
+func (h *warningsHandler) InjectDecoder(d *admission.Decoder) error {
+	_, err := admission.InjectDecoderInto(d, h.handler)
+	return err
+}
@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestWithWarnings(t *testing.T) {
+	wh := WithWarnings(&admission.Webhook{
+		Handler: admission.HandlerFunc(
+			func(ctx context.Context, _ admission.Request) admission.Response {
+				AddWarnings(ctx, "first warning")
+				AddWarnings(ctx, "second warning")
+				return admission.Allowed("")
+			},
+		),
+	})
+	res := wh.Handler.Handle(context.Background(), admission.Request{})
+	require.True(t, res.Allowed)
+	require.Equal(
+		t,
+		[]string{"first warning", "second warning"},
+		res.Warnings,
+	)
+}
+
+func TestAddWarningsWithoutHandler(t *testing.T) {
+	require.NotPanics(t, func() {
+		AddWarnings(context.Background(), "warning")
+	})
+}
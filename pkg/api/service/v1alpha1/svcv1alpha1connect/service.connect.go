@@ -74,12 +74,26 @@ const (
 	// KargoServicePromoteStageProcedure is the fully-qualified name of the KargoService's PromoteStage
 	// RPC.
 	KargoServicePromoteStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteStage"
+	// KargoServicePreviewPromotionProcedure is the fully-qualified name of the KargoService's
+	// PreviewPromotion RPC.
+	KargoServicePreviewPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PreviewPromotion"
 	// KargoServicePromoteSubscribersProcedure is the fully-qualified name of the KargoService's
 	// PromoteSubscribers RPC.
 	KargoServicePromoteSubscribersProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteSubscribers"
+	// KargoServicePromoteStagesProcedure is the fully-qualified name of the KargoService's
+	// PromoteStages RPC.
+	KargoServicePromoteStagesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteStages"
 	// KargoServiceRefreshStageProcedure is the fully-qualified name of the KargoService's RefreshStage
 	// RPC.
 	KargoServiceRefreshStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RefreshStage"
+	// KargoServiceLockStageProcedure is the fully-qualified name of the KargoService's LockStage RPC.
+	KargoServiceLockStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/LockStage"
+	// KargoServiceUnlockStageProcedure is the fully-qualified name of the KargoService's UnlockStage
+	// RPC.
+	KargoServiceUnlockStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UnlockStage"
+	// KargoServiceExplainStageProcedure is the fully-qualified name of the KargoService's ExplainStage
+	// RPC.
+	KargoServiceExplainStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ExplainStage"
 	// KargoServiceListPromotionsProcedure is the fully-qualified name of the KargoService's
 	// ListPromotions RPC.
 	KargoServiceListPromotionsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListPromotions"
@@ -92,6 +106,9 @@ const (
 	// KargoServiceWatchPromotionProcedure is the fully-qualified name of the KargoService's
 	// WatchPromotion RPC.
 	KargoServiceWatchPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WatchPromotion"
+	// KargoServiceAbortPromotionProcedure is the fully-qualified name of the KargoService's
+	// AbortPromotion RPC.
+	KargoServiceAbortPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AbortPromotion"
 	// KargoServiceSetAutoPromotionForStageProcedure is the fully-qualified name of the KargoService's
 	// SetAutoPromotionForStage RPC.
 	KargoServiceSetAutoPromotionForStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/SetAutoPromotionForStage"
@@ -119,6 +136,9 @@ const (
 	// KargoServiceDeleteProjectProcedure is the fully-qualified name of the KargoService's
 	// DeleteProject RPC.
 	KargoServiceDeleteProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteProject"
+	// KargoServiceGetProjectGraphProcedure is the fully-qualified name of the KargoService's
+	// GetProjectGraph RPC.
+	KargoServiceGetProjectGraphProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetProjectGraph"
 	// KargoServiceQueryFreightProcedure is the fully-qualified name of the KargoService's QueryFreight
 	// RPC.
 	KargoServiceQueryFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight"
@@ -161,13 +181,19 @@ type KargoServiceClient interface {
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
+	PreviewPromotion(context.Context, *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error)
 	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
+	PromoteStages(context.Context, *connect.Request[v1alpha1.PromoteStagesRequest]) (*connect.ServerStreamForClient[v1alpha1.PromoteStagesResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
+	LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error)
+	UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error)
+	ExplainStage(context.Context, *connect.Request[v1alpha1.ExplainStageRequest]) (*connect.Response[v1alpha1.ExplainStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionsResponse], error)
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
+	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
 	CreatePromotionPolicy(context.Context, *connect.Request[v1alpha1.CreatePromotionPolicyRequest]) (*connect.Response[v1alpha1.CreatePromotionPolicyResponse], error)
 	ListPromotionPolicies(context.Context, *connect.Request[v1alpha1.ListPromotionPoliciesRequest]) (*connect.Response[v1alpha1.ListPromotionPoliciesResponse], error)
@@ -177,6 +203,7 @@ type KargoServiceClient interface {
 	CreateProject(context.Context, *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
+	GetProjectGraph(context.Context, *connect.Request[v1alpha1.GetProjectGraphRequest]) (*connect.Response[v1alpha1.GetProjectGraphResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
@@ -271,16 +298,41 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServicePromoteStageProcedure,
 			opts...,
 		),
+		previewPromotion: connect.NewClient[v1alpha1.PreviewPromotionRequest, v1alpha1.PreviewPromotionResponse](
+			httpClient,
+			baseURL+KargoServicePreviewPromotionProcedure,
+			opts...,
+		),
 		promoteSubscribers: connect.NewClient[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse](
 			httpClient,
 			baseURL+KargoServicePromoteSubscribersProcedure,
 			opts...,
 		),
+		promoteStages: connect.NewClient[v1alpha1.PromoteStagesRequest, v1alpha1.PromoteStagesResponse](
+			httpClient,
+			baseURL+KargoServicePromoteStagesProcedure,
+			opts...,
+		),
 		refreshStage: connect.NewClient[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse](
 			httpClient,
 			baseURL+KargoServiceRefreshStageProcedure,
 			opts...,
 		),
+		lockStage: connect.NewClient[v1alpha1.LockStageRequest, v1alpha1.LockStageResponse](
+			httpClient,
+			baseURL+KargoServiceLockStageProcedure,
+			opts...,
+		),
+		unlockStage: connect.NewClient[v1alpha1.UnlockStageRequest, v1alpha1.UnlockStageResponse](
+			httpClient,
+			baseURL+KargoServiceUnlockStageProcedure,
+			opts...,
+		),
+		explainStage: connect.NewClient[v1alpha1.ExplainStageRequest, v1alpha1.ExplainStageResponse](
+			httpClient,
+			baseURL+KargoServiceExplainStageProcedure,
+			opts...,
+		),
 		listPromotions: connect.NewClient[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse](
 			httpClient,
 			baseURL+KargoServiceListPromotionsProcedure,
@@ -301,6 +353,11 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceWatchPromotionProcedure,
 			opts...,
 		),
+		abortPromotion: connect.NewClient[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse](
+			httpClient,
+			baseURL+KargoServiceAbortPromotionProcedure,
+			opts...,
+		),
 		setAutoPromotionForStage: connect.NewClient[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse](
 			httpClient,
 			baseURL+KargoServiceSetAutoPromotionForStageProcedure,
@@ -346,6 +403,11 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceDeleteProjectProcedure,
 			opts...,
 		),
+		getProjectGraph: connect.NewClient[v1alpha1.GetProjectGraphRequest, v1alpha1.GetProjectGraphResponse](
+			httpClient,
+			baseURL+KargoServiceGetProjectGraphProcedure,
+			opts...,
+		),
 		queryFreight: connect.NewClient[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse](
 			httpClient,
 			baseURL+KargoServiceQueryFreightProcedure,
@@ -401,12 +463,18 @@ type kargoServiceClient struct {
 	updateStage              *connect.Client[v1alpha1.UpdateStageRequest, v1alpha1.UpdateStageResponse]
 	deleteStage              *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
 	promoteStage             *connect.Client[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse]
+	previewPromotion         *connect.Client[v1alpha1.PreviewPromotionRequest, v1alpha1.PreviewPromotionResponse]
 	promoteSubscribers       *connect.Client[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse]
+	promoteStages            *connect.Client[v1alpha1.PromoteStagesRequest, v1alpha1.PromoteStagesResponse]
 	refreshStage             *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
+	lockStage                *connect.Client[v1alpha1.LockStageRequest, v1alpha1.LockStageResponse]
+	unlockStage              *connect.Client[v1alpha1.UnlockStageRequest, v1alpha1.UnlockStageResponse]
+	explainStage             *connect.Client[v1alpha1.ExplainStageRequest, v1alpha1.ExplainStageResponse]
 	listPromotions           *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
 	watchPromotions          *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
 	getPromotion             *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
 	watchPromotion           *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
+	abortPromotion           *connect.Client[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse]
 	setAutoPromotionForStage *connect.Client[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse]
 	createPromotionPolicy    *connect.Client[v1alpha1.CreatePromotionPolicyRequest, v1alpha1.CreatePromotionPolicyResponse]
 	listPromotionPolicies    *connect.Client[v1alpha1.ListPromotionPoliciesRequest, v1alpha1.ListPromotionPoliciesResponse]
@@ -416,6 +484,7 @@ type kargoServiceClient struct {
 	createProject            *connect.Client[v1alpha1.CreateProjectRequest, v1alpha1.CreateProjectResponse]
 	listProjects             *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
 	deleteProject            *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
+	getProjectGraph          *connect.Client[v1alpha1.GetProjectGraphRequest, v1alpha1.GetProjectGraphResponse]
 	queryFreight             *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
 	listWarehouses           *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
 	getWarehouse             *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
@@ -501,16 +570,41 @@ func (c *kargoServiceClient) PromoteStage(ctx context.Context, req *connect.Requ
 	return c.promoteStage.CallUnary(ctx, req)
 }
 
+// PreviewPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.PreviewPromotion.
+func (c *kargoServiceClient) PreviewPromotion(ctx context.Context, req *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error) {
+	return c.previewPromotion.CallUnary(ctx, req)
+}
+
 // PromoteSubscribers calls akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers.
 func (c *kargoServiceClient) PromoteSubscribers(ctx context.Context, req *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error) {
 	return c.promoteSubscribers.CallUnary(ctx, req)
 }
 
+// PromoteStages calls akuity.io.kargo.service.v1alpha1.KargoService.PromoteStages.
+func (c *kargoServiceClient) PromoteStages(ctx context.Context, req *connect.Request[v1alpha1.PromoteStagesRequest]) (*connect.ServerStreamForClient[v1alpha1.PromoteStagesResponse], error) {
+	return c.promoteStages.CallServerStream(ctx, req)
+}
+
 // RefreshStage calls akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage.
 func (c *kargoServiceClient) RefreshStage(ctx context.Context, req *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error) {
 	return c.refreshStage.CallUnary(ctx, req)
 }
 
+// LockStage calls akuity.io.kargo.service.v1alpha1.KargoService.LockStage.
+func (c *kargoServiceClient) LockStage(ctx context.Context, req *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error) {
+	return c.lockStage.CallUnary(ctx, req)
+}
+
+// UnlockStage calls akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage.
+func (c *kargoServiceClient) UnlockStage(ctx context.Context, req *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error) {
+	return c.unlockStage.CallUnary(ctx, req)
+}
+
+// ExplainStage calls akuity.io.kargo.service.v1alpha1.KargoService.ExplainStage.
+func (c *kargoServiceClient) ExplainStage(ctx context.Context, req *connect.Request[v1alpha1.ExplainStageRequest]) (*connect.Response[v1alpha1.ExplainStageResponse], error) {
+	return c.explainStage.CallUnary(ctx, req)
+}
+
 // ListPromotions calls akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions.
 func (c *kargoServiceClient) ListPromotions(ctx context.Context, req *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error) {
 	return c.listPromotions.CallUnary(ctx, req)
@@ -531,6 +625,11 @@ func (c *kargoServiceClient) WatchPromotion(ctx context.Context, req *connect.Re
 	return c.watchPromotion.CallServerStream(ctx, req)
 }
 
+// AbortPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion.
+func (c *kargoServiceClient) AbortPromotion(ctx context.Context, req *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error) {
+	return c.abortPromotion.CallUnary(ctx, req)
+}
+
 // SetAutoPromotionForStage calls
 // akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage.
 func (c *kargoServiceClient) SetAutoPromotionForStage(ctx context.Context, req *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error) {
@@ -577,6 +676,11 @@ func (c *kargoServiceClient) DeleteProject(ctx context.Context, req *connect.Req
 	return c.deleteProject.CallUnary(ctx, req)
 }
 
+// GetProjectGraph calls akuity.io.kargo.service.v1alpha1.KargoService.GetProjectGraph.
+func (c *kargoServiceClient) GetProjectGraph(ctx context.Context, req *connect.Request[v1alpha1.GetProjectGraphRequest]) (*connect.Response[v1alpha1.GetProjectGraphResponse], error) {
+	return c.getProjectGraph.CallUnary(ctx, req)
+}
+
 // QueryFreight calls akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight.
 func (c *kargoServiceClient) QueryFreight(ctx context.Context, req *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return c.queryFreight.CallUnary(ctx, req)
@@ -632,13 +736,19 @@ type KargoServiceHandler interface {
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
+	PreviewPromotion(context.Context, *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error)
 	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
+	PromoteStages(context.Context, *connect.Request[v1alpha1.PromoteStagesRequest], *connect.ServerStream[v1alpha1.PromoteStagesResponse]) error
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
+	LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error)
+	UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error)
+	ExplainStage(context.Context, *connect.Request[v1alpha1.ExplainStageRequest]) (*connect.Response[v1alpha1.ExplainStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest], *connect.ServerStream[v1alpha1.WatchPromotionsResponse]) error
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
+	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
 	CreatePromotionPolicy(context.Context, *connect.Request[v1alpha1.CreatePromotionPolicyRequest]) (*connect.Response[v1alpha1.CreatePromotionPolicyResponse], error)
 	ListPromotionPolicies(context.Context, *connect.Request[v1alpha1.ListPromotionPoliciesRequest]) (*connect.Response[v1alpha1.ListPromotionPoliciesResponse], error)
@@ -648,6 +758,7 @@ type KargoServiceHandler interface {
 	CreateProject(context.Context, *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
+	GetProjectGraph(context.Context, *connect.Request[v1alpha1.GetProjectGraphRequest]) (*connect.Response[v1alpha1.GetProjectGraphResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
@@ -738,16 +849,41 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.PromoteStage,
 		opts...,
 	)
+	kargoServicePreviewPromotionHandler := connect.NewUnaryHandler(
+		KargoServicePreviewPromotionProcedure,
+		svc.PreviewPromotion,
+		opts...,
+	)
 	kargoServicePromoteSubscribersHandler := connect.NewUnaryHandler(
 		KargoServicePromoteSubscribersProcedure,
 		svc.PromoteSubscribers,
 		opts...,
 	)
+	kargoServicePromoteStagesHandler := connect.NewServerStreamHandler(
+		KargoServicePromoteStagesProcedure,
+		svc.PromoteStages,
+		opts...,
+	)
 	kargoServiceRefreshStageHandler := connect.NewUnaryHandler(
 		KargoServiceRefreshStageProcedure,
 		svc.RefreshStage,
 		opts...,
 	)
+	kargoServiceLockStageHandler := connect.NewUnaryHandler(
+		KargoServiceLockStageProcedure,
+		svc.LockStage,
+		opts...,
+	)
+	kargoServiceUnlockStageHandler := connect.NewUnaryHandler(
+		KargoServiceUnlockStageProcedure,
+		svc.UnlockStage,
+		opts...,
+	)
+	kargoServiceExplainStageHandler := connect.NewUnaryHandler(
+		KargoServiceExplainStageProcedure,
+		svc.ExplainStage,
+		opts...,
+	)
 	kargoServiceListPromotionsHandler := connect.NewUnaryHandler(
 		KargoServiceListPromotionsProcedure,
 		svc.ListPromotions,
@@ -768,6 +904,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.WatchPromotion,
 		opts...,
 	)
+	kargoServiceAbortPromotionHandler := connect.NewUnaryHandler(
+		KargoServiceAbortPromotionProcedure,
+		svc.AbortPromotion,
+		opts...,
+	)
 	kargoServiceSetAutoPromotionForStageHandler := connect.NewUnaryHandler(
 		KargoServiceSetAutoPromotionForStageProcedure,
 		svc.SetAutoPromotionForStage,
@@ -813,6 +954,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.DeleteProject,
 		opts...,
 	)
+	kargoServiceGetProjectGraphHandler := connect.NewUnaryHandler(
+		KargoServiceGetProjectGraphProcedure,
+		svc.GetProjectGraph,
+		opts...,
+	)
 	kargoServiceQueryFreightHandler := connect.NewUnaryHandler(
 		KargoServiceQueryFreightProcedure,
 		svc.QueryFreight,
@@ -880,10 +1026,20 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteStageHandler.ServeHTTP(w, r)
 		case KargoServicePromoteStageProcedure:
 			kargoServicePromoteStageHandler.ServeHTTP(w, r)
+		case KargoServicePreviewPromotionProcedure:
+			kargoServicePreviewPromotionHandler.ServeHTTP(w, r)
 		case KargoServicePromoteSubscribersProcedure:
 			kargoServicePromoteSubscribersHandler.ServeHTTP(w, r)
+		case KargoServicePromoteStagesProcedure:
+			kargoServicePromoteStagesHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshStageProcedure:
 			kargoServiceRefreshStageHandler.ServeHTTP(w, r)
+		case KargoServiceLockStageProcedure:
+			kargoServiceLockStageHandler.ServeHTTP(w, r)
+		case KargoServiceUnlockStageProcedure:
+			kargoServiceUnlockStageHandler.ServeHTTP(w, r)
+		case KargoServiceExplainStageProcedure:
+			kargoServiceExplainStageHandler.ServeHTTP(w, r)
 		case KargoServiceListPromotionsProcedure:
 			kargoServiceListPromotionsHandler.ServeHTTP(w, r)
 		case KargoServiceWatchPromotionsProcedure:
@@ -892,6 +1048,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceWatchPromotionProcedure:
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceAbortPromotionProcedure:
+			kargoServiceAbortPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceSetAutoPromotionForStageProcedure:
 			kargoServiceSetAutoPromotionForStageHandler.ServeHTTP(w, r)
 		case KargoServiceCreatePromotionPolicyProcedure:
@@ -910,6 +1068,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceListProjectsHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteProjectProcedure:
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
+		case KargoServiceGetProjectGraphProcedure:
+			kargoServiceGetProjectGraphHandler.ServeHTTP(w, r)
 		case KargoServiceQueryFreightProcedure:
 			kargoServiceQueryFreightHandler.ServeHTTP(w, r)
 		case KargoServiceListWarehousesProcedure:
@@ -993,14 +1153,34 @@ func (UnimplementedKargoServiceHandler) PromoteStage(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) PreviewPromotion(context.Context, *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PreviewPromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) PromoteStages(context.Context, *connect.Request[v1alpha1.PromoteStagesRequest], *connect.ServerStream[v1alpha1.PromoteStagesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteStages is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.LockStage is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ExplainStage(context.Context, *connect.Request[v1alpha1.ExplainStageRequest]) (*connect.Response[v1alpha1.ExplainStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ExplainStage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions is not implemented"))
 }
@@ -1017,6 +1197,10 @@ func (UnimplementedKargoServiceHandler) WatchPromotion(context.Context, *connect
 	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WatchPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage is not implemented"))
 }
@@ -1053,6 +1237,10 @@ func (UnimplementedKargoServiceHandler) DeleteProject(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) GetProjectGraph(context.Context, *connect.Request[v1alpha1.GetProjectGraphRequest]) (*connect.Response[v1alpha1.GetProjectGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetProjectGraph is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight is not implemented"))
 }
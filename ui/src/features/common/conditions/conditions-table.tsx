@@ -0,0 +1,57 @@
+import { Table, Tag } from 'antd';
+import { ColumnsType } from 'antd/es/table';
+import { format } from 'date-fns';
+
+import { Condition } from '@ui/gen/metav1/types_pb';
+
+const statusColor = (condition: Condition) => {
+  switch (condition.status) {
+    case 'True':
+      // These conditions are abnormal when True
+      return ['Stalled', 'Drifted', 'AutoPromotionBlocked'].includes(condition.type || '')
+        ? 'error'
+        : 'success';
+    case 'False':
+      return 'default';
+    default:
+      return 'warning';
+  }
+};
+
+export const ConditionsTable = ({ conditions }: { conditions?: Condition[] }) => {
+  const columns: ColumnsType<Condition> = [
+    {
+      title: 'Type',
+      dataIndex: 'type'
+    },
+    {
+      title: 'Status',
+      render: (_, condition) => <Tag color={statusColor(condition)}>{condition.status}</Tag>
+    },
+    {
+      title: 'Reason',
+      dataIndex: 'reason'
+    },
+    {
+      title: 'Message',
+      dataIndex: 'message'
+    },
+    {
+      title: 'Last Transition',
+      render: (_, condition) => {
+        const date = condition.lastTransitionTime?.toDate();
+        return date ? format(date, 'MMM do yyyy HH:mm:ss') : '';
+      }
+    }
+  ];
+
+  return (
+    <Table
+      columns={columns}
+      dataSource={conditions || []}
+      size='small'
+      pagination={false}
+      rowKey={(c) => c.type || ''}
+    />
+  );
+};
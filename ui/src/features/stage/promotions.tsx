@@ -1,9 +1,11 @@
 import { createPromiseClient } from '@bufbuild/connect';
 import {
+  faBan,
   faCircleCheck,
   faCircleExclamation,
   faCircleNotch,
-  faCircleQuestion
+  faCircleQuestion,
+  faForward
 } from '@fortawesome/free-solid-svg-icons';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
 import { useQuery, useQueryClient } from '@tanstack/react-query';
@@ -112,10 +114,28 @@ export const Promotions = () => {
                 />
               </Popover>
             );
+          case 'Aborted':
+            return (
+              <Tooltip title='Aborted' placement='right'>
+                <FontAwesomeIcon color='#aaa' icon={faBan} size='lg' />
+              </Tooltip>
+            );
+          case 'Superseded':
+            return (
+              <Popover content={promotion.status.error} title='Superseded' placement='right'>
+                <FontAwesomeIcon color='#aaa' icon={faForward} size='lg' />
+              </Popover>
+            );
           case 'Pending':
           case 'Running':
             return (
-              <Tooltip title={promotion.status?.phase} placement='right'>
+              <Tooltip
+                title={
+                  promotion.status?.conditions?.find((c) => c.type === 'Reconciling')?.message ||
+                  promotion.status?.phase
+                }
+                placement='right'
+              >
                 <FontAwesomeIcon icon={faCircleNotch} spin size='lg' />
               </Tooltip>
             );
@@ -2,6 +2,7 @@ import { Divider, Drawer, Tabs, Typography } from 'antd';
 import { generatePath, useNavigate, useParams } from 'react-router-dom';
 
 import { paths } from '@ui/config/paths';
+import { ConditionsTable } from '@ui/features/common/conditions/conditions-table';
 import { HealthStatusIcon } from '@ui/features/common/health-status/health-status-icon';
 import { Subscriptions } from '@ui/features/stage/subscriptions';
 import { Stage } from '@ui/gen/v1alpha1/types_pb';
@@ -39,6 +40,16 @@ export const StageDetails = ({ stage }: { stage: Stage }) => {
 
           <div className='flex flex-col gap-8 flex-1'>
             <Subscriptions subscriptions={stage.spec?.subscriptions} projectName={projectName} />
+            {stage.status?.lastAutoPromotionDecision && (
+              <Typography.Text type='secondary'>
+                Last auto-promotion decision:{' '}
+                <Typography.Text strong>
+                  {stage.status.lastAutoPromotionDecision.reason}
+                </Typography.Text>
+                {stage.status.lastAutoPromotionDecision.message &&
+                  ` - ${stage.status.lastAutoPromotionDecision.message}`}
+              </Typography.Text>
+            )}
             <Tabs
               className='flex-1'
               defaultActiveKey='1'
@@ -54,6 +65,11 @@ export const StageDetails = ({ stage }: { stage: Stage }) => {
                   label: 'Live Manifest',
                   className: 'h-full pb-2',
                   children: <ManifestPreview stage={stage} />
+                },
+                {
+                  key: '3',
+                  label: 'Conditions',
+                  children: <ConditionsTable conditions={stage.status?.conditions} />
                 }
               ]}
             />
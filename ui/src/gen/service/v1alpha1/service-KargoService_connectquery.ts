@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { MethodKind } from "@bufbuild/protobuf";
-import { AdminLoginRequest, AdminLoginResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
+import { AbortPromotionRequest, AbortPromotionResponse, AdminLoginRequest, AdminLoginResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, ExplainStageRequest, ExplainStageResponse, GetConfigRequest, GetConfigResponse, GetProjectGraphRequest, GetProjectGraphResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, LockStageRequest, LockStageResponse, PreviewPromotionRequest, PreviewPromotionResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UnlockStageRequest, UnlockStageResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
 
 export const typeName = "akuity.io.kargo.service.v1alpha1.KargoService";
 
@@ -250,6 +250,23 @@ export const promoteStage = createQueryService({
   },
 }).promoteStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PreviewPromotion
+ */
+export const previewPromotion = createQueryService({
+  service: {
+    methods: {
+      previewPromotion: {
+        name: "PreviewPromotion",
+        kind: MethodKind.Unary,
+        I: PreviewPromotionRequest,
+        O: PreviewPromotionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).previewPromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers
  */
@@ -284,6 +301,57 @@ export const refreshStage = createQueryService({
   },
 }).refreshStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.LockStage
+ */
+export const lockStage = createQueryService({
+  service: {
+    methods: {
+      lockStage: {
+        name: "LockStage",
+        kind: MethodKind.Unary,
+        I: LockStageRequest,
+        O: LockStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).lockStage;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage
+ */
+export const unlockStage = createQueryService({
+  service: {
+    methods: {
+      unlockStage: {
+        name: "UnlockStage",
+        kind: MethodKind.Unary,
+        I: UnlockStageRequest,
+        O: UnlockStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).unlockStage;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ExplainStage
+ */
+export const explainStage = createQueryService({
+  service: {
+    methods: {
+      explainStage: {
+        name: "ExplainStage",
+        kind: MethodKind.Unary,
+        I: ExplainStageRequest,
+        O: ExplainStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).explainStage;
+
 /**
  * Promotion APIs 
  *
@@ -320,6 +388,23 @@ export const getPromotion = createQueryService({
   },
 }).getPromotion;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion
+ */
+export const abortPromotion = createQueryService({
+  service: {
+    methods: {
+      abortPromotion: {
+        name: "AbortPromotion",
+        kind: MethodKind.Unary,
+        I: AbortPromotionRequest,
+        O: AbortPromotionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).abortPromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage
  */
@@ -473,6 +558,23 @@ export const deleteProject = createQueryService({
   },
 }).deleteProject;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetProjectGraph
+ */
+export const getProjectGraph = createQueryService({
+  service: {
+    methods: {
+      getProjectGraph: {
+        name: "GetProjectGraph",
+        kind: MethodKind.Unary,
+        I: GetProjectGraphRequest,
+        O: GetProjectGraphResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).getProjectGraph;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
  */
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
-import { Freight, Promotion, PromotionPolicy, Stage, StageSpec, Warehouse, WarehouseSpec } from "../../v1alpha1/types_pb.js";
+import { ArtifactSelector, AutoPromotionDecision, Freight, Promotion, PromotionPolicy, Stage, StageSpec, Warehouse, WarehouseSpec } from "../../v1alpha1/types_pb.js";
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ComponentVersions
@@ -687,6 +687,13 @@ export class CreateOrUpdateResourceRequest extends Message<CreateOrUpdateResourc
    */
   manifest = new Uint8Array(0);
 
+  /**
+   * dry_run validates the resources without persisting them.
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun = false;
+
   constructor(data?: PartialMessage<CreateOrUpdateResourceRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -696,6 +703,7 @@ export class CreateOrUpdateResourceRequest extends Message<CreateOrUpdateResourc
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateOrUpdateResourceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrUpdateResourceRequest {
@@ -742,6 +750,11 @@ export class CreateOrUpdateResourceResult extends Message<CreateOrUpdateResource
     case: "error";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * @generated from field: repeated string warnings = 4;
+   */
+  warnings: string[] = [];
+
   constructor(data?: PartialMessage<CreateOrUpdateResourceResult>) {
     super();
     proto3.util.initPartial(data, this);
@@ -753,6 +766,7 @@ export class CreateOrUpdateResourceResult extends Message<CreateOrUpdateResource
     { no: 1, name: "created_resource_manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "result" },
     { no: 2, name: "updated_resource_manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "result" },
     { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "result" },
+    { no: 4, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrUpdateResourceResult {
@@ -942,6 +956,16 @@ export class DeleteResourceRequest extends Message<DeleteResourceRequest> {
    */
   manifest = new Uint8Array(0);
 
+  /**
+   * @generated from field: bool cascade = 2;
+   */
+  cascade = false;
+
+  /**
+   * @generated from field: bool force = 3;
+   */
+  force = false;
+
   constructor(data?: PartialMessage<DeleteResourceRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -951,6 +975,8 @@ export class DeleteResourceRequest extends Message<DeleteResourceRequest> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteResourceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "manifest", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "cascade", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteResourceRequest {
@@ -1116,6 +1142,11 @@ export class CreateStageResponse extends Message<CreateStageResponse> {
    */
   stage?: Stage;
 
+  /**
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[] = [];
+
   constructor(data?: PartialMessage<CreateStageResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1125,6 +1156,7 @@ export class CreateStageResponse extends Message<CreateStageResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateStageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stage", kind: "message", T: Stage },
+    { no: 2, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateStageResponse {
@@ -1443,6 +1475,11 @@ export class UpdateStageResponse extends Message<UpdateStageResponse> {
    */
   stage?: Stage;
 
+  /**
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[] = [];
+
   constructor(data?: PartialMessage<UpdateStageResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1452,6 +1489,7 @@ export class UpdateStageResponse extends Message<UpdateStageResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateStageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stage", kind: "message", T: Stage },
+    { no: 2, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateStageResponse {
@@ -1485,6 +1523,16 @@ export class DeleteStageRequest extends Message<DeleteStageRequest> {
    */
   name = "";
 
+  /**
+   * @generated from field: bool cascade = 3;
+   */
+  cascade = false;
+
+  /**
+   * @generated from field: bool force = 4;
+   */
+  force = false;
+
   constructor(data?: PartialMessage<DeleteStageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1495,6 +1543,8 @@ export class DeleteStageRequest extends Message<DeleteStageRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cascade", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteStageRequest {
@@ -1515,11 +1565,14 @@ export class DeleteStageRequest extends Message<DeleteStageRequest> {
 }
 
 /**
- * explicitly empty 
- *
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteStageResponse
  */
 export class DeleteStageResponse extends Message<DeleteStageResponse> {
+  /**
+   * @generated from field: repeated string cascaded_stages = 1;
+   */
+  cascadedStages: string[] = [];
+
   constructor(data?: PartialMessage<DeleteStageResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1528,6 +1581,7 @@ export class DeleteStageResponse extends Message<DeleteStageResponse> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteStageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cascaded_stages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteStageResponse {
@@ -1566,6 +1620,16 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
    */
   freight = "";
 
+  /**
+   * @generated from field: bool override_lock = 4;
+   */
+  overrideLock = false;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactSelector artifacts = 5;
+   */
+  artifacts?: ArtifactSelector;
+
   constructor(data?: PartialMessage<PromoteStageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1577,6 +1641,8 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "override_lock", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "artifacts", kind: "message", T: ArtifactSelector, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteStageRequest {
@@ -1633,6 +1699,208 @@ export class PromoteStageResponse extends Message<PromoteStageResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PreviewPromotionRequest
+ */
+export class PreviewPromotionRequest extends Message<PreviewPromotionRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string stage = 2;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string freight = 3;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactSelector artifacts = 4;
+   */
+  artifacts?: ArtifactSelector;
+
+  constructor(data?: PartialMessage<PreviewPromotionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PreviewPromotionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "artifacts", kind: "message", T: ArtifactSelector, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewPromotionRequest {
+    return new PreviewPromotionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewPromotionRequest {
+    return new PreviewPromotionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewPromotionRequest {
+    return new PreviewPromotionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewPromotionRequest | PlainMessage<PreviewPromotionRequest> | undefined, b: PreviewPromotionRequest | PlainMessage<PreviewPromotionRequest> | undefined): boolean {
+    return proto3.util.equals(PreviewPromotionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PreviewPromotionResponse
+ */
+export class PreviewPromotionResponse extends Message<PreviewPromotionResponse> {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.GitRepoDiff git_repo_diffs = 1;
+   */
+  gitRepoDiffs: GitRepoDiff[] = [];
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ArgoCDAppPatch argocd_app_patches = 2;
+   */
+  argocdAppPatches: ArgoCDAppPatch[] = [];
+
+  constructor(data?: PartialMessage<PreviewPromotionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PreviewPromotionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "git_repo_diffs", kind: "message", T: GitRepoDiff, repeated: true },
+    { no: 2, name: "argocd_app_patches", kind: "message", T: ArgoCDAppPatch, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewPromotionResponse {
+    return new PreviewPromotionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewPromotionResponse {
+    return new PreviewPromotionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewPromotionResponse {
+    return new PreviewPromotionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewPromotionResponse | PlainMessage<PreviewPromotionResponse> | undefined, b: PreviewPromotionResponse | PlainMessage<PreviewPromotionResponse> | undefined): boolean {
+    return proto3.util.equals(PreviewPromotionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GitRepoDiff
+ */
+export class GitRepoDiff extends Message<GitRepoDiff> {
+  /**
+   * @generated from field: string mechanism = 1;
+   */
+  mechanism = "";
+
+  /**
+   * @generated from field: string repo_url = 2;
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string branch = 3;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: string diff = 4;
+   */
+  diff = "";
+
+  constructor(data?: PartialMessage<GitRepoDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GitRepoDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mechanism", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "diff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitRepoDiff {
+    return new GitRepoDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitRepoDiff {
+    return new GitRepoDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitRepoDiff {
+    return new GitRepoDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GitRepoDiff | PlainMessage<GitRepoDiff> | undefined, b: GitRepoDiff | PlainMessage<GitRepoDiff> | undefined): boolean {
+    return proto3.util.equals(GitRepoDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ArgoCDAppPatch
+ */
+export class ArgoCDAppPatch extends Message<ArgoCDAppPatch> {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string patch = 3;
+   */
+  patch = "";
+
+  constructor(data?: PartialMessage<ArgoCDAppPatch>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ArgoCDAppPatch";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "patch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppPatch {
+    return new ArgoCDAppPatch().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDAppPatch {
+    return new ArgoCDAppPatch().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDAppPatch {
+    return new ArgoCDAppPatch().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDAppPatch | PlainMessage<ArgoCDAppPatch> | undefined, b: ArgoCDAppPatch | PlainMessage<ArgoCDAppPatch> | undefined): boolean {
+    return proto3.util.equals(ArgoCDAppPatch, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.PromoteSubscribersRequest
  */
@@ -1687,115 +1955,601 @@ export class PromoteSubscribersRequest extends Message<PromoteSubscribersRequest
  */
 export class PromoteSubscribersResponse extends Message<PromoteSubscribersResponse> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Promotion promotions = 1;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Promotion promotions = 1;
+   */
+  promotions: Promotion[] = [];
+
+  /**
+   * locked_stages lists subscribers that were skipped because they are locked.
+   *
+   * @generated from field: repeated string locked_stages = 2;
+   */
+  lockedStages: string[] = [];
+
+  constructor(data?: PartialMessage<PromoteSubscribersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteSubscribersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "promotions", kind: "message", T: Promotion, repeated: true },
+    { no: 2, name: "locked_stages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteSubscribersResponse {
+    return new PromoteSubscribersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
+    return new PromoteSubscribersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
+    return new PromoteSubscribersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined, b: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined): boolean {
+    return proto3.util.equals(PromoteSubscribersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PromoteStagesRequest
+ */
+export class PromoteStagesRequest extends Message<PromoteStagesRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * selector is a label selector identifying the Stages to promote.
+   *
+   * @generated from field: string selector = 2;
+   */
+  selector = "";
+
+  /**
+   * @generated from field: string freight = 3;
+   */
+  freight = "";
+
+  /**
+   * batch_size is the maximum number of Stages promoted in each wave. Each
+   * wave's Promotions must complete before the next wave begins. If zero, all
+   * eligible Stages are promoted at once without waiting for completion.
+   *
+   * @generated from field: uint32 batch_size = 4;
+   */
+  batchSize = 0;
+
+  /**
+   * pause is how long to wait between waves, in Go duration format.
+   *
+   * @generated from field: string pause = 5;
+   */
+  pause = "";
+
+  /**
+   * halt_on_failure stops further waves once any Promotion in a wave fails.
+   *
+   * @generated from field: bool halt_on_failure = 6;
+   */
+  haltOnFailure = false;
+
+  constructor(data?: PartialMessage<PromoteStagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteStagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "selector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "batch_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "pause", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "halt_on_failure", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteStagesRequest {
+    return new PromoteStagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteStagesRequest {
+    return new PromoteStagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteStagesRequest {
+    return new PromoteStagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteStagesRequest | PlainMessage<PromoteStagesRequest> | undefined, b: PromoteStagesRequest | PlainMessage<PromoteStagesRequest> | undefined): boolean {
+    return proto3.util.equals(PromoteStagesRequest, a, b);
+  }
+}
+
+/**
+ * PromoteStagesResponse reports the outcome for a single Stage matched by the
+ * selector of a PromoteStagesRequest.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.PromoteStagesResponse
+ */
+export class PromoteStagesResponse extends Message<PromoteStagesResponse> {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage = "";
+
+  /**
+   * wave is the 1-based wave in which the Stage was promoted. It is zero for
+   * Stages that were skipped.
+   *
+   * @generated from field: uint32 wave = 2;
+   */
+  wave = 0;
+
+  /**
+   * @generated from field: string outcome = 3;
+   */
+  outcome = "";
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Promotion promotion = 5;
+   */
+  promotion?: Promotion;
+
+  constructor(data?: PartialMessage<PromoteStagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteStagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "wave", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "outcome", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "promotion", kind: "message", T: Promotion, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteStagesResponse {
+    return new PromoteStagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteStagesResponse {
+    return new PromoteStagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteStagesResponse {
+    return new PromoteStagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteStagesResponse | PlainMessage<PromoteStagesResponse> | undefined, b: PromoteStagesResponse | PlainMessage<PromoteStagesResponse> | undefined): boolean {
+    return proto3.util.equals(PromoteStagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageRequest
+ */
+export class RefreshStageRequest extends Message<RefreshStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<RefreshStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshStageRequest {
+    return new RefreshStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshStageRequest {
+    return new RefreshStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshStageRequest {
+    return new RefreshStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined, b: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined): boolean {
+    return proto3.util.equals(RefreshStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageResponse
+ */
+export class RefreshStageResponse extends Message<RefreshStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<RefreshStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshStageResponse {
+    return new RefreshStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshStageResponse {
+    return new RefreshStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshStageResponse {
+    return new RefreshStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshStageResponse | PlainMessage<RefreshStageResponse> | undefined, b: RefreshStageResponse | PlainMessage<RefreshStageResponse> | undefined): boolean {
+    return proto3.util.equals(RefreshStageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.LockStageRequest
+ */
+export class LockStageRequest extends Message<LockStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp until = 4;
+   */
+  until?: Timestamp;
+
+  constructor(data?: PartialMessage<LockStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.LockStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "until", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockStageRequest | PlainMessage<LockStageRequest> | undefined, b: LockStageRequest | PlainMessage<LockStageRequest> | undefined): boolean {
+    return proto3.util.equals(LockStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.LockStageResponse
+ */
+export class LockStageResponse extends Message<LockStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<LockStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.LockStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockStageResponse | PlainMessage<LockStageResponse> | undefined, b: LockStageResponse | PlainMessage<LockStageResponse> | undefined): boolean {
+    return proto3.util.equals(LockStageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnlockStageRequest
+ */
+export class UnlockStageRequest extends Message<UnlockStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UnlockStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnlockStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlockStageRequest | PlainMessage<UnlockStageRequest> | undefined, b: UnlockStageRequest | PlainMessage<UnlockStageRequest> | undefined): boolean {
+    return proto3.util.equals(UnlockStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnlockStageResponse
+ */
+export class UnlockStageResponse extends Message<UnlockStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<UnlockStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnlockStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlockStageResponse | PlainMessage<UnlockStageResponse> | undefined, b: UnlockStageResponse | PlainMessage<UnlockStageResponse> | undefined): boolean {
+    return proto3.util.equals(UnlockStageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExplainStageRequest
+ */
+export class ExplainStageRequest extends Message<ExplainStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
    */
-  promotions: Promotion[] = [];
+  name = "";
 
-  constructor(data?: PartialMessage<PromoteSubscribersResponse>) {
+  constructor(data?: PartialMessage<ExplainStageRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PromoteSubscribersResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExplainStageRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "promotions", kind: "message", T: Promotion, repeated: true },
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteSubscribersResponse {
-    return new PromoteSubscribersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainStageRequest {
+    return new ExplainStageRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
-    return new PromoteSubscribersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainStageRequest {
+    return new ExplainStageRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteSubscribersResponse {
-    return new PromoteSubscribersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainStageRequest {
+    return new ExplainStageRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined, b: PromoteSubscribersResponse | PlainMessage<PromoteSubscribersResponse> | undefined): boolean {
-    return proto3.util.equals(PromoteSubscribersResponse, a, b);
+  static equals(a: ExplainStageRequest | PlainMessage<ExplainStageRequest> | undefined, b: ExplainStageRequest | PlainMessage<ExplainStageRequest> | undefined): boolean {
+    return proto3.util.equals(ExplainStageRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExplainStageResponse
  */
-export class RefreshStageRequest extends Message<RefreshStageRequest> {
+export class ExplainStageResponse extends Message<ExplainStageResponse> {
   /**
-   * @generated from field: string project = 1;
+   * last_decision is the most recent auto-promotion decision recorded by the
+   * controller for the Stage, if any.
+   *
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionDecision last_decision = 1;
    */
-  project = "";
+  lastDecision?: AutoPromotionDecision;
 
   /**
-   * @generated from field: string name = 2;
+   * blocker, if set, describes why the Stage cannot currently be
+   * auto-promoted regardless of what Freight is available to it.
+   *
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionDecision blocker = 2;
    */
-  name = "";
+  blocker?: AutoPromotionDecision;
 
-  constructor(data?: PartialMessage<RefreshStageRequest>) {
+  /**
+   * candidates lists the Freight available to the Stage, newest first, with
+   * an explanation of whether each is eligible for auto-promotion.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.FreightEligibility candidates = 3;
+   */
+  candidates: FreightEligibility[] = [];
+
+  constructor(data?: PartialMessage<ExplainStageResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExplainStageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "last_decision", kind: "message", T: AutoPromotionDecision, opt: true },
+    { no: 2, name: "blocker", kind: "message", T: AutoPromotionDecision, opt: true },
+    { no: 3, name: "candidates", kind: "message", T: FreightEligibility, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshStageRequest {
-    return new RefreshStageRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainStageResponse {
+    return new ExplainStageResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshStageRequest {
-    return new RefreshStageRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainStageResponse {
+    return new ExplainStageResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshStageRequest {
-    return new RefreshStageRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainStageResponse {
+    return new ExplainStageResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined, b: RefreshStageRequest | PlainMessage<RefreshStageRequest> | undefined): boolean {
-    return proto3.util.equals(RefreshStageRequest, a, b);
+  static equals(a: ExplainStageResponse | PlainMessage<ExplainStageResponse> | undefined, b: ExplainStageResponse | PlainMessage<ExplainStageResponse> | undefined): boolean {
+    return proto3.util.equals(ExplainStageResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightEligibility
  */
-export class RefreshStageResponse extends Message<RefreshStageResponse> {
+export class FreightEligibility extends Message<FreightEligibility> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   * @generated from field: string freight = 1;
    */
-  stage?: Stage;
+  freight = "";
 
-  constructor(data?: PartialMessage<RefreshStageResponse>) {
+  /**
+   * @generated from field: google.protobuf.Timestamp created = 2;
+   */
+  created?: Timestamp;
+
+  /**
+   * @generated from field: bool eligible = 3;
+   */
+  eligible = false;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<FreightEligibility>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshStageResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.FreightEligibility";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "stage", kind: "message", T: Stage },
+    { no: 1, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "created", kind: "message", T: Timestamp },
+    { no: 3, name: "eligible", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshStageResponse {
-    return new RefreshStageResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightEligibility {
+    return new FreightEligibility().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshStageResponse {
-    return new RefreshStageResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightEligibility {
+    return new FreightEligibility().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshStageResponse {
-    return new RefreshStageResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightEligibility {
+    return new FreightEligibility().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RefreshStageResponse | PlainMessage<RefreshStageResponse> | undefined, b: RefreshStageResponse | PlainMessage<RefreshStageResponse> | undefined): boolean {
-    return proto3.util.equals(RefreshStageResponse, a, b);
+  static equals(a: FreightEligibility | PlainMessage<FreightEligibility> | undefined, b: FreightEligibility | PlainMessage<FreightEligibility> | undefined): boolean {
+    return proto3.util.equals(FreightEligibility, a, b);
   }
 }
 
@@ -2186,6 +2940,86 @@ export class WatchPromotionResponse extends Message<WatchPromotionResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.AbortPromotionRequest
+ */
+export class AbortPromotionRequest extends Message<AbortPromotionRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<AbortPromotionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AbortPromotionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AbortPromotionRequest | PlainMessage<AbortPromotionRequest> | undefined, b: AbortPromotionRequest | PlainMessage<AbortPromotionRequest> | undefined): boolean {
+    return proto3.util.equals(AbortPromotionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.AbortPromotionResponse
+ */
+export class AbortPromotionResponse extends Message<AbortPromotionResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion promotion = 1;
+   */
+  promotion?: Promotion;
+
+  constructor(data?: PartialMessage<AbortPromotionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AbortPromotionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "promotion", kind: "message", T: Promotion },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AbortPromotionResponse | PlainMessage<AbortPromotionResponse> | undefined, b: AbortPromotionResponse | PlainMessage<AbortPromotionResponse> | undefined): boolean {
+    return proto3.util.equals(AbortPromotionResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.SetAutoPromotionForStageRequest
  */
@@ -2933,6 +3767,217 @@ export class DeleteProjectResponse extends Message<DeleteProjectResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetProjectGraphRequest
+ */
+export class GetProjectGraphRequest extends Message<GetProjectGraphRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  constructor(data?: PartialMessage<GetProjectGraphRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetProjectGraphRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectGraphRequest {
+    return new GetProjectGraphRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProjectGraphRequest {
+    return new GetProjectGraphRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProjectGraphRequest {
+    return new GetProjectGraphRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProjectGraphRequest | PlainMessage<GetProjectGraphRequest> | undefined, b: GetProjectGraphRequest | PlainMessage<GetProjectGraphRequest> | undefined): boolean {
+    return proto3.util.equals(GetProjectGraphRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetProjectGraphResponse
+ */
+export class GetProjectGraphResponse extends Message<GetProjectGraphResponse> {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ProjectGraphNode nodes = 1;
+   */
+  nodes: ProjectGraphNode[] = [];
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ProjectGraphEdge edges = 2;
+   */
+  edges: ProjectGraphEdge[] = [];
+
+  constructor(data?: PartialMessage<GetProjectGraphResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetProjectGraphResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "nodes", kind: "message", T: ProjectGraphNode, repeated: true },
+    { no: 2, name: "edges", kind: "message", T: ProjectGraphEdge, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectGraphResponse {
+    return new GetProjectGraphResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProjectGraphResponse {
+    return new GetProjectGraphResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProjectGraphResponse {
+    return new GetProjectGraphResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProjectGraphResponse | PlainMessage<GetProjectGraphResponse> | undefined, b: GetProjectGraphResponse | PlainMessage<GetProjectGraphResponse> | undefined): boolean {
+    return proto3.util.equals(GetProjectGraphResponse, a, b);
+  }
+}
+
+/**
+ * ProjectGraphNode is a Warehouse or Stage in a Project's pipeline graph.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ProjectGraphNode
+ */
+export class ProjectGraphNode extends Message<ProjectGraphNode> {
+  /**
+   * kind is either "Warehouse" or "Stage".
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * current_freight, health, locked, and lock_reason are only set for Stages.
+   *
+   * @generated from field: string current_freight = 3;
+   */
+  currentFreight = "";
+
+  /**
+   * @generated from field: string health = 4;
+   */
+  health = "";
+
+  /**
+   * @generated from field: bool locked = 5;
+   */
+  locked = false;
+
+  /**
+   * @generated from field: string lock_reason = 6;
+   */
+  lockReason = "";
+
+  constructor(data?: PartialMessage<ProjectGraphNode>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ProjectGraphNode";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "current_freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "health", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "lock_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectGraphNode {
+    return new ProjectGraphNode().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectGraphNode {
+    return new ProjectGraphNode().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectGraphNode {
+    return new ProjectGraphNode().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectGraphNode | PlainMessage<ProjectGraphNode> | undefined, b: ProjectGraphNode | PlainMessage<ProjectGraphNode> | undefined): boolean {
+    return proto3.util.equals(ProjectGraphNode, a, b);
+  }
+}
+
+/**
+ * ProjectGraphEdge is a subscription of a Stage to a Warehouse or to an
+ * upstream Stage.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ProjectGraphEdge
+ */
+export class ProjectGraphEdge extends Message<ProjectGraphEdge> {
+  /**
+   * @generated from field: string from_kind = 1;
+   */
+  fromKind = "";
+
+  /**
+   * @generated from field: string from_name = 2;
+   */
+  fromName = "";
+
+  /**
+   * @generated from field: string to_kind = 3;
+   */
+  toKind = "";
+
+  /**
+   * @generated from field: string to_name = 4;
+   */
+  toName = "";
+
+  constructor(data?: PartialMessage<ProjectGraphEdge>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ProjectGraphEdge";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectGraphEdge {
+    return new ProjectGraphEdge().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectGraphEdge {
+    return new ProjectGraphEdge().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectGraphEdge {
+    return new ProjectGraphEdge().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectGraphEdge | PlainMessage<ProjectGraphEdge> | undefined, b: ProjectGraphEdge | PlainMessage<ProjectGraphEdge> | undefined): boolean {
+    return proto3.util.equals(ProjectGraphEdge, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightRequest
  */
@@ -3465,6 +4510,16 @@ export class DeleteWarehouseRequest extends Message<DeleteWarehouseRequest> {
    */
   name = "";
 
+  /**
+   * @generated from field: bool cascade = 3;
+   */
+  cascade = false;
+
+  /**
+   * @generated from field: bool force = 4;
+   */
+  force = false;
+
   constructor(data?: PartialMessage<DeleteWarehouseRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3475,6 +4530,8 @@ export class DeleteWarehouseRequest extends Message<DeleteWarehouseRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cascade", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseRequest {
@@ -3495,11 +4552,14 @@ export class DeleteWarehouseRequest extends Message<DeleteWarehouseRequest> {
 }
 
 /**
- * explicitly empty 
- *
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse
  */
 export class DeleteWarehouseResponse extends Message<DeleteWarehouseResponse> {
+  /**
+   * @generated from field: repeated string cascaded_stages = 1;
+   */
+  cascadedStages: string[] = [];
+
   constructor(data?: PartialMessage<DeleteWarehouseResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3508,6 +4568,7 @@ export class DeleteWarehouseResponse extends Message<DeleteWarehouseResponse> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cascaded_stages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseResponse {
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
-import { ListMeta, ObjectMeta } from "../metav1/types_pb.js";
+import { Condition, ListMeta, ObjectMeta } from "../metav1/types_pb.js";
 
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate
@@ -208,6 +208,16 @@ export class ArgoCDSourceUpdate extends Message<ArgoCDSourceUpdate> {
    */
   helm?: ArgoCDHelm;
 
+  /**
+   * @generated from field: optional int32 source_index = 6;
+   */
+  sourceIndex?: number;
+
+  /**
+   * @generated from field: optional string ref = 7;
+   */
+  ref?: string;
+
   constructor(data?: PartialMessage<ArgoCDSourceUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -221,6 +231,8 @@ export class ArgoCDSourceUpdate extends Message<ArgoCDSourceUpdate> {
     { no: 3, name: "update_target_revision", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 4, name: "kustomize", kind: "message", T: ArgoCDKustomize, opt: true },
     { no: 5, name: "helm", kind: "message", T: ArgoCDHelm, opt: true },
+    { no: 6, name: "source_index", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 7, name: "ref", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDSourceUpdate {
@@ -470,6 +482,11 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
    */
   render?: KargoRenderPromotionMechanism;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitCommitOptions commit = 8;
+   */
+  commit?: GitCommitOptions;
+
   constructor(data?: PartialMessage<GitRepoUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -484,6 +501,7 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
     { no: 5, name: "kustomize", kind: "message", T: KustomizePromotionMechanism, opt: true },
     { no: 6, name: "helm", kind: "message", T: HelmPromotionMechanism, opt: true },
     { no: 7, name: "render", kind: "message", T: KargoRenderPromotionMechanism, opt: true },
+    { no: 8, name: "commit", kind: "message", T: GitCommitOptions, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitRepoUpdate {
@@ -503,6 +521,61 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.GitCommitOptions
+ */
+export class GitCommitOptions extends Message<GitCommitOptions> {
+  /**
+   * @generated from field: optional string author_name = 1;
+   */
+  authorName?: string;
+
+  /**
+   * @generated from field: optional string author_email = 2;
+   */
+  authorEmail?: string;
+
+  /**
+   * @generated from field: optional string message_template = 3;
+   */
+  messageTemplate?: string;
+
+  /**
+   * @generated from field: optional bool disable_trailers = 4;
+   */
+  disableTrailers?: boolean;
+
+  constructor(data?: PartialMessage<GitCommitOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.GitCommitOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "author_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "author_email", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "message_template", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "disable_trailers", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitCommitOptions {
+    return new GitCommitOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitCommitOptions {
+    return new GitCommitOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitCommitOptions {
+    return new GitCommitOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GitCommitOptions | PlainMessage<GitCommitOptions> | undefined, b: GitCommitOptions | PlainMessage<GitCommitOptions> | undefined): boolean {
+    return proto3.util.equals(GitCommitOptions, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription
  */
@@ -565,6 +638,11 @@ export class Health extends Message<Health> {
    */
   argocdApps: ArgoCDAppState[] = [];
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ResourceHealthStatus resources = 4;
+   */
+  resources: ResourceHealthStatus[] = [];
+
   constructor(data?: PartialMessage<Health>) {
     super();
     proto3.util.initPartial(data, this);
@@ -576,6 +654,7 @@ export class Health extends Message<Health> {
     { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "issues", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "argocd_apps", jsonName: "argoCDApps", kind: "message", T: ArgoCDAppState, repeated: true },
+    { no: 4, name: "resources", kind: "message", T: ResourceHealthStatus, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Health {
@@ -595,6 +674,73 @@ export class Health extends Message<Health> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ResourceHealthStatus
+ */
+export class ResourceHealthStatus extends Message<ResourceHealthStatus> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string namespace = 3;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string status = 5;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ResourceHealthStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ResourceHealthStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceHealthStatus {
+    return new ResourceHealthStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceHealthStatus {
+    return new ResourceHealthStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceHealthStatus {
+    return new ResourceHealthStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceHealthStatus | PlainMessage<ResourceHealthStatus> | undefined, b: ResourceHealthStatus | PlainMessage<ResourceHealthStatus> | undefined): boolean {
+    return proto3.util.equals(ResourceHealthStatus, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState
  */
@@ -1240,6 +1386,26 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
    */
   argocdAppUpdates: ArgoCDAppUpdate[] = [];
 
+  /**
+   * @generated from field: optional string timeout = 3;
+   */
+  timeout?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetry retry = 4;
+   */
+  retry?: PromotionRetry;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook pre_hooks = 5;
+   */
+  preHooks: PromotionHook[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook post_hooks = 6;
+   */
+  postHooks: PromotionHook[] = [];
+
   constructor(data?: PartialMessage<PromotionMechanisms>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1250,6 +1416,10 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "git_repo_updates", kind: "message", T: GitRepoUpdate, repeated: true },
     { no: 2, name: "argocd_app_updates", jsonName: "argoCDAppUpdates", kind: "message", T: ArgoCDAppUpdate, repeated: true },
+    { no: 3, name: "timeout", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "retry", kind: "message", T: PromotionRetry, opt: true },
+    { no: 5, name: "pre_hooks", kind: "message", T: PromotionHook, repeated: true },
+    { no: 6, name: "post_hooks", kind: "message", T: PromotionHook, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionMechanisms {
@@ -1269,6 +1439,100 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook
+ */
+export class PromotionHook extends Message<PromotionHook> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * template is the JSON encoding of a Kubernetes JobTemplateSpec.
+   *
+   * @generated from field: bytes template = 2;
+   */
+  template = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<PromotionHook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "template", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionHook {
+    return new PromotionHook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionHook {
+    return new PromotionHook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionHook {
+    return new PromotionHook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionHook | PlainMessage<PromotionHook> | undefined, b: PromotionHook | PlainMessage<PromotionHook> | undefined): boolean {
+    return proto3.util.equals(PromotionHook, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetry
+ */
+export class PromotionRetry extends Message<PromotionRetry> {
+  /**
+   * @generated from field: int32 limit = 1;
+   */
+  limit = 0;
+
+  /**
+   * @generated from field: optional string backoff = 2;
+   */
+  backoff?: string;
+
+  /**
+   * @generated from field: optional string max_backoff = 3;
+   */
+  maxBackoff?: string;
+
+  constructor(data?: PartialMessage<PromotionRetry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "backoff", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "max_backoff", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionRetry {
+    return new PromotionRetry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionRetry {
+    return new PromotionRetry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionRetry {
+    return new PromotionRetry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionRetry | PlainMessage<PromotionRetry> | undefined, b: PromotionRetry | PlainMessage<PromotionRetry> | undefined): boolean {
+    return proto3.util.equals(PromotionRetry, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
  */
@@ -1387,6 +1651,16 @@ export class PromotionSpec extends Message<PromotionSpec> {
    */
   freight = "";
 
+  /**
+   * @generated from field: bool supersedable = 3;
+   */
+  supersedable = false;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactSelector artifacts = 4;
+   */
+  artifacts?: ArtifactSelector;
+
   constructor(data?: PartialMessage<PromotionSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1397,6 +1671,8 @@ export class PromotionSpec extends Message<PromotionSpec> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "supersedable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "artifacts", kind: "message", T: ArtifactSelector, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
@@ -1417,156 +1693,363 @@ export class PromotionSpec extends Message<PromotionSpec> {
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactSelector
  */
-export class PromotionStatus extends Message<PromotionStatus> {
+export class ArtifactSelector extends Message<ArtifactSelector> {
   /**
-   * @generated from field: string phase = 1;
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactReferences include = 1;
    */
-  phase = "";
+  include?: ArtifactReferences;
 
   /**
-   * @generated from field: string error = 2;
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactReferences exclude = 2;
    */
-  error = "";
+  exclude?: ArtifactReferences;
 
-  constructor(data?: PartialMessage<PromotionStatus>) {
+  constructor(data?: PartialMessage<ArtifactSelector>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactSelector";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "include", kind: "message", T: ArtifactReferences, opt: true },
+    { no: 2, name: "exclude", kind: "message", T: ArtifactReferences, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArtifactSelector {
+    return new ArtifactSelector().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArtifactSelector {
+    return new ArtifactSelector().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArtifactSelector {
+    return new ArtifactSelector().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionStatus | PlainMessage<PromotionStatus> | undefined, b: PromotionStatus | PlainMessage<PromotionStatus> | undefined): boolean {
-    return proto3.util.equals(PromotionStatus, a, b);
+  static equals(a: ArtifactSelector | PlainMessage<ArtifactSelector> | undefined, b: ArtifactSelector | PlainMessage<ArtifactSelector> | undefined): boolean {
+    return proto3.util.equals(ArtifactSelector, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactReferences
  */
-export class RepoSubscription extends Message<RepoSubscription> {
+export class ArtifactReferences extends Message<ArtifactReferences> {
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription git = 1;
+   * @generated from field: repeated string images = 1;
    */
-  git?: GitSubscription;
+  images: string[] = [];
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription image = 2;
+   * @generated from field: repeated string charts = 2;
    */
-  image?: ImageSubscription;
+  charts: string[] = [];
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription chart = 3;
+   * @generated from field: repeated string commits = 3;
    */
-  chart?: ChartSubscription;
+  commits: string[] = [];
 
-  constructor(data?: PartialMessage<RepoSubscription>) {
+  constructor(data?: PartialMessage<ArtifactReferences>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactReferences";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "git", kind: "message", T: GitSubscription, opt: true },
-    { no: 2, name: "image", kind: "message", T: ImageSubscription, opt: true },
-    { no: 3, name: "chart", kind: "message", T: ChartSubscription, opt: true },
+    { no: 1, name: "images", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "charts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "commits", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArtifactReferences {
+    return new ArtifactReferences().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArtifactReferences {
+    return new ArtifactReferences().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArtifactReferences {
+    return new ArtifactReferences().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RepoSubscription | PlainMessage<RepoSubscription> | undefined, b: RepoSubscription | PlainMessage<RepoSubscription> | undefined): boolean {
-    return proto3.util.equals(RepoSubscription, a, b);
+  static equals(a: ArtifactReferences | PlainMessage<ArtifactReferences> | undefined, b: ArtifactReferences | PlainMessage<ArtifactReferences> | undefined): boolean {
+    return proto3.util.equals(ArtifactReferences, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
  */
-export class Stage extends Message<Stage> {
+export class PromotionStatus extends Message<PromotionStatus> {
   /**
-   * @generated from field: string api_version = 1;
+   * @generated from field: string phase = 1;
    */
-  apiVersion = "";
+  phase = "";
 
   /**
-   * @generated from field: string kind = 2;
+   * @generated from field: string error = 2;
    */
-  kind = "";
+  error = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStepResult steps = 3;
    */
-  metadata?: ObjectMeta;
+  steps: PromotionStepResult[] = [];
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec spec = 4;
+   * @generated from field: int32 attempts = 4;
    */
-  spec?: StageSpec;
+  attempts = 0;
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus status = 5;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.metav1.Condition conditions = 5;
    */
-  status?: StageStatus;
+  conditions: Condition[] = [];
 
-  constructor(data?: PartialMessage<Stage>) {
+  /**
+   * @generated from field: optional google.protobuf.Timestamp attempt_start_time = 6;
+   */
+  attemptStartTime?: Timestamp;
+
+  /**
+   * @generated from field: string derived_freight = 7;
+   */
+  derivedFreight = "";
+
+  constructor(data?: PartialMessage<PromotionStatus>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Stage";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
-    { no: 4, name: "spec", kind: "message", T: StageSpec },
-    { no: 5, name: "status", kind: "message", T: StageStatus },
+    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "steps", kind: "message", T: PromotionStepResult, repeated: true },
+    { no: 4, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "conditions", kind: "message", T: Condition, repeated: true },
+    { no: 6, name: "attempt_start_time", kind: "message", T: Timestamp, opt: true },
+    { no: 7, name: "derived_freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Stage {
-    return new Stage().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Stage {
-    return new Stage().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Stage {
-    return new Stage().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Stage | PlainMessage<Stage> | undefined, b: Stage | PlainMessage<Stage> | undefined): boolean {
-    return proto3.util.equals(Stage, a, b);
-  }
+  static equals(a: PromotionStatus | PlainMessage<PromotionStatus> | undefined, b: PromotionStatus | PlainMessage<PromotionStatus> | undefined): boolean {
+    return proto3.util.equals(PromotionStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStepResult
+ */
+export class PromotionStepResult extends Message<PromotionStepResult> {
+  /**
+   * @generated from field: string mechanism = 1;
+   */
+  mechanism = "";
+
+  /**
+   * @generated from field: string target = 2;
+   */
+  target = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: string outcome = 5;
+   */
+  outcome = "";
+
+  /**
+   * @generated from field: string commit = 6;
+   */
+  commit = "";
+
+  /**
+   * @generated from field: string error = 7;
+   */
+  error = "";
+
+  /**
+   * @generated from field: string job = 8;
+   */
+  job = "";
+
+  /**
+   * @generated from field: string pull_request_url = 9 [json_name = "pullRequestURL"];
+   */
+  pullRequestUrl = "";
+
+  constructor(data?: PartialMessage<PromotionStepResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStepResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mechanism", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "start_time", kind: "message", T: Timestamp, opt: true },
+    { no: 4, name: "end_time", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "outcome", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "commit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "job", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "pull_request_url", jsonName: "pullRequestURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStepResult {
+    return new PromotionStepResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStepResult {
+    return new PromotionStepResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStepResult {
+    return new PromotionStepResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionStepResult | PlainMessage<PromotionStepResult> | undefined, b: PromotionStepResult | PlainMessage<PromotionStepResult> | undefined): boolean {
+    return proto3.util.equals(PromotionStepResult, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+ */
+export class RepoSubscription extends Message<RepoSubscription> {
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription git = 1;
+   */
+  git?: GitSubscription;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription image = 2;
+   */
+  image?: ImageSubscription;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription chart = 3;
+   */
+  chart?: ChartSubscription;
+
+  constructor(data?: PartialMessage<RepoSubscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "git", kind: "message", T: GitSubscription, opt: true },
+    { no: 2, name: "image", kind: "message", T: ImageSubscription, opt: true },
+    { no: 3, name: "chart", kind: "message", T: ChartSubscription, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoSubscription | PlainMessage<RepoSubscription> | undefined, b: RepoSubscription | PlainMessage<RepoSubscription> | undefined): boolean {
+    return proto3.util.equals(RepoSubscription, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+ */
+export class Stage extends Message<Stage> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec spec = 4;
+   */
+  spec?: StageSpec;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus status = 5;
+   */
+  status?: StageStatus;
+
+  constructor(data?: PartialMessage<Stage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Stage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
+    { no: 4, name: "spec", kind: "message", T: StageSpec },
+    { no: 5, name: "status", kind: "message", T: StageStatus },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Stage {
+    return new Stage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Stage {
+    return new Stage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Stage {
+    return new Stage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Stage | PlainMessage<Stage> | undefined, b: Stage | PlainMessage<Stage> | undefined): boolean {
+    return proto3.util.equals(Stage, a, b);
+  }
 }
 
 /**
@@ -1626,6 +2109,31 @@ export class StageSpec extends Message<StageSpec> {
    */
   promotionMechanisms?: PromotionMechanisms;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HealthChecks health_checks = 3;
+   */
+  healthChecks?: HealthChecks;
+
+  /**
+   * @generated from field: optional string minimum_soak_duration = 4;
+   */
+  minimumSoakDuration?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.AutoRollback auto_rollback = 5;
+   */
+  autoRollback?: AutoRollback;
+
+  /**
+   * @generated from field: optional string drift_policy = 6;
+   */
+  driftPolicy?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.StageLock lock = 7;
+   */
+  lock?: StageLock;
+
   constructor(data?: PartialMessage<StageSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1636,6 +2144,11 @@ export class StageSpec extends Message<StageSpec> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "subscriptions", kind: "message", T: Subscriptions },
     { no: 2, name: "promotion_mechanisms", kind: "message", T: PromotionMechanisms },
+    { no: 3, name: "health_checks", kind: "message", T: HealthChecks, opt: true },
+    { no: 4, name: "minimum_soak_duration", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "auto_rollback", kind: "message", T: AutoRollback, opt: true },
+    { no: 6, name: "drift_policy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "lock", kind: "message", T: StageLock, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
@@ -1655,6 +2168,184 @@ export class StageSpec extends Message<StageSpec> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageLock
+ */
+export class StageLock extends Message<StageLock> {
+  /**
+   * @generated from field: string by = 1;
+   */
+  by = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp until = 3;
+   */
+  until?: Timestamp;
+
+  constructor(data?: PartialMessage<StageLock>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageLock";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "until", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageLock {
+    return new StageLock().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageLock {
+    return new StageLock().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageLock {
+    return new StageLock().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageLock | PlainMessage<StageLock> | undefined, b: StageLock | PlainMessage<StageLock> | undefined): boolean {
+    return proto3.util.equals(StageLock, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.AutoRollback
+ */
+export class AutoRollback extends Message<AutoRollback> {
+  /**
+   * @generated from field: string window = 1;
+   */
+  window = "";
+
+  constructor(data?: PartialMessage<AutoRollback>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.AutoRollback";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "window", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AutoRollback {
+    return new AutoRollback().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AutoRollback {
+    return new AutoRollback().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AutoRollback {
+    return new AutoRollback().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AutoRollback | PlainMessage<AutoRollback> | undefined, b: AutoRollback | PlainMessage<AutoRollback> | undefined): boolean {
+    return proto3.util.equals(AutoRollback, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HealthChecks
+ */
+export class HealthChecks extends Message<HealthChecks> {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ResourceHealthCheck resources = 2;
+   */
+  resources: ResourceHealthCheck[] = [];
+
+  constructor(data?: PartialMessage<HealthChecks>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HealthChecks";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resources", kind: "message", T: ResourceHealthCheck, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthChecks {
+    return new HealthChecks().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthChecks {
+    return new HealthChecks().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthChecks {
+    return new HealthChecks().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HealthChecks | PlainMessage<HealthChecks> | undefined, b: HealthChecks | PlainMessage<HealthChecks> | undefined): boolean {
+    return proto3.util.equals(HealthChecks, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ResourceHealthCheck
+ */
+export class ResourceHealthCheck extends Message<ResourceHealthCheck> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ResourceHealthCheck>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ResourceHealthCheck";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceHealthCheck {
+    return new ResourceHealthCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceHealthCheck {
+    return new ResourceHealthCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceHealthCheck {
+    return new ResourceHealthCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceHealthCheck | PlainMessage<ResourceHealthCheck> | undefined, b: ResourceHealthCheck | PlainMessage<ResourceHealthCheck> | undefined): boolean {
+    return proto3.util.equals(ResourceHealthCheck, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Freight
  */
@@ -1743,6 +2434,11 @@ export class FreightStatus extends Message<FreightStatus> {
    */
   qualifications: { [key: string]: Qualification } = {};
 
+  /**
+   * @generated from field: map<string, github.com.akuity.kargo.pkg.api.v1alpha1.Failure> failures = 2;
+   */
+  failures: { [key: string]: Failure } = {};
+
   constructor(data?: PartialMessage<FreightStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1752,6 +2448,7 @@ export class FreightStatus extends Message<FreightStatus> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "qualifications", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Qualification} },
+    { no: 2, name: "failures", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Failure} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightStatus {
@@ -1771,6 +2468,49 @@ export class FreightStatus extends Message<FreightStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Failure
+ */
+export class Failure extends Message<Failure> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<Failure>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Failure";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Failure {
+    return new Failure().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Failure {
+    return new Failure().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Failure {
+    return new Failure().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Failure | PlainMessage<Failure> | undefined, b: Failure | PlainMessage<Failure> | undefined): boolean {
+    return proto3.util.equals(Failure, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Qualification
  */
@@ -1892,6 +2632,31 @@ export class StageStatus extends Message<StageStatus> {
    */
   currentPromotion?: PromotionInfo;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.SoakStatus soak = 7;
+   */
+  soak?: SoakStatus;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_promotion_time = 8;
+   */
+  lastPromotionTime?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Rollback rollbacks = 9;
+   */
+  rollbacks: Rollback[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.metav1.Condition conditions = 10;
+   */
+  conditions: Condition[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionDecision last_auto_promotion_decision = 11;
+   */
+  lastAutoPromotionDecision?: AutoPromotionDecision;
+
   constructor(data?: PartialMessage<StageStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1905,6 +2670,11 @@ export class StageStatus extends Message<StageStatus> {
     { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "health", kind: "message", T: Health, opt: true },
     { no: 6, name: "current_promotion", kind: "message", T: PromotionInfo, opt: true },
+    { no: 7, name: "soak", kind: "message", T: SoakStatus, opt: true },
+    { no: 8, name: "last_promotion_time", kind: "message", T: Timestamp, opt: true },
+    { no: 9, name: "rollbacks", kind: "message", T: Rollback, repeated: true },
+    { no: 10, name: "conditions", kind: "message", T: Condition, repeated: true },
+    { no: 11, name: "last_auto_promotion_decision", kind: "message", T: AutoPromotionDecision, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageStatus {
@@ -1924,6 +2694,183 @@ export class StageStatus extends Message<StageStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionDecision
+ */
+export class AutoPromotionDecision extends Message<AutoPromotionDecision> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  /**
+   * @generated from field: string freight = 4;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: string promotion = 5;
+   */
+  promotion = "";
+
+  constructor(data?: PartialMessage<AutoPromotionDecision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.AutoPromotionDecision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "promotion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AutoPromotionDecision {
+    return new AutoPromotionDecision().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AutoPromotionDecision {
+    return new AutoPromotionDecision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AutoPromotionDecision {
+    return new AutoPromotionDecision().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AutoPromotionDecision | PlainMessage<AutoPromotionDecision> | undefined, b: AutoPromotionDecision | PlainMessage<AutoPromotionDecision> | undefined): boolean {
+    return proto3.util.equals(AutoPromotionDecision, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Rollback
+ */
+export class Rollback extends Message<Rollback> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: string from_freight = 2;
+   */
+  fromFreight = "";
+
+  /**
+   * @generated from field: string to_freight = 3;
+   */
+  toFreight = "";
+
+  /**
+   * @generated from field: string promotion = 4;
+   */
+  promotion = "";
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: bool acknowledged = 6;
+   */
+  acknowledged = false;
+
+  constructor(data?: PartialMessage<Rollback>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Rollback";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "from_freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "promotion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "acknowledged", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Rollback {
+    return new Rollback().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Rollback {
+    return new Rollback().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Rollback {
+    return new Rollback().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Rollback | PlainMessage<Rollback> | undefined, b: Rollback | PlainMessage<Rollback> | undefined): boolean {
+    return proto3.util.equals(Rollback, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.SoakStatus
+ */
+export class SoakStatus extends Message<SoakStatus> {
+  /**
+   * @generated from field: string freight = 1;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp healthy_since = 2;
+   */
+  healthySince?: Timestamp;
+
+  /**
+   * @generated from field: optional string remaining = 3;
+   */
+  remaining?: string;
+
+  constructor(data?: PartialMessage<SoakStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.SoakStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "healthy_since", kind: "message", T: Timestamp, opt: true },
+    { no: 3, name: "remaining", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SoakStatus {
+    return new SoakStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SoakStatus {
+    return new SoakStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SoakStatus {
+    return new SoakStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SoakStatus | PlainMessage<SoakStatus> | undefined, b: SoakStatus | PlainMessage<SoakStatus> | undefined): boolean {
+    return proto3.util.equals(SoakStatus, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription
  */
@@ -1975,6 +2922,11 @@ export class Subscriptions extends Message<Subscriptions> {
    */
   warehouse = "";
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.QualificationRule qualification_rule = 4;
+   */
+  qualificationRule?: QualificationRule;
+
   constructor(data?: PartialMessage<Subscriptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1985,6 +2937,7 @@ export class Subscriptions extends Message<Subscriptions> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "upstream_stages", kind: "message", T: StageSubscription, repeated: true },
     { no: 3, name: "warehouse", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "qualification_rule", kind: "message", T: QualificationRule, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Subscriptions {
@@ -2004,6 +2957,49 @@ export class Subscriptions extends Message<Subscriptions> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.QualificationRule
+ */
+export class QualificationRule extends Message<QualificationRule> {
+  /**
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: optional int32 quorum = 2;
+   */
+  quorum?: number;
+
+  constructor(data?: PartialMessage<QualificationRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.QualificationRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "quorum", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QualificationRule {
+    return new QualificationRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QualificationRule {
+    return new QualificationRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QualificationRule {
+    return new QualificationRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QualificationRule | PlainMessage<QualificationRule> | undefined, b: QualificationRule | PlainMessage<QualificationRule> | undefined): boolean {
+    return proto3.util.equals(QualificationRule, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse
  */
@@ -2116,6 +3112,11 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
    */
   observedGeneration = protoInt64.zero;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.metav1.Condition conditions = 3;
+   */
+  conditions: Condition[] = [];
+
   constructor(data?: PartialMessage<WarehouseStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2126,6 +3127,7 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "observed_generation", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "conditions", kind: "message", T: Condition, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseStatus {